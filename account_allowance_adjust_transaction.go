@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -196,7 +197,7 @@ func (transaction *AccountAllowanceAdjustTransaction) GetTokenNftAllowances() []
 }
 
 func (transaction *AccountAllowanceAdjustTransaction) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -302,7 +303,7 @@ func (transaction *AccountAllowanceAdjustTransaction) SignWithOperator(
 
 	if client == nil {
 		return nil, errNoClientProvided
-	} else if client.operator == nil {
+	} else if client._GetOperator() == nil {
 		return nil, errClientOperatorSigning
 	}
 
@@ -312,7 +313,7 @@ func (transaction *AccountAllowanceAdjustTransaction) SignWithOperator(
 			return transaction, err
 		}
 	}
-	return transaction.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return transaction.SignWithSigner(client._GetOperator().signer), nil
 }
 
 // Deprecated
@@ -327,9 +328,30 @@ func (transaction *AccountAllowanceAdjustTransaction) SignWith(
 	return transaction
 }
 
+// SignWithSigner uses the Signer to sign the transaction and adds the resulting signature data to the
+// Transaction's signature map with the Signer's public key as the map key.
+func (transaction *AccountAllowanceAdjustTransaction) SignWithSigner(
+	signer Signer,
+) *AccountAllowanceAdjustTransaction {
+	if !transaction._KeyAlreadySigned(signer.GetPublicKey()) {
+		transaction._SignWithSigner(signer)
+	}
+
+	return transaction
+}
+
 // Deprecated
 func (transaction *AccountAllowanceAdjustTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (transaction *AccountAllowanceAdjustTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -339,6 +361,10 @@ func (transaction *AccountAllowanceAdjustTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
+	if err := transaction._SelectNodesForExecute(ctx, client); err != nil {
+		return TransactionResponse{}, err
+	}
+
 	if !transaction.IsFrozen() {
 		_, err := transaction.FreezeWith(client)
 		if err != nil {
@@ -349,13 +375,11 @@ func (transaction *AccountAllowanceAdjustTransaction) Execute(
 	transactionID := transaction.transactionIDs._GetCurrent().(TransactionID)
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		transaction.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		transaction.SignWithSigner(client._GetOperator().signer)
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
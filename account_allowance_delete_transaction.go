@@ -1,6 +1,7 @@
 package hedera
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -118,7 +119,7 @@ func (transaction *AccountAllowanceDeleteTransaction) GetAllTokenNftDeleteAllowa
 }
 
 func (transaction *AccountAllowanceDeleteTransaction) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -213,7 +214,7 @@ func (transaction *AccountAllowanceDeleteTransaction) SignWithOperator(
 
 	if client == nil {
 		return nil, errNoClientProvided
-	} else if client.operator == nil {
+	} else if client._GetOperator() == nil {
 		return nil, errClientOperatorSigning
 	}
 
@@ -223,7 +224,7 @@ func (transaction *AccountAllowanceDeleteTransaction) SignWithOperator(
 			return transaction, err
 		}
 	}
-	return transaction.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return transaction.SignWithSigner(client._GetOperator().signer), nil
 }
 
 // SignWith executes the TransactionSigner and adds the resulting signature data to the Transaction's signature map
@@ -239,9 +240,30 @@ func (transaction *AccountAllowanceDeleteTransaction) SignWith(
 	return transaction
 }
 
+// SignWithSigner uses the Signer to sign the transaction and adds the resulting signature data to the
+// Transaction's signature map with the Signer's public key as the map key.
+func (transaction *AccountAllowanceDeleteTransaction) SignWithSigner(
+	signer Signer,
+) *AccountAllowanceDeleteTransaction {
+	if !transaction._KeyAlreadySigned(signer.GetPublicKey()) {
+		transaction._SignWithSigner(signer)
+	}
+
+	return transaction
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *AccountAllowanceDeleteTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (transaction *AccountAllowanceDeleteTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -251,6 +273,10 @@ func (transaction *AccountAllowanceDeleteTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
+	if err := transaction._SelectNodesForExecute(ctx, client); err != nil {
+		return TransactionResponse{}, err
+	}
+
 	if !transaction.IsFrozen() {
 		_, err := transaction.FreezeWith(client)
 		if err != nil {
@@ -261,13 +287,11 @@ func (transaction *AccountAllowanceDeleteTransaction) Execute(
 	transactionID := transaction.transactionIDs._GetCurrent().(TransactionID)
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		transaction.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		transaction.SignWithSigner(client._GetOperator().signer)
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
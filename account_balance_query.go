@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -87,7 +88,7 @@ func (query *AccountBalanceQuery) GetContractID() ContractID {
 }
 
 func (query *AccountBalanceQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -127,7 +128,13 @@ func (query *AccountBalanceQuery) _Build() *services.Query_CryptogetAccountBalan
 }
 
 func (query *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountBalanceQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -147,6 +154,7 @@ func (query *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
 		Query: pb,
 	}
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountBalanceQueryShouldRetry,
@@ -171,8 +179,8 @@ func (query *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _AccountBalanceQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptogetAccountBalance().Header.NodeTransactionPrecheckCode))
+func _AccountBalanceQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetCryptogetAccountBalance().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountBalanceQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -188,6 +196,12 @@ func _AccountBalanceQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the AccountBalanceQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountBalanceQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountBalance, error) {
 	if client == nil {
 		return AccountBalance{}, errNoClientProvided
 	}
@@ -210,6 +224,7 @@ func (query *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountBalanceQueryShouldRetry,
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -260,7 +261,7 @@ func (transaction *AccountCreateTransaction) GetAliasEvmAddress() []byte {
 }
 
 func (transaction *AccountCreateTransaction) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -403,7 +404,7 @@ func (transaction *AccountCreateTransaction) SignWithOperator(
 
 	if client == nil {
 		return nil, errNoClientProvided
-	} else if client.operator == nil {
+	} else if client._GetOperator() == nil {
 		return nil, errClientOperatorSigning
 	}
 
@@ -413,7 +414,7 @@ func (transaction *AccountCreateTransaction) SignWithOperator(
 			return transaction, err
 		}
 	}
-	return transaction.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return transaction.SignWithSigner(client._GetOperator().signer), nil
 }
 
 // SignWith executes the TransactionSigner and adds the resulting signature data to the Transaction's signature map
@@ -429,9 +430,30 @@ func (transaction *AccountCreateTransaction) SignWith(
 	return transaction
 }
 
+// SignWithSigner uses the Signer to sign the transaction and adds the resulting signature data to the
+// Transaction's signature map with the Signer's public key as the map key.
+func (transaction *AccountCreateTransaction) SignWithSigner(
+	signer Signer,
+) *AccountCreateTransaction {
+	if !transaction._KeyAlreadySigned(signer.GetPublicKey()) {
+		transaction._SignWithSigner(signer)
+	}
+
+	return transaction
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *AccountCreateTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (transaction *AccountCreateTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -441,6 +463,10 @@ func (transaction *AccountCreateTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
+	if err := transaction._SelectNodesForExecute(ctx, client); err != nil {
+		return TransactionResponse{}, err
+	}
+
 	if !transaction.IsFrozen() {
 		_, err := transaction.FreezeWith(client)
 		if err != nil {
@@ -451,17 +477,15 @@ func (transaction *AccountCreateTransaction) Execute(
 	transactionID := transaction.transactionIDs._GetCurrent().(TransactionID)
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		transaction.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		transaction.SignWithSigner(client._GetOperator().signer)
 	}
 
 	if transaction.grpcDeadline == nil {
-		transaction.grpcDeadline = client.requestTimeout
+		transaction.grpcDeadline = client.GetRequestTimeout()
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -94,7 +95,7 @@ func (transaction *AccountDeleteTransaction) GetTransferAccountID() AccountID {
 }
 
 func (transaction *AccountDeleteTransaction) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -191,7 +192,7 @@ func (transaction *AccountDeleteTransaction) SignWithOperator(
 
 	if client == nil {
 		return nil, errNoClientProvided
-	} else if client.operator == nil {
+	} else if client._GetOperator() == nil {
 		return nil, errClientOperatorSigning
 	}
 
@@ -201,7 +202,7 @@ func (transaction *AccountDeleteTransaction) SignWithOperator(
 			return transaction, err
 		}
 	}
-	return transaction.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return transaction.SignWithSigner(client._GetOperator().signer), nil
 }
 
 // SignWith executes the TransactionSigner and adds the resulting signature data to the Transaction's signature map
@@ -217,9 +218,30 @@ func (transaction *AccountDeleteTransaction) SignWith(
 	return transaction
 }
 
+// SignWithSigner uses the Signer to sign the transaction and adds the resulting signature data to the
+// Transaction's signature map with the Signer's public key as the map key.
+func (transaction *AccountDeleteTransaction) SignWithSigner(
+	signer Signer,
+) *AccountDeleteTransaction {
+	if !transaction._KeyAlreadySigned(signer.GetPublicKey()) {
+		transaction._SignWithSigner(signer)
+	}
+
+	return transaction
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *AccountDeleteTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (transaction *AccountDeleteTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -229,6 +251,10 @@ func (transaction *AccountDeleteTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
+	if err := transaction._SelectNodesForExecute(ctx, client); err != nil {
+		return TransactionResponse{}, err
+	}
+
 	if !transaction.IsFrozen() {
 		_, err := transaction.FreezeWith(client)
 		if err != nil {
@@ -239,13 +265,11 @@ func (transaction *AccountDeleteTransaction) Execute(
 	transactionID := transaction.transactionIDs._GetCurrent().(TransactionID)
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		transaction.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		transaction.SignWithSigner(client._GetOperator().signer)
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
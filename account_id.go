@@ -124,7 +124,7 @@ func (id *AccountID) ValidateChecksum(client *Client) error {
 	if id.AliasKey != nil {
 		return errors.New("Account ID contains alias key, unable to validate")
 	}
-	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
+	if !id._IsZero() && client != nil && client.network._ManagedNetwork._GetLedgerID() != nil {
 		var tempChecksum _ParseAddressResult
 		var err error
 		tempChecksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Account))
@@ -139,7 +139,7 @@ func (id *AccountID) ValidateChecksum(client *Client) error {
 			return errChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
-			temp, _ := client.network.ledgerID.ToNetworkName()
+			temp, _ := client.network._ManagedNetwork._GetLedgerID().ToNetworkName()
 			return errors.New(fmt.Sprintf("network mismatch or wrong checksum given, given checksum: %s, correct checksum %s, network: %s",
 				*id.checksum,
 				tempChecksum.correctChecksum,
@@ -155,7 +155,7 @@ func (id *AccountID) Validate(client *Client) error {
 	if id.AliasKey != nil {
 		return errors.New("Account ID contains alias key, unable to validate")
 	}
-	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
+	if !id._IsZero() && client != nil && client.network._ManagedNetwork._GetLedgerID() != nil {
 		tempChecksum, err := _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Account))
 		if err != nil {
 			return err
@@ -196,7 +196,7 @@ func (id AccountID) ToStringWithChecksum(client *Client) (string, error) {
 	}
 	var checksum _ParseAddressResult
 	var err error
-	if client.network.ledgerID != nil {
+	if client.network._ManagedNetwork._GetLedgerID() != nil {
 		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Account))
 	}
 	if err != nil {
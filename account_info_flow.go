@@ -20,11 +20,18 @@ package hedera
  *
  */
 
+import "context"
+
 // AccountInfoFlowVerifySignature Verifies signature using AccountInfoQuery
 func AccountInfoFlowVerifySignature(client *Client, accountID AccountID, message []byte, signature []byte) (bool, error) {
+	return AccountInfoFlowVerifySignatureWithContext(context.Background(), client, accountID, message, signature)
+}
+
+// AccountInfoFlowVerifySignatureWithContext Verifies signature using AccountInfoQuery, bounded by the context
+func AccountInfoFlowVerifySignatureWithContext(ctx context.Context, client *Client, accountID AccountID, message []byte, signature []byte) (bool, error) {
 	info, err := NewAccountInfoQuery().
 		SetAccountID(accountID).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return false, err
@@ -39,9 +46,14 @@ func AccountInfoFlowVerifySignature(client *Client, accountID AccountID, message
 
 // AccountInfoFlowVerifyTransaction Verifies transaction using AccountInfoQuery
 func AccountInfoFlowVerifyTransaction(client *Client, accountID AccountID, transaction Transaction, signature []byte) (bool, error) {
+	return AccountInfoFlowVerifyTransactionWithContext(context.Background(), client, accountID, transaction, signature)
+}
+
+// AccountInfoFlowVerifyTransactionWithContext Verifies transaction using AccountInfoQuery, bounded by the context
+func AccountInfoFlowVerifyTransactionWithContext(ctx context.Context, client *Client, accountID AccountID, transaction Transaction, signature []byte) (bool, error) {
 	info, err := NewAccountInfoQuery().
 		SetAccountID(accountID).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return false, err
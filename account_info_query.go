@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -65,7 +66,7 @@ func (query *AccountInfoQuery) GetAccountID() AccountID {
 }
 
 func (query *AccountInfoQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -92,8 +93,8 @@ func (query *AccountInfoQuery) _Build() *services.Query_CryptoGetInfo {
 	return &pb
 }
 
-func _AccountInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetInfo().Header.NodeTransactionPrecheckCode))
+func _AccountInfoQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetCryptoGetInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -110,7 +111,13 @@ func _AccountInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 
 // GetCost Get the cost of the query
 func (query *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -123,14 +130,14 @@ func (query *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 	if query.nodeAccountIDs.locked {
 		for range query.nodeAccountIDs.slice {
-			paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+			paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 			if err != nil {
 				return Hbar{}, err
 			}
 			query.paymentTransactions = append(query.paymentTransactions, paymentTransaction)
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -145,6 +152,7 @@ func (query *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountInfoQueryShouldRetry,
@@ -194,7 +202,13 @@ func (query *AccountInfoQuery) SetMaxRetry(count int) *AccountInfoQuery {
 }
 
 func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the AccountInfoQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountInfo, error) {
+	if client == nil || client._GetOperator() == nil {
 		return AccountInfo{}, errNoClientProvided
 	}
 
@@ -206,7 +220,7 @@ func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -219,7 +233,7 @@ func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return AccountInfo{}, err
 		}
@@ -237,12 +251,12 @@ func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 
 	query.paymentTransactions = make([]*services.Transaction, 0)
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return AccountInfo{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return AccountInfo{}, err
 		}
@@ -256,6 +270,7 @@ func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountInfoQueryShouldRetry,
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -66,7 +67,7 @@ func (query *AccountRecordsQuery) GetAccountID() AccountID {
 }
 
 func (query *AccountRecordsQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -95,7 +96,13 @@ func (query *AccountRecordsQuery) _Build() *services.Query_CryptoGetAccountRecor
 
 // GetCost Get the cost of the query
 func (query *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountRecordsQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -107,7 +114,7 @@ func (query *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -122,6 +129,7 @@ func (query *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountRecordsQueryShouldRetry,
@@ -146,8 +154,8 @@ func (query *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _AccountRecordsQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetAccountRecords().Header.NodeTransactionPrecheckCode))
+func _AccountRecordsQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetCryptoGetAccountRecords().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountRecordsQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -163,7 +171,13 @@ func _AccountRecordsQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the AccountRecordsQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountRecordsQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TransactionRecord, error) {
+	if client == nil || client._GetOperator() == nil {
 		return []TransactionRecord{}, errNoClientProvided
 	}
 
@@ -175,7 +189,7 @@ func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord,
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -188,7 +202,7 @@ func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord,
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []TransactionRecord{}, err
 		}
@@ -207,12 +221,12 @@ func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord,
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return []TransactionRecord{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			if err != nil {
 				return []TransactionRecord{}, err
@@ -230,6 +244,7 @@ func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord,
 	records := make([]TransactionRecord, 0)
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountRecordsQueryShouldRetry,
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -66,7 +67,7 @@ func (query *AccountStakersQuery) GetAccountID() AccountID {
 }
 
 func (query *AccountStakersQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -94,7 +95,13 @@ func (query *AccountStakersQuery) _Build() *services.Query_CryptoGetProxyStakers
 }
 
 func (query *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountStakersQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -104,7 +111,7 @@ func (query *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
 	}
 	if query.Query.nodeAccountIDs.locked {
 		for range query.nodeAccountIDs.slice {
-			paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+			paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 			if err != nil {
 				return Hbar{}, err
 			}
@@ -120,6 +127,7 @@ func (query *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountStakersQueryShouldRetry,
@@ -144,8 +152,8 @@ func (query *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _AccountStakersQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetProxyStakers().Header.NodeTransactionPrecheckCode))
+func _AccountStakersQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetCryptoGetProxyStakers().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountStakersQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -161,7 +169,13 @@ func _AccountStakersQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the AccountStakersQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *AccountStakersQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]Transfer, error) {
+	if client == nil || client._GetOperator() == nil {
 		return []Transfer{}, errNoClientProvided
 	}
 
@@ -173,7 +187,7 @@ func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -186,7 +200,7 @@ func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []Transfer{}, err
 		}
@@ -205,12 +219,12 @@ func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return []Transfer{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return []Transfer{}, err
 		}
@@ -224,6 +238,7 @@ func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountStakersQueryShouldRetry,
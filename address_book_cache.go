@@ -0,0 +1,124 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// _ReadAddressBookCache reads the address book cached at path, in the format of file 0.0.102. A
+// missing file or an empty book is not an error, it is reported as not found.
+func _ReadAddressBookCache(path string) (NodeAddressBook, bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return NodeAddressBook{}, false, nil
+	}
+	if err != nil {
+		return NodeAddressBook{}, false, fmt.Errorf("cannot read address book cache: %w", err)
+	}
+
+	book, err := NodeAddressBookFromBytes(data)
+	if err != nil {
+		return NodeAddressBook{}, false, fmt.Errorf("cannot decode address book cache: %w", err)
+	}
+
+	return book, len(book._ToMap()) > 0, nil
+}
+
+// _WriteAddressBookCache replaces the address book cached at path. The book is written to a temporary
+// file first, so a crash never leaves a truncated cache behind.
+func _WriteAddressBookCache(path string, book NodeAddressBook) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("cannot write address book cache: %w", err)
+	}
+
+	_, err = file.Write(book.ToBytes())
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return fmt.Errorf("cannot write address book cache: %w", err)
+	}
+
+	return nil
+}
+
+// SetAddressBookCachePath makes the client persist every address book it is given at path, whether
+// it comes from the scheduled address book update, an AddressBookQuery or the contents of file 0.0.102
+// passed to SetNetworkFromAddressBook.
+//
+// A book the client has already been given is newer than anything cached, so it is written to path
+// right away. Only a client that has not received a book yet, such as one whose mirror node could not
+// be reached, replaces its network with the book cached at path. A client restarted with the same path
+// then reuses the last known nodes and cert hashes until the mirror node answers again.
+func (client *Client) SetAddressBookCachePath(path string) error {
+	book, ok, err := _ReadAddressBookCache(path)
+	if err != nil {
+		return err
+	}
+
+	client._Mu().Lock()
+	client.addressBookCachePath = path
+	received := client.addressBookReceived
+	if !received && ok {
+		client.network._SetNetworkFromAddressBook(book)
+	}
+	client._Mu().Unlock()
+
+	if received {
+		client._PersistAddressBook()
+	}
+
+	return nil
+}
+
+// GetAddressBookCachePath returns the path address books are persisted at, empty if they are not
+func (client *Client) GetAddressBookCachePath() string {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	return client.addressBookCachePath
+}
+
+// _PersistAddressBook writes the address book of the network to the cache path, if one is set
+func (client *Client) _PersistAddressBook() {
+	path := client.GetAddressBookCachePath()
+	if path == "" {
+		return
+	}
+
+	book := client.network._GetAddressBook()
+	if len(book.NodeAddresses) == 0 {
+		return
+	}
+
+	if err := _WriteAddressBookCache(path, book); err != nil {
+		client._GetLogger().Warn("failed to persist the address book", "path", path, "error", err.Error())
+	}
+}
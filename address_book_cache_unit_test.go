@@ -0,0 +1,189 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewUnitAddressBook() NodeAddressBook {
+	return _NodeAddressBookFromProtobuf(&services.NodeAddressBook{
+		NodeAddress: []*services.NodeAddress{
+			{
+				NodeAccountId:   &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 4}},
+				NodeCertHash:    []byte("abcd"),
+				ServiceEndpoint: []*services.ServiceEndpoint{{IpAddressV4: []byte{10, 0, 0, 4}, Port: 50211}},
+			},
+			{
+				NodeAccountId:   &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 3}},
+				ServiceEndpoint: []*services.ServiceEndpoint{{IpAddressV4: []byte{10, 0, 0, 3}, Port: 50211}},
+			},
+		},
+	})
+}
+
+func TestUnitNetworkAddressBookPinsCustomLedger(t *testing.T) {
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+
+	client.SetLedgerID(*LedgerIDFromBytes([]byte{42}))
+	client.SetNetworkFromAddressBook(_NewUnitAddressBook())
+
+	require.Equal(t, map[string]AccountID{
+		"10.0.0.3:50211": {Account: 3},
+		"10.0.0.4:50211": {Account: 4},
+	}, client.GetNetwork())
+
+	node, ok := client.network._GetNodeForAccountID(AccountID{Account: 4})
+	require.True(t, ok)
+	require.Equal(t, []byte("abcd"), node._GetAddressBook().CertHash)
+	require.False(t, node._GetRequireCertHash())
+
+	// A node without a cert hash is not pinned
+	node, ok = client.network._GetNodeForAccountID(AccountID{Account: 3})
+	require.True(t, ok)
+	require.Nil(t, node._GetAddressBook())
+
+	client.SetRequireCertificateHash(true)
+	require.True(t, client.GetRequireCertificateHash())
+	require.True(t, node._GetRequireCertHash())
+
+	// The pins survive switching to TLS, and later nodes get them too
+	client.SetTransportSecurity(true)
+	for _, managed := range client.network.nodes {
+		node := managed.(*_Node)
+		require.True(t, node._GetRequireCertHash())
+		if node.accountID.Account == 4 {
+			require.Equal(t, []byte("abcd"), node._GetAddressBook().CertHash)
+		}
+	}
+}
+
+func TestUnitClientAddressBookCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "addressbook.pb")
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+
+	// Nothing is cached yet
+	require.NoError(t, client.SetAddressBookCachePath(path))
+	require.Equal(t, path, client.GetAddressBookCachePath())
+	require.Equal(t, map[string]AccountID{"127.0.0.1:50211": {Account: 3}}, client.GetNetwork())
+	_, err := os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	client.SetNetworkFromAddressBook(_NewUnitAddressBook())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	book, err := NodeAddressBookFromBytes(data)
+	require.NoError(t, err)
+	require.Len(t, book.NodeAddresses, 2)
+	require.Equal(t, "0.0.3", book.NodeAddresses[0].AccountID.String())
+
+	restarted := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer restarted.Close()
+
+	require.NoError(t, restarted.SetAddressBookCachePath(path))
+	require.Equal(t, client.GetNetwork(), restarted.GetNetwork())
+
+	node, ok := restarted.network._GetNodeForAccountID(AccountID{Account: 4})
+	require.True(t, ok)
+	require.Equal(t, []byte("abcd"), node._GetAddressBook().CertHash)
+}
+
+func TestUnitClientAddressBookCacheOlderThanNetwork(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "addressbook.pb")
+	require.NoError(t, _WriteAddressBookCache(path, _NodeAddressBookFromProtobuf(&services.NodeAddressBook{
+		NodeAddress: []*services.NodeAddress{
+			{
+				NodeAccountId:   &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 5}},
+				ServiceEndpoint: []*services.ServiceEndpoint{{IpAddressV4: []byte{10, 0, 0, 5}, Port: 50211}},
+			},
+		},
+	})))
+
+	// The client already has a book, as after the address book update of ClientForTestnet
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	client.SetNetworkFromAddressBook(_NewUnitAddressBook())
+
+	require.NoError(t, client.SetAddressBookCachePath(path))
+	require.Equal(t, map[string]AccountID{
+		"10.0.0.3:50211": {Account: 3},
+		"10.0.0.4:50211": {Account: 4},
+	}, client.GetNetwork())
+
+	// The current book replaces the older one in the cache
+	book, ok, err := _ReadAddressBookCache(path)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, book.NodeAddresses, 2)
+	require.Equal(t, "0.0.3", book.NodeAddresses[0].AccountID.String())
+	require.Equal(t, "0.0.4", book.NodeAddresses[1].AccountID.String())
+}
+
+func TestUnitClientAddressBookCacheInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "addressbook.pb")
+	require.NoError(t, os.WriteFile(path, []byte{0xff, 0xff}, 0o600))
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+
+	require.Error(t, client.SetAddressBookCachePath(path))
+	require.Equal(t, "", client.GetAddressBookCachePath())
+
+	// An empty book is ignored
+	require.NoError(t, os.WriteFile(path, []byte{}, 0o600))
+	require.NoError(t, client.SetAddressBookCachePath(path))
+	require.Equal(t, map[string]AccountID{"127.0.0.1:50211": {Account: 3}}, client.GetNetwork())
+}
+
+func TestUnitClientFromConfigAddressBookCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "addressbook.pb")
+	config := []byte(fmt.Sprintf(`{"network": {"127.0.0.1:50211": "0.0.3"}, "mirrorNetwork": [], "addressBookCache": %q}`, path))
+
+	// Without a cache or a mirror node the configured network is kept
+	client, err := ClientFromConfig(config)
+	require.NoError(t, err)
+	defer client.Close()
+	require.Equal(t, path, client.GetAddressBookCachePath())
+	require.Equal(t, map[string]AccountID{"127.0.0.1:50211": {Account: 3}}, client.GetNetwork())
+
+	require.NoError(t, _WriteAddressBookCache(path, _NewUnitAddressBook()))
+
+	client, err = ClientFromConfig(config)
+	require.NoError(t, err)
+	defer client.Close()
+	require.Equal(t, map[string]AccountID{
+		"10.0.0.3:50211": {Account: 3},
+		"10.0.0.4:50211": {Account: 4},
+	}, client.GetNetwork())
+}
@@ -83,7 +83,7 @@ func (query *AddressBookQuery) GetMaxAttempts() uint64 {
 }
 
 func (query *AddressBookQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -108,8 +108,14 @@ func (query *AddressBookQuery) _Build() *mirror.AddressBookQuery {
 }
 
 func (query *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
+	return query.ExecuteWithContext(client._GetNetworkUpdateContext(), client)
+}
+
+// ExecuteWithContext executes the AddressBookQuery with the provided client. Cancelling the context
+// closes the mirror node stream and stops any further retries.
+func (query *AddressBookQuery) ExecuteWithContext(ctx context.Context, client *Client) (NodeAddressBook, error) {
 	var cancel func()
-	var ctx context.Context
+	var streamCtx context.Context
 	var subClientError error
 	err := query._ValidateNetworkOnIDs(client)
 	if err != nil {
@@ -139,7 +145,14 @@ func (query *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error)
 						subClient = nil
 
 						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
-						time.Sleep(time.Duration(delay) * time.Millisecond)
+						select {
+						case <-ctx.Done():
+							subClientError = ctx.Err()
+						case <-time.After(time.Duration(delay) * time.Millisecond):
+						}
+						if subClientError != nil {
+							break
+						}
 						query.attempt++
 					} else {
 						subClientError = grpcErr.Err()
@@ -154,9 +167,9 @@ func (query *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error)
 			}
 
 			if subClient == nil {
-				ctx, cancel = context.WithCancel(client.networkUpdateContext)
+				streamCtx, cancel = context.WithCancel(ctx)
 
-				subClient, err = (*channel).GetNodes(ctx, pb)
+				subClient, err = (*channel).GetNodes(streamCtx, pb)
 				if err != nil {
 					continue
 				}
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -45,7 +46,15 @@ var testnetNodes, _ = NodeAddressBookFromBytes(testnetAddress)
 
 // Client is the Hedera protocol wrapper for the SDK used by all
 // transaction and query types.
+//
+// A Client is safe for concurrent use, one Client can be shared by any number of goroutines
+// executing transactions and queries while its configuration and network are being updated.
 type Client struct {
+	// mu guards the configuration fields below, the network and mirror network have their own locks.
+	// It is a pointer because Client is passed by value to the ToStringWithChecksum methods; use
+	// _Mu, which also covers a zero value Client.
+	mu *sync.RWMutex
+
 	defaultMaxTransactionFee Hbar
 	defaultMaxQueryPayment   Hbar
 
@@ -61,19 +70,27 @@ type Client struct {
 	minBackoff time.Duration
 
 	requestTimeout             *time.Duration
+	addressBookCachePath       string
+	addressBookReceived        bool
 	defaultNetworkUpdatePeriod time.Duration
 	networkUpdateContext       context.Context
 	cancelNetworkUpdate        context.CancelFunc
+
+	interceptors []Interceptor
+	tracer       Tracer
+	meter        Meter
+	logger       Logger
 }
 
-// TransactionSigner is a closure or function that defines how transactions will be signed
+// TransactionSigner is a closure or function that defines how transactions will be signed. Signers
+// which can fail or need a context, such as remote ones, implement Signer instead.
 type TransactionSigner func(message []byte) []byte
 
 type _Operator struct {
 	accountID  AccountID
 	privateKey *PrivateKey
 	publicKey  PublicKey
-	signer     TransactionSigner
+	signer     Signer
 }
 
 var mainnetMirror = []string{"mainnet-public.mirrornode.hedera.com:443"}
@@ -117,6 +134,7 @@ func ClientForPreviewnet() *Client {
 func _NewClient(network _Network, mirrorNetwork []string, name NetworkName) *Client {
 	ctx, cancel := context.WithCancel(context.Background())
 	client := Client{
+		mu:                              &sync.RWMutex{},
 		defaultMaxQueryPayment:          NewHbar(1),
 		network:                         network,
 		mirrorNetwork:                   _NewMirrorNetwork(),
@@ -128,6 +146,7 @@ func _NewClient(network _Network, mirrorNetwork []string, name NetworkName) *Cli
 		defaultNetworkUpdatePeriod:      24 * time.Hour,
 		networkUpdateContext:            ctx,
 		cancelNetworkUpdate:             cancel,
+		logger:                          _NewDefaultLogger(),
 	}
 
 	client.SetMirrorNetwork(mirrorNetwork)
@@ -164,21 +183,49 @@ func (client *Client) _ScheduleNetworkUpdate(ctx context.Context, duration time.
 }
 
 func (client *Client) CancelScheduledNetworkUpdate() {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	client.cancelNetworkUpdate()
 }
 
 func (client *Client) SetNetworkUpdatePeriod(period time.Duration) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	client.defaultNetworkUpdatePeriod = period
-	client.CancelScheduledNetworkUpdate()
+	client.cancelNetworkUpdate()
 	client.networkUpdateContext, client.cancelNetworkUpdate = context.WithCancel(context.Background())
 	go client._ScheduleNetworkUpdate(client.networkUpdateContext, period)
 	return client
 }
 
+func (client *Client) _GetNetworkUpdateContext() context.Context {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	return client.networkUpdateContext
+}
+
 func (client *Client) GetNetworkUpdatePeriod() time.Duration {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.defaultNetworkUpdatePeriod
 }
 
+// _ZeroClientMu guards the clients that were not built by a constructor, such as a zero value Client.
+var _ZeroClientMu sync.RWMutex
+
+// _Mu returns the lock guarding the configuration of the client.
+func (client *Client) _Mu() *sync.RWMutex {
+	if client.mu == nil {
+		return &_ZeroClientMu
+	}
+
+	return client.mu
+}
+
 func ClientForName(name string) (*Client, error) {
 	switch name {
 	case string(NetworkNameTestnet):
@@ -188,7 +235,7 @@ func ClientForName(name string) (*Client, error) {
 	case string(NetworkNameMainnet):
 		return ClientForMainnet(), nil
 	default:
-		return &Client{}, fmt.Errorf("%q is not recognized as a valid Hedera _Network", name)
+		return nil, fmt.Errorf("%q is not recognized as a valid Hedera _Network", name)
 	}
 }
 
@@ -199,9 +246,10 @@ type _ConfigOperator struct {
 
 // TODO: Implement complete spec: https://gitlab.com/launchbadge/hedera/sdk/python/-/issues/45
 type _ClientConfig struct {
-	Network       interface{}      `json:"network"`
-	MirrorNetwork interface{}      `json:"mirrorNetwork"`
-	Operator      *_ConfigOperator `json:"operator"`
+	Network          interface{}      `json:"network"`
+	MirrorNetwork    interface{}      `json:"mirrorNetwork"`
+	Operator         *_ConfigOperator `json:"operator"`
+	AddressBookCache string           `json:"addressBookCache"`
 }
 
 // ClientFromConfig takes in the byte slice representation of a JSON string or
@@ -225,16 +273,16 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 			case string:
 				accountID, err := AccountIDFromString(id)
 				if err != nil {
-					return client, err
+					return nil, err
 				}
 				networkAddresses[url] = accountID
 			default:
-				return client, errors.New("network is expected to be map of string to string, or string")
+				return nil, errors.New("network is expected to be map of string to string, or string")
 			}
 		}
 		err = network.SetNetwork(networkAddresses)
 		if err != nil {
-			return &Client{}, err
+			return nil, err
 		}
 	case string:
 		if len(net) > 0 {
@@ -248,7 +296,28 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 			}
 		}
 	default:
-		return client, errors.New("network is expected to be map of string to string, or string")
+		return nil, errors.New("network is expected to be map of string to string, or string")
+	}
+
+	// The operator is parsed before the client is created, so an invalid one does not leave a client behind
+	var operator *_Operator
+	if clientConfig.Operator != nil {
+		operatorID, err := AccountIDFromString(clientConfig.Operator.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		operatorKey, err := PrivateKeyFromString(clientConfig.Operator.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		operator = &_Operator{
+			accountID:  operatorID,
+			privateKey: &operatorKey,
+			publicKey:  operatorKey.PublicKey(),
+			signer:     NewLocalSigner(operatorKey),
+		}
 	}
 
 	switch mirror := clientConfig.MirrorNetwork.(type) {
@@ -259,7 +328,7 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 			case string:
 				arr[i] = str
 			default:
-				return client, errors.New("mirrorNetwork is expected to be either string or an array of strings")
+				return nil, errors.New("mirrorNetwork is expected to be either string or an array of strings")
 			}
 		}
 		client = _NewClient(network, arr, NetworkNameMainnet)
@@ -275,34 +344,22 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 			}
 		}
 	default:
-		return client, errors.New("mirrorNetwork is expected to be either string or an array of strings")
-	}
-
-	// if the _Operator is not provided, finish here
-	if clientConfig.Operator == nil {
-		return client, nil
+		return nil, errors.New("mirrorNetwork is expected to be either string or an array of strings")
 	}
 
-	operatorID, err := AccountIDFromString(clientConfig.Operator.AccountID)
-	if err != nil {
-		return client, err
-	}
-
-	operatorKey, err := PrivateKeyFromString(clientConfig.Operator.PrivateKey)
-
-	if err != nil {
-		return client, err
+	// The cache is set up once the client has asked the mirror node for the address book, so a
+	// cached book only replaces the configured nodes if the mirror node could not be reached
+	if clientConfig.AddressBookCache != "" && client != nil {
+		if err := client.SetAddressBookCachePath(clientConfig.AddressBookCache); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
 	}
 
-	operator := _Operator{
-		accountID:  operatorID,
-		privateKey: &operatorKey,
-		publicKey:  operatorKey.PublicKey(),
-		signer:     operatorKey.Sign,
+	if client != nil {
+		client.operator = operator
 	}
 
-	client.operator = &operator
-
 	return client, nil
 }
 
@@ -366,6 +423,9 @@ func (client *Client) GetMinNodeReadmitPeriod() time.Duration {
 }
 
 func (client *Client) SetMaxBackoff(max time.Duration) {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
 	} else if max.Nanoseconds() < client.minBackoff.Nanoseconds() {
@@ -375,10 +435,16 @@ func (client *Client) SetMaxBackoff(max time.Duration) {
 }
 
 func (client *Client) GetMaxBackoff() time.Duration {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.maxBackoff
 }
 
 func (client *Client) SetMinBackoff(min time.Duration) {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	if min.Nanoseconds() < 0 {
 		panic("minBackoff must be a positive duration")
 	} else if client.maxBackoff.Nanoseconds() < min.Nanoseconds() {
@@ -388,14 +454,23 @@ func (client *Client) SetMinBackoff(min time.Duration) {
 }
 
 func (client *Client) GetMinBackoff() time.Duration {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.minBackoff
 }
 
 func (client *Client) SetMaxAttempts(max int) {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	client.maxAttempts = &max
 }
 
 func (client *Client) GetMaxAttempts() int {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	if client.maxAttempts == nil {
 		return -1
 	}
@@ -403,6 +478,13 @@ func (client *Client) GetMaxAttempts() int {
 	return *client.maxAttempts
 }
 
+func (client *Client) _GetMaxAttempts() *int {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	return client.maxAttempts
+}
+
 func (client *Client) SetMaxNodeAttempts(max int) {
 	client.network._SetMaxNodeAttempts(max)
 }
@@ -467,6 +549,25 @@ func (client *Client) GetCertificateVerification() bool {
 	return client.network._GetVerifyCertificate()
 }
 
+// SetRequireCertificateHash makes the TLS handshake with a node fail when the address book has no
+// cert hash for it, instead of skipping the certificate check. Requiring a cert hash turns on
+// transport security and certificate verification; while it is required, nodes are never reached
+// over plaintext and their certificates are checked even if verification is turned off again.
+func (client *Client) SetRequireCertificateHash(require bool) *Client {
+	client.network._SetRequireCertHash(require)
+	if require {
+		client.network._SetTransportSecurity(true)
+		client.network._SetVerifyCertificate(true)
+	}
+
+	return client
+}
+
+// GetRequireCertificateHash returns true if nodes without a cert hash are rejected
+func (client *Client) GetRequireCertificateHash() bool {
+	return client.network._GetRequireCertHash()
+}
+
 // Deprecated: Use SetLedgerID instead
 func (client *Client) SetNetworkName(name NetworkName) {
 	client.network._SetNetworkName(name)
@@ -486,18 +587,30 @@ func (client *Client) GetLedgerID() *LedgerID {
 }
 
 func (client *Client) SetAutoValidateChecksums(validate bool) {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	client.autoValidateChecksums = validate
 }
 
 func (client *Client) GetAutoValidateChecksums() bool {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.autoValidateChecksums
 }
 
 func (client *Client) SetDefaultRegenerateTransactionIDs(regen bool) {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	client.defaultRegenerateTransactionIDs = regen
 }
 
 func (client *Client) GetDefaultRegenerateTransactionIDs() bool {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.defaultRegenerateTransactionIDs
 }
 
@@ -521,11 +634,14 @@ func (client *Client) GetNodeMaxReadmitPeriod() time.Duration {
 // transactions and queries built with the client and the associated key
 // with which to automatically sign transactions.
 func (client *Client) SetOperator(accountID AccountID, privateKey PrivateKey) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	client.operator = &_Operator{
 		accountID:  accountID,
 		privateKey: &privateKey,
 		publicKey:  privateKey.PublicKey(),
-		signer:     privateKey.Sign,
+		signer:     NewLocalSigner(privateKey),
 	}
 
 	return client
@@ -535,10 +651,30 @@ func (client *Client) SetOperator(accountID AccountID, privateKey PrivateKey) *C
 // transactions and queries built with the client, the account's PublicKey
 // and a callback that will be invoked when a transaction needs to be signed.
 func (client *Client) SetOperatorWith(accountID AccountID, publicKey PublicKey, signer TransactionSigner) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	client.operator = &_Operator{
 		accountID:  accountID,
 		privateKey: nil,
 		publicKey:  publicKey,
+		signer:     _NewTransactionSignerAdapter(publicKey, signer),
+	}
+
+	return client
+}
+
+// SetOperatorWithSigner sets that account that will, by default, be paying for
+// transactions and queries built with the client and the Signer, such as an HSM
+// or KMS backed one, that produces the account's signatures.
+func (client *Client) SetOperatorWithSigner(accountID AccountID, signer Signer) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
+	client.operator = &_Operator{
+		accountID:  accountID,
+		privateKey: nil,
+		publicKey:  signer.GetPublicKey(),
 		signer:     signer,
 	}
 
@@ -546,15 +682,31 @@ func (client *Client) SetOperatorWith(accountID AccountID, publicKey PublicKey,
 }
 
 func (client *Client) SetRequestTimeout(timeout *time.Duration) {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	client.requestTimeout = timeout
 }
 
 func (client *Client) GetRequestTimeout() *time.Duration {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.requestTimeout
 }
 
+func (client *Client) _GetOperator() *_Operator {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	return client.operator
+}
+
 // GetOperatorAccountID returns the ID for the _Operator
 func (client *Client) GetOperatorAccountID() AccountID {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	if client.operator != nil {
 		return client.operator.accountID
 	}
@@ -564,6 +716,9 @@ func (client *Client) GetOperatorAccountID() AccountID {
 
 // GetOperatorPublicKey returns the Key for the _Operator
 func (client *Client) GetOperatorPublicKey() PublicKey {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	if client.operator != nil {
 		return client.operator.publicKey
 	}
@@ -575,10 +730,15 @@ func (client *Client) GetOperatorPublicKey() PublicKey {
 // problems occur. Otherwise, an error representing the status of the _Node will
 // be returned.
 func (client *Client) Ping(nodeID AccountID) error {
+	return client.PingWithContext(context.Background(), nodeID)
+}
+
+// PingWithContext is Ping bounded by the provided context.
+func (client *Client) PingWithContext(ctx context.Context, nodeID AccountID) error {
 	_, err := NewAccountBalanceQuery().
 		SetNodeAccountIDs([]AccountID{nodeID}).
 		SetAccountID(client.GetOperatorAccountID()).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	return err
 }
@@ -589,12 +749,22 @@ func (client *Client) PingAll() {
 	}
 }
 
+// SetNetworkFromAddressBook replaces the nodes of the client with the ones in addressBook and pins
+// their certificates to its cert hashes. The book is persisted if an address book cache path is set.
 func (client *Client) SetNetworkFromAddressBook(addressBook NodeAddressBook) *Client {
+	client._Mu().Lock()
+	client.addressBookReceived = true
 	client.network._SetNetworkFromAddressBook(addressBook)
+	client._Mu().Unlock()
+
+	client._PersistAddressBook()
 	return client
 }
 
 func (client *Client) SetDefaultMaxQueryPayment(defaultMaxQueryPayment Hbar) error {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	if defaultMaxQueryPayment.AsTinybar() < 0 {
 		return errors.New("DefaultMaxQueryPayment must be non-negative")
 	}
@@ -604,10 +774,16 @@ func (client *Client) SetDefaultMaxQueryPayment(defaultMaxQueryPayment Hbar) err
 }
 
 func (client *Client) GetDefaultMaxQueryPayment() Hbar {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.defaultMaxQueryPayment
 }
 
 func (client *Client) SetDefaultMaxTransactionFee(defaultMaxTransactionFee Hbar) error {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
 	if defaultMaxTransactionFee.AsTinybar() < 0 {
 		return errors.New("DefaultMaxTransactionFee must be non-negative")
 	}
@@ -617,5 +793,8 @@ func (client *Client) SetDefaultMaxTransactionFee(defaultMaxTransactionFee Hbar)
 }
 
 func (client *Client) GetDefaultMaxTransactionFee() Hbar {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
 	return client.defaultMaxTransactionFee
 }
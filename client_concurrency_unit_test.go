@@ -0,0 +1,201 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+// These tests are meant to be run with -race, they hammer the client from many goroutines
+// while the network is being updated.
+
+func _MockAddressBookForNetwork(t *testing.T, network map[string]AccountID) NodeAddressBook {
+	addresses := make([]NodeAddress, 0)
+	for address, accountID := range network {
+		host, port, err := net.SplitHostPort(address)
+		require.NoError(t, err)
+		portNumber, err := strconv.Atoi(port)
+		require.NoError(t, err)
+
+		accountID := accountID
+		addresses = append(addresses, NodeAddress{
+			AccountID: &accountID,
+			Addresses: []_Endpoint{{
+				address: _Ipv4AddressFromProtobuf(net.ParseIP(host).To4()),
+				port:    int32(portNumber),
+			}},
+		})
+	}
+
+	return NodeAddressBook{NodeAddresses: addresses}
+}
+
+func TestUnitClientConcurrentExecuteWithNetworkUpdates(t *testing.T) {
+	const workers = 8
+	const transactionsPerWorker = 5
+
+	responses := make([]interface{}, 0, workers*transactionsPerWorker)
+	for i := 0; i < workers*transactionsPerWorker; i++ {
+		responses = append(responses, &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		})
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{responses, responses})
+	defer server.Close()
+
+	addressBook := _MockAddressBookForNetwork(t, client.GetNetwork())
+	mirrorNetwork := client.GetMirrorNetwork()
+
+	done := make(chan struct{})
+	updaterDone := make(chan struct{})
+	go func() {
+		defer close(updaterDone)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			client.SetNetworkFromAddressBook(addressBook)
+			require.NoError(t, client.SetNetwork(client.GetNetwork()))
+			client.SetMirrorNetwork(mirrorNetwork)
+			client.SetLedgerID(*NewLedgerIDMainnet())
+			client.SetCertificateVerification(client.GetCertificateVerification())
+			client.SetNodeMinBackoff(0)
+			client.SetMaxAttempts(10)
+			client.SetDefaultRegenerateTransactionIDs(true)
+			client.SetLogLevel(LoggerLevelDisabled)
+			client.SetTracer(NewInMemoryTracer())
+			client.SetMeter(NewInMemoryMeter())
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*transactionsPerWorker)
+	for i := 0; i < workers; i++ {
+		nodeAccountID := AccountID{Account: uint64(3 + i%2)}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < transactionsPerWorker; j++ {
+				_, err := NewTransferTransaction().
+					SetNodeAccountIDs([]AccountID{nodeAccountID}).
+					AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+					AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+					Execute(client)
+				errs <- err
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(done)
+	<-updaterDone
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestUnitNetworkConcurrentAccess(t *testing.T) {
+	nodes := make(map[string]AccountID)
+	for i := 0; i < 10; i++ {
+		nodes["127.0.0.1:"+strconv.Itoa(50211+i)] = AccountID{Account: uint64(3 + i)}
+	}
+
+	network := _NewNetwork()
+	network._SetNodeMinBackoff(0)
+	network._SetNodeMinReadmitPeriod(0)
+	network._SetNodeMaxReadmitPeriod(0)
+	network._SetLedgerID(*NewLedgerIDTestnet())
+	require.NoError(t, network.SetNetwork(nodes))
+
+	var wg sync.WaitGroup
+	run := func(f func(i int)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				f(i)
+			}
+		}()
+	}
+
+	run(func(int) { require.NoError(t, network.SetNetwork(nodes)) })
+	run(func(int) { network._SetLedgerID(*NewLedgerIDTestnet()) })
+	run(func(int) { require.NotNil(t, network._GetNetworkName()) })
+	run(func(int) { require.Len(t, network._GetNetwork(), len(nodes)) })
+	run(func(int) { network._GetNodeAccountIDsForExecute() })
+	run(func(int) { require.NotNil(t, network._GetNode()) })
+	run(func(i int) {
+		// Only ever back off two of the ten nodes so there is always a healthy node to pick
+		if node, ok := network._GetNodeForAccountID(AccountID{Account: uint64(3 + i%2)}); ok {
+			network._IncreaseBackoff(node)
+			node._DecreaseBackoff()
+			node._InUse()
+		}
+	})
+	run(func(int) {
+		network._SetVerifyCertificate(true)
+		network._SetMaxNodesPerTransaction(3)
+		network._SetNodeMaxBackoff(time.Second)
+	})
+
+	wg.Wait()
+
+	_, ok := network._GetNodeForAccountID(AccountID{Account: 99})
+	require.False(t, ok)
+}
+
+func TestUnitMirrorNetworkConcurrentAccess(t *testing.T) {
+	network := _NewMirrorNetwork()
+	addresses := []string{"127.0.0.1:5600", "127.0.0.1:5601"}
+	require.NoError(t, network._SetNetwork(addresses))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				require.NoError(t, network._SetNetwork(addresses))
+				require.Len(t, network._GetNetwork(), 2)
+				require.NotNil(t, network._GetNextMirrorNode())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -25,6 +25,7 @@ package hedera
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -69,6 +70,47 @@ func TestUnitClientFromConfigWrongType(t *testing.T) {
 	}
 }
 
+func TestUnitClientFromConfigInvalidNodeAddress(t *testing.T) {
+	client, err := ClientFromConfig([]byte(`{"network":{"not-an-address":"0.0.3"}}`))
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func TestUnitClientFromConfigErrorsReturnNil(t *testing.T) {
+	for _, config := range []string{
+		`{"network":{"127.0.0.1:50211":"not-an-id"},"mirrorNetwork":[]}`,
+		`{"network":{"127.0.0.1:50211":3},"mirrorNetwork":[]}`,
+		`{"network":3,"mirrorNetwork":[]}`,
+		`{"network":{"127.0.0.1:50211":"0.0.3"},"mirrorNetwork":[3]}`,
+		`{"network":{"127.0.0.1:50211":"0.0.3"},"mirrorNetwork":3}`,
+		`{"network":{"127.0.0.1:50211":"0.0.3"},"mirrorNetwork":[],"operator":{"accountId":"x","privateKey":"` + mockPrivateKey + `"}}`,
+		`{"network":{"127.0.0.1:50211":"0.0.3"},"mirrorNetwork":[],"operator":{"accountId":"0.0.2","privateKey":"x"}}`,
+	} {
+		client, err := ClientFromConfig([]byte(config))
+		assert.Error(t, err, config)
+		assert.Nil(t, client, config)
+	}
+
+	client, err := ClientForName("not-a-network")
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func TestUnitClientZeroValue(t *testing.T) {
+	var client Client
+
+	// The getters and setters lock the client, which must not panic before it is built
+	assert.NotPanics(t, func() {
+		assert.Equal(t, AccountID{}, client.GetOperatorAccountID())
+		assert.Empty(t, client.GetNetwork())
+		assert.Equal(t, time.Duration(0), client.GetMaxBackoff())
+		_ = client.GetLedgerID()
+
+		client.SetMaxAttempts(3)
+		assert.Equal(t, 3, client.GetMaxAttempts())
+	})
+}
+
 func TestUnitClientSetNetworkExtensive(t *testing.T) {
 	client := ClientForTestnet()
 	nodes := make(map[string]AccountID, 2)
@@ -0,0 +1,441 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ContractABI is a parsed Solidity JSON ABI. It encodes function calls and constructor parameters
+// from Go values, and decodes function results and event logs back into Go values or structs.
+//
+// Values follow the go-ethereum mapping: uintN/intN above 64 bits are *big.Int, address is
+// common.Address, bytesN is [N]byte, tuples are structs and arrays are slices or arrays.
+// Top level address arguments may also be given as an AccountID, ContractID, TokenID or hex string.
+type ContractABI struct {
+	abi abi.ABI
+}
+
+// ContractEvent is an event log decoded with a ContractABI.
+type ContractEvent struct {
+	// Name is the event name in the ABI, overloaded events get a numeric suffix
+	Name string
+	// Signature is the canonical event signature, for example Transfer(address,address,uint256)
+	Signature  string
+	ContractID ContractID
+	// Args holds the indexed and non indexed arguments by name
+	Args map[string]interface{}
+}
+
+// ContractCustomError is a custom Solidity error, which a contract reverted with, decoded with a ContractABI.
+type ContractCustomError struct {
+	// Name is the error name in the ABI
+	Name string
+	// Signature is the canonical error signature, for example InsufficientBalance(uint256,uint256)
+	Signature string
+	// Args holds the arguments by name
+	Args map[string]interface{}
+}
+
+// ContractABIFromJSON parses a Solidity JSON ABI, as emitted by solc or found in compiler artifacts.
+func ContractABIFromJSON(data []byte) (*ContractABI, error) {
+	return ContractABIFromReader(bytes.NewReader(data))
+}
+
+// ContractABIFromReader parses a Solidity JSON ABI from a reader.
+func ContractABIFromReader(reader io.Reader) (*ContractABI, error) {
+	parsed, err := abi.JSON(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractABI{abi: parsed}, nil
+}
+
+// GetFunctionNames returns the names of the functions in the ABI.
+func (contractABI *ContractABI) GetFunctionNames() []string {
+	names := make([]string, 0, len(contractABI.abi.Methods))
+	for name := range contractABI.abi.Methods {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// GetEventNames returns the names of the events in the ABI.
+func (contractABI *ContractABI) GetEventNames() []string {
+	names := make([]string, 0, len(contractABI.abi.Events))
+	for name := range contractABI.abi.Events {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// GetFunctionSelector returns the 4 byte selector of the named function.
+func (contractABI *ContractABI) GetFunctionSelector(name string) ([]byte, error) {
+	method, err := contractABI._GetMethod(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.ID, nil
+}
+
+// EncodeFunctionCall encodes a call to the named function, the result can be passed to
+// ContractExecuteTransaction.SetFunctionParameters() or ContractCallQuery.SetFunctionParameters().
+func (contractABI *ContractABI) EncodeFunctionCall(name string, args ...interface{}) ([]byte, error) {
+	method, err := contractABI._GetMethod(name)
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := method.Inputs.Pack(_ContractABIArguments(method.Inputs, args)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode arguments of %s: %w", method.Sig, err)
+	}
+
+	return append(append([]byte{}, method.ID...), packed...), nil
+}
+
+// EncodeConstructorParameters encodes the constructor arguments, the result can be passed to
+// ContractCreateTransaction.SetConstructorParametersRaw().
+func (contractABI *ContractABI) EncodeConstructorParameters(args ...interface{}) ([]byte, error) {
+	inputs := contractABI.abi.Constructor.Inputs
+
+	packed, err := inputs.Pack(_ContractABIArguments(inputs, args)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode constructor arguments: %w", err)
+	}
+
+	return packed, nil
+}
+
+// DecodeFunctionCall decodes encoded call data back into the function name and its arguments.
+func (contractABI *ContractABI) DecodeFunctionCall(data []byte) (string, []interface{}, error) {
+	if len(data) < 4 {
+		return "", nil, fmt.Errorf("call data of %d bytes is too short to contain a function selector", len(data))
+	}
+
+	method, err := contractABI.abi.MethodById(data[:4])
+	if err != nil {
+		return "", nil, err
+	}
+
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decode arguments of %s: %w", method.Sig, err)
+	}
+
+	return method.Name, args, nil
+}
+
+// DecodeFunctionResult decodes the return values of the named function.
+func (contractABI *ContractABI) DecodeFunctionResult(name string, data []byte) ([]interface{}, error) {
+	method, err := contractABI._GetMethod(name)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := method.Outputs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode result of %s: %w", method.Sig, err)
+	}
+
+	return values, nil
+}
+
+// DecodeFunctionResultInto decodes the return values of the named function into out, which must be
+// a pointer. A function with a single return value is decoded into a pointer of that value's type,
+// one with several return values into a pointer to a struct with a field per output. Tuples are
+// decoded into structs, with fields named after the components in camel case.
+func (contractABI *ContractABI) DecodeFunctionResultInto(name string, data []byte, out interface{}) error {
+	method, err := contractABI._GetMethod(name)
+	if err != nil {
+		return err
+	}
+
+	values, err := method.Outputs.Unpack(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode result of %s: %w", method.Sig, err)
+	}
+
+	target := reflect.ValueOf(out)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return fmt.Errorf("can't decode result of %s into non pointer %T", method.Sig, out)
+	}
+
+	if len(values) == 1 {
+		return _ContractABISet(target.Elem(), reflect.ValueOf(values[0]))
+	}
+
+	return _ContractABISetNamed(target.Elem(), method.Outputs, values)
+}
+
+// DecodeError decodes the revert data of a custom error declared in the ABI, using its first 4 bytes to find the error.
+func (contractABI *ContractABI) DecodeError(data []byte) (ContractCustomError, error) {
+	if len(data) < 4 {
+		return ContractCustomError{}, fmt.Errorf("revert data of %d bytes is too short to contain an error selector", len(data))
+	}
+
+	for _, customError := range contractABI.abi.Errors {
+		if !bytes.Equal(customError.ID[:4], data[:4]) {
+			continue
+		}
+
+		args := make(map[string]interface{})
+		if err := customError.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+			return ContractCustomError{}, fmt.Errorf("failed to decode arguments of %s: %w", customError.Sig, err)
+		}
+
+		return ContractCustomError{
+			Name:      customError.Name,
+			Signature: customError.Sig,
+			Args:      args,
+		}, nil
+	}
+
+	return ContractCustomError{}, fmt.Errorf("no error with selector 0x%x found in the ABI", data[:4])
+}
+
+// DecodeEvent decodes a log emitted by the contract, using the first topic to find the event.
+// Anonymous events can't be decoded since they have no signature topic.
+func (contractABI *ContractABI) DecodeEvent(log ContractLogInfo) (ContractEvent, error) {
+	event, err := contractABI._GetEventForLog(log)
+	if err != nil {
+		return ContractEvent{}, err
+	}
+
+	args := make(map[string]interface{})
+	if err = event.Inputs.NonIndexed().UnpackIntoMap(args, log.Data); err != nil {
+		return ContractEvent{}, fmt.Errorf("failed to decode data of %s: %w", event.Sig, err)
+	}
+
+	if err = abi.ParseTopicsIntoMap(args, _ContractABIIndexed(event.Inputs), _ContractABITopics(log.Topics[1:])); err != nil {
+		return ContractEvent{}, fmt.Errorf("failed to decode topics of %s: %w", event.Sig, err)
+	}
+
+	return ContractEvent{
+		Name:       event.Name,
+		Signature:  event.Sig,
+		ContractID: log.ContractID,
+		Args:       args,
+	}, nil
+}
+
+// DecodeEventInto decodes a log of the named event into out, which must be a pointer to a struct
+// whose fields match the event argument names.
+func (contractABI *ContractABI) DecodeEventInto(name string, log ContractLogInfo, out interface{}) error {
+	event, err := contractABI._GetEventForLog(log)
+	if err != nil {
+		return err
+	}
+
+	if event.Name != name {
+		return fmt.Errorf("log is a %s event, not %s", event.Name, name)
+	}
+
+	target := reflect.ValueOf(out)
+	if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%s event must be decoded into a pointer to a struct, not %T", event.Name, out)
+	}
+
+	if len(log.Data) > 0 {
+		values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil {
+			return fmt.Errorf("failed to decode data of %s: %w", event.Sig, err)
+		}
+
+		if err = _ContractABISetNamed(target.Elem(), event.Inputs.NonIndexed(), values); err != nil {
+			return err
+		}
+	}
+
+	return abi.ParseTopics(out, _ContractABIIndexed(event.Inputs), _ContractABITopics(log.Topics[1:]))
+}
+
+// DecodeEvents decodes every log whose signature topic belongs to an event of the ABI, logs of
+// other events, such as those emitted by contracts that were called along the way, are skipped.
+func (contractABI *ContractABI) DecodeEvents(logs []ContractLogInfo) ([]ContractEvent, error) {
+	events := make([]ContractEvent, 0, len(logs))
+	for _, log := range logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		if _, err := contractABI.abi.EventByID(common.BytesToHash(log.Topics[0])); err != nil {
+			continue
+		}
+
+		event, err := contractABI.DecodeEvent(log)
+		if err != nil {
+			return events, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (contractABI *ContractABI) _GetMethod(name string) (abi.Method, error) {
+	method, ok := contractABI.abi.Methods[name]
+	if !ok {
+		return abi.Method{}, fmt.Errorf("function %s not found in ABI", name)
+	}
+
+	return method, nil
+}
+
+func (contractABI *ContractABI) _GetEventForLog(log ContractLogInfo) (*abi.Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("log has no topics, anonymous events can't be decoded")
+	}
+
+	event, err := contractABI.abi.EventByID(common.BytesToHash(log.Topics[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// _ContractABIArguments converts top level Hedera entity IDs given for address parameters into
+// addresses, other values are passed through unchanged.
+func _ContractABIArguments(inputs abi.Arguments, args []interface{}) []interface{} {
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		converted[i] = arg
+		if i >= len(inputs) || inputs[i].Type.T != abi.AddressTy {
+			continue
+		}
+
+		switch id := arg.(type) {
+		case AccountID:
+			converted[i] = common.HexToAddress(id.ToSolidityAddress())
+		case *AccountID:
+			converted[i] = common.HexToAddress(id.ToSolidityAddress())
+		case ContractID:
+			converted[i] = common.HexToAddress(id.ToSolidityAddress())
+		case *ContractID:
+			converted[i] = common.HexToAddress(id.ToSolidityAddress())
+		case TokenID:
+			converted[i] = common.HexToAddress(id.ToSolidityAddress())
+		case *TokenID:
+			converted[i] = common.HexToAddress(id.ToSolidityAddress())
+		case string:
+			converted[i] = common.HexToAddress(id)
+		}
+	}
+
+	return converted
+}
+
+// _ContractABISetNamed copies decoded arguments into the fields of target named after them in camel case.
+func _ContractABISetNamed(target reflect.Value, arguments abi.Arguments, values []interface{}) error {
+	if target.Kind() != reflect.Struct {
+		return fmt.Errorf("%d values must be decoded into a struct, not %s", len(values), target.Type())
+	}
+
+	for i, argument := range arguments {
+		field := target.FieldByName(abi.ToCamelCase(argument.Name))
+		if argument.Name == "" || !field.IsValid() || !field.CanSet() {
+			return fmt.Errorf("%s has no settable field for value %d", target.Type(), i)
+		}
+
+		if err := _ContractABISet(field, reflect.ValueOf(values[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// _ContractABISet copies a decoded value into dst. go-ethereum decodes tuples into anonymous
+// structs, so structs are matched field by field on name, recursing into slices and arrays.
+func _ContractABISet(dst reflect.Value, src reflect.Value) error {
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+		return nil
+	case dst.Kind() == reflect.Ptr:
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		return _ContractABISet(dst.Elem(), src)
+	case dst.Kind() == reflect.Struct && src.Kind() == reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			name := src.Type().Field(i).Name
+			field := dst.FieldByName(name)
+			if !field.IsValid() || !field.CanSet() {
+				return fmt.Errorf("%s has no settable field %s", dst.Type(), name)
+			}
+			if err := _ContractABISet(field, src.Field(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case dst.Kind() == reflect.Slice && src.Kind() == reflect.Slice:
+		slice := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			if err := _ContractABISet(slice.Index(i), src.Index(i)); err != nil {
+				return err
+			}
+		}
+		dst.Set(slice)
+		return nil
+	case dst.Kind() == reflect.Array && src.Kind() == reflect.Array && dst.Len() == src.Len():
+		for i := 0; i < src.Len(); i++ {
+			if err := _ContractABISet(dst.Index(i), src.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("can't decode %s into %s", src.Type(), dst.Type())
+}
+
+func _ContractABIIndexed(inputs abi.Arguments) abi.Arguments {
+	indexed := make(abi.Arguments, 0, len(inputs))
+	for _, input := range inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+
+	return indexed
+}
+
+func _ContractABITopics(topics [][]byte) []common.Hash {
+	hashes := make([]common.Hash, len(topics))
+	for i, topic := range topics {
+		hashes[i] = common.BytesToHash(topic)
+	}
+
+	return hashes
+}
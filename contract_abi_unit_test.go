@@ -0,0 +1,202 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractABI = `[
+	{"type":"constructor","inputs":[{"name":"owner","type":"address"},{"name":"supply","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint64"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"getInfo","stateMutability":"view","inputs":[],
+		"outputs":[{"name":"name","type":"string"},{"name":"decimals","type":"uint8"},{"name":"amounts","type":"uint256[][]"}]},
+	{"type":"function","name":"getPosition","stateMutability":"view","inputs":[],
+		"outputs":[{"name":"position","type":"tuple","components":[
+			{"name":"holder","type":"address"},
+			{"name":"balances","type":"uint64[]"},
+			{"name":"label","type":"string"}]}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+func _MustParseTestContractABI(t *testing.T) *ContractABI {
+	contractABI, err := ContractABIFromJSON([]byte(testContractABI))
+	require.NoError(t, err)
+	return contractABI
+}
+
+func TestUnitContractABIEncodeFunctionCall(t *testing.T) {
+	contractABI := _MustParseTestContractABI(t)
+
+	to := AccountID{Account: 1234}
+	encoded, err := contractABI.EncodeFunctionCall("transfer", to, uint64(500))
+	require.NoError(t, err)
+
+	params, err := NewContractFunctionParameters().AddAddress(to.ToSolidityAddress())
+	require.NoError(t, err)
+	name := "transfer"
+	require.Equal(t, params.AddUint64(500)._Build(&name), encoded)
+
+	selector, err := contractABI.GetFunctionSelector("transfer")
+	require.NoError(t, err)
+	require.Equal(t, encoded[:4], selector)
+
+	decodedName, args, err := contractABI.DecodeFunctionCall(encoded)
+	require.NoError(t, err)
+	require.Equal(t, "transfer", decodedName)
+	require.Equal(t, []interface{}{common.HexToAddress(to.ToSolidityAddress()), uint64(500)}, args)
+
+	_, err = contractABI.EncodeFunctionCall("missing")
+	require.Error(t, err)
+
+	_, err = contractABI.EncodeFunctionCall("transfer", to, "not a number")
+	require.Error(t, err)
+}
+
+func TestUnitContractABIEncodeConstructorParameters(t *testing.T) {
+	contractABI := _MustParseTestContractABI(t)
+
+	encoded, err := contractABI.EncodeConstructorParameters(ContractID{Contract: 7}, big.NewInt(1000))
+	require.NoError(t, err)
+	require.Len(t, encoded, 64)
+	require.Equal(t, common.HexToAddress(ContractID{Contract: 7}.ToSolidityAddress()).Bytes(), encoded[12:32])
+	require.Equal(t, big.NewInt(1000), new(big.Int).SetBytes(encoded[32:64]))
+}
+
+func TestUnitContractABIDecodeFunctionResult(t *testing.T) {
+	contractABI := _MustParseTestContractABI(t)
+
+	amounts := [][]*big.Int{{big.NewInt(1), big.NewInt(2)}, {}, {big.NewInt(3)}}
+	data, err := contractABI.abi.Methods["getInfo"].Outputs.Pack("Token", uint8(8), amounts)
+	require.NoError(t, err)
+
+	values, err := ContractFunctionResult{ContractCallResult: data}.DecodeWithABI(contractABI, "getInfo")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"Token", uint8(8), amounts}, values)
+
+	var info struct {
+		Name     string
+		Decimals uint8
+		Amounts  [][]*big.Int
+	}
+	require.NoError(t, contractABI.DecodeFunctionResultInto("getInfo", data, &info))
+	require.Equal(t, "Token", info.Name)
+	require.Equal(t, uint8(8), info.Decimals)
+	require.Equal(t, amounts, info.Amounts)
+
+	_, err = contractABI.DecodeFunctionResult("getInfo", data[:40])
+	require.Error(t, err)
+}
+
+func TestUnitContractABIDecodeTupleResult(t *testing.T) {
+	contractABI := _MustParseTestContractABI(t)
+
+	type position struct {
+		Holder   common.Address
+		Balances []uint64
+		Label    string
+	}
+	expected := position{
+		Holder:   common.HexToAddress(AccountID{Account: 99}.ToSolidityAddress()),
+		Balances: []uint64{10, 20, 30},
+		Label:    "primary",
+	}
+
+	data, err := contractABI.abi.Methods["getPosition"].Outputs.Pack(expected)
+	require.NoError(t, err)
+
+	var decoded position
+	require.NoError(t, ContractFunctionResult{ContractCallResult: data}.DecodeWithABIInto(contractABI, "getPosition", &decoded))
+	require.Equal(t, expected, decoded)
+
+	var mismatched struct {
+		Label string
+	}
+	require.Error(t, contractABI.DecodeFunctionResultInto("getPosition", data, &mismatched))
+}
+
+func TestUnitContractABIDecodeEvent(t *testing.T) {
+	contractABI := _MustParseTestContractABI(t)
+
+	from := common.HexToAddress(AccountID{Account: 1001}.ToSolidityAddress())
+	to := common.HexToAddress(AccountID{Account: 1002}.ToSolidityAddress())
+	data, err := abi.Arguments{contractABI.abi.Events["Transfer"].Inputs[2]}.Pack(big.NewInt(42))
+	require.NoError(t, err)
+
+	log := ContractLogInfo{
+		ContractID: ContractID{Contract: 5},
+		Topics: [][]byte{
+			crypto.Keccak256([]byte("Transfer(address,address,uint256)")),
+			common.LeftPadBytes(from.Bytes(), 32),
+			common.LeftPadBytes(to.Bytes(), 32),
+		},
+		Data: data,
+	}
+
+	event, err := contractABI.DecodeEvent(log)
+	require.NoError(t, err)
+	require.Equal(t, "Transfer", event.Name)
+	require.Equal(t, "Transfer(address,address,uint256)", event.Signature)
+	require.Equal(t, ContractID{Contract: 5}, event.ContractID)
+	require.Equal(t, from, event.Args["from"])
+	require.Equal(t, to, event.Args["to"])
+	require.Equal(t, big.NewInt(42), event.Args["value"])
+
+	var transfer struct {
+		From  common.Address
+		To    common.Address
+		Value *big.Int
+	}
+	require.NoError(t, contractABI.DecodeEventInto("Transfer", log, &transfer))
+	require.Equal(t, from, transfer.From)
+	require.Equal(t, to, transfer.To)
+	require.Equal(t, big.NewInt(42), transfer.Value)
+
+	require.Error(t, contractABI.DecodeEventInto("Approval", log, &transfer))
+
+	unknown := ContractLogInfo{Topics: [][]byte{crypto.Keccak256([]byte("Other()"))}}
+	events, err := ContractFunctionResult{LogInfo: []ContractLogInfo{unknown, log, {}}}.DecodeEventsWithABI(contractABI)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "Transfer", events[0].Name)
+
+	_, err = contractABI.DecodeEvent(unknown)
+	require.Error(t, err)
+}
+
+func TestUnitContractABIInvalidJSON(t *testing.T) {
+	_, err := ContractABIFromJSON([]byte(`{"not":"an abi"`))
+	require.Error(t, err)
+}
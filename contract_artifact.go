@@ -0,0 +1,311 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ContractArtifact is a compiled contract loaded from the JSON artifact of solc, Hardhat, Truffle or Foundry.
+// It holds the ABI and the creation bytecode of the contract, links the libraries the bytecode refers to and
+// deploys it with a ContractCreateFlow.
+type ContractArtifact struct {
+	contractName     string
+	abi              *ContractABI
+	bytecode         string
+	deployedBytecode string
+	libraries        map[string]string
+}
+
+// _ContractArtifactBytecode is the bytecode of an artifact, either a hex string or, as in the standard JSON output
+// of solc and Foundry artifacts, an object holding it with the libraries it refers to.
+type _ContractArtifactBytecode struct {
+	Object         string                                `json:"object"`
+	LinkReferences map[string]map[string]json.RawMessage `json:"linkReferences"`
+}
+
+func (bytecode *_ContractArtifactBytecode) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &bytecode.Object)
+	}
+
+	type plain _ContractArtifactBytecode
+	return json.Unmarshal(data, (*plain)(bytecode))
+}
+
+type _ContractArtifactJSON struct {
+	ContractName     string                                `json:"contractName"`
+	ABI              json.RawMessage                       `json:"abi"`
+	Bytecode         _ContractArtifactBytecode             `json:"bytecode"`
+	DeployedBytecode _ContractArtifactBytecode             `json:"deployedBytecode"`
+	LinkReferences   map[string]map[string]json.RawMessage `json:"linkReferences"`
+	EVM              struct {
+		Bytecode         _ContractArtifactBytecode `json:"bytecode"`
+		DeployedBytecode _ContractArtifactBytecode `json:"deployedBytecode"`
+	} `json:"evm"`
+}
+
+// _ContractArtifactPlaceholderRegex matches the library placeholders of solc 0.5 and later, __$ followed by the
+// first 34 hex characters of the keccak256 hash of the fully qualified library name and $__
+var _ContractArtifactPlaceholderRegex = regexp.MustCompile(`__\$([0-9a-fA-F]{34})\$__`)
+
+// ContractArtifactFromJSON parses a compiler artifact. Hardhat and Truffle artifacts, Foundry artifacts and the
+// contract entries of the solc standard JSON output are supported.
+func ContractArtifactFromJSON(data []byte) (*ContractArtifact, error) {
+	return ContractArtifactFromReader(bytes.NewReader(data))
+}
+
+// ContractArtifactFromReader parses a compiler artifact from a reader.
+func ContractArtifactFromReader(reader io.Reader) (*ContractArtifact, error) {
+	var artifact _ContractArtifactJSON
+	if err := json.NewDecoder(reader).Decode(&artifact); err != nil {
+		return nil, fmt.Errorf("failed to parse contract artifact: %w", err)
+	}
+
+	if len(artifact.ABI) == 0 {
+		return nil, errContractArtifactNoABI
+	}
+
+	contractABI, err := ContractABIFromJSON(artifact.ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	bytecode, deployedBytecode := artifact.Bytecode, artifact.DeployedBytecode
+	if bytecode.Object == "" {
+		bytecode, deployedBytecode = artifact.EVM.Bytecode, artifact.EVM.DeployedBytecode
+	}
+
+	if bytecode.Object == "" || bytecode.Object == "0x" {
+		return nil, errContractArtifactNoBytecode
+	}
+
+	linkReferences := bytecode.LinkReferences
+	if linkReferences == nil {
+		linkReferences = artifact.LinkReferences
+	}
+
+	// the names of the libraries are only known from the link references, placeholders without one can still
+	// be linked by their fully qualified name
+	libraries := make(map[string]string)
+	for file, names := range linkReferences {
+		for name := range names {
+			fullyQualifiedName := file + ":" + name
+			libraries[_ContractArtifactPlaceholder(fullyQualifiedName)] = fullyQualifiedName
+		}
+	}
+
+	return &ContractArtifact{
+		contractName:     artifact.ContractName,
+		abi:              contractABI,
+		bytecode:         strings.TrimPrefix(bytecode.Object, "0x"),
+		deployedBytecode: strings.TrimPrefix(deployedBytecode.Object, "0x"),
+		libraries:        libraries,
+	}, nil
+}
+
+// GetContractName returns the name of the contract, if the artifact records it
+func (artifact *ContractArtifact) GetContractName() string {
+	return artifact.contractName
+}
+
+// GetABI returns the ABI of the contract
+func (artifact *ContractArtifact) GetABI() *ContractABI {
+	return artifact.abi
+}
+
+// GetBytecode returns the hex encoded creation bytecode, which still contains the placeholders of the
+// libraries that are not linked
+func (artifact *ContractArtifact) GetBytecode() string {
+	return artifact.bytecode
+}
+
+// GetDeployedBytecode returns the hex encoded runtime bytecode, if the artifact records it
+func (artifact *ContractArtifact) GetDeployedBytecode() string {
+	return artifact.deployedBytecode
+}
+
+// GetUnlinkedLibraries returns the fully qualified names, such as contracts/Math.sol:Math, of the libraries
+// the bytecode refers to which are not linked yet. Libraries without a link reference in the artifact are
+// returned as their placeholder.
+func (artifact *ContractArtifact) GetUnlinkedLibraries() []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+	for _, placeholder := range _ContractArtifactPlaceholderRegex.FindAllString(artifact.bytecode+artifact.deployedBytecode, -1) {
+		if seen[placeholder] {
+			continue
+		}
+		seen[placeholder] = true
+
+		if name, ok := artifact.libraries[placeholder]; ok {
+			names = append(names, name)
+		} else {
+			names = append(names, placeholder)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// IsLinked returns whether every library the bytecode refers to is linked
+func (artifact *ContractArtifact) IsLinked() bool {
+	return !_ContractArtifactPlaceholderRegex.MatchString(artifact.bytecode + artifact.deployedBytecode)
+}
+
+// Link returns a copy of the artifact with the libraries replaced by the EVM addresses of their deployed
+// contracts. Libraries are named by their fully qualified name, contracts/Math.sol:Math, or only by their
+// name, Math, when no other library the artifact refers to has the same name.
+func (artifact *ContractArtifact) Link(libraries map[string]ContractID) (*ContractArtifact, error) {
+	linked := *artifact
+	for name, contractID := range libraries {
+		placeholder, err := artifact._LibraryPlaceholder(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.Contains(artifact.bytecode+artifact.deployedBytecode, placeholder) {
+			return nil, fmt.Errorf("contract artifact does not refer to library %s", name)
+		}
+
+		address := hex.EncodeToString(_ContractIDEvmAddress(contractID))
+		linked.bytecode = strings.ReplaceAll(linked.bytecode, placeholder, address)
+		linked.deployedBytecode = strings.ReplaceAll(linked.deployedBytecode, placeholder, address)
+	}
+
+	return &linked, nil
+}
+
+// GetInitCode returns the linked creation bytecode followed by the constructor arguments encoded with the ABI,
+// the init code a CREATE or CREATE2 deployment runs
+func (artifact *ContractArtifact) GetInitCode(args ...interface{}) ([]byte, error) {
+	bytecode, parameters, err := artifact._Deployment(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bytecode, parameters...), nil
+}
+
+// ToContractCreateFlow returns a ContractCreateFlow deploying the linked bytecode with the constructor
+// arguments encoded with the ABI. The gas, admin key and other properties are set on the returned flow.
+func (artifact *ContractArtifact) ToContractCreateFlow(args ...interface{}) (*ContractCreateFlow, error) {
+	_, parameters, err := artifact._Deployment(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// the bytecode file of a contract holds the hex encoded bytecode
+	return NewContractCreateFlow().
+		SetBytecode([]byte(artifact.bytecode)).
+		SetConstructorParametersRaw(parameters), nil
+}
+
+func (artifact *ContractArtifact) _Deployment(args []interface{}) ([]byte, []byte, error) {
+	if !artifact.IsLinked() {
+		return nil, nil, fmt.Errorf("contract artifact has unlinked libraries: %s", strings.Join(artifact.GetUnlinkedLibraries(), ", "))
+	}
+
+	bytecode, err := hex.DecodeString(artifact.bytecode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode contract bytecode: %w", err)
+	}
+
+	parameters, err := artifact.abi.EncodeConstructorParameters(args...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bytecode, parameters, nil
+}
+
+func (artifact *ContractArtifact) _LibraryPlaceholder(name string) (string, error) {
+	if strings.Contains(name, ":") {
+		return _ContractArtifactPlaceholder(name), nil
+	}
+
+	placeholder := ""
+	for candidate, fullyQualifiedName := range artifact.libraries {
+		if fullyQualifiedName[strings.LastIndex(fullyQualifiedName, ":")+1:] != name {
+			continue
+		}
+
+		if placeholder != "" {
+			return "", fmt.Errorf("library name %s is ambiguous, use its fully qualified name", name)
+		}
+		placeholder = candidate
+	}
+
+	if placeholder == "" {
+		return "", fmt.Errorf("contract artifact does not refer to library %s", name)
+	}
+
+	return placeholder, nil
+}
+
+func _ContractArtifactPlaceholder(fullyQualifiedName string) string {
+	return "__$" + hex.EncodeToString(crypto.Keccak256([]byte(fullyQualifiedName)))[:34] + "$__"
+}
+
+// _ContractIDEvmAddress returns the EVM address of a contract, the long zero address of its ID if it has none
+func _ContractIDEvmAddress(contractID ContractID) []byte {
+	if contractID.EvmAddress != nil {
+		return contractID.EvmAddress
+	}
+
+	address, _ := hex.DecodeString(contractID.ToSolidityAddress())
+	return address
+}
+
+// ContractEvmAddressFromCreate returns the EVM address of a contract deployed with the CREATE opcode by the
+// contract or account with the 20 byte EVM address deployer, at its nonce
+func ContractEvmAddressFromCreate(deployer []byte, nonce uint64) ([]byte, error) {
+	if len(deployer) != common.AddressLength {
+		return nil, errContractDeployerInvalidAddress
+	}
+
+	return crypto.CreateAddress(common.BytesToAddress(deployer), nonce).Bytes(), nil
+}
+
+// ContractEvmAddressFromCreate2 returns the EVM address of a contract deployed with the CREATE2 opcode by the
+// contract with the 20 byte EVM address deployer, from a 32 byte salt and the init code, see
+// ContractArtifact.GetInitCode()
+func ContractEvmAddressFromCreate2(deployer []byte, salt []byte, initCode []byte) ([]byte, error) {
+	if len(deployer) != common.AddressLength {
+		return nil, errContractDeployerInvalidAddress
+	}
+
+	if len(salt) != common.HashLength {
+		return nil, errContractCreate2InvalidSalt
+	}
+
+	return crypto.CreateAddress2(common.BytesToAddress(deployer), common.BytesToHash(salt), crypto.Keccak256(initCode)).Bytes(), nil
+}
@@ -0,0 +1,203 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const _UnitArtifactABI = `[
+	{"type":"constructor","inputs":[{"name":"value","type":"uint256"},{"name":"owner","type":"address"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"value","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}
+]`
+
+func _UnitArtifactBytecode(placeholders ...string) string {
+	return "6080604052" + strings.Join(placeholders, "6000") + "600080fd"
+}
+
+func TestUnitContractArtifactHardhat(t *testing.T) {
+	math := _ContractArtifactPlaceholder("contracts/Math.sol:Math")
+	bytecode := _UnitArtifactBytecode(math, math)
+
+	artifact, err := ContractArtifactFromJSON([]byte(fmt.Sprintf(`{
+		"_format": "hh-sol-artifact-1",
+		"contractName": "Calculator",
+		"abi": %s,
+		"bytecode": "0x%s",
+		"deployedBytecode": "0x%s",
+		"linkReferences": {"contracts/Math.sol": {"Math": [{"length": 20, "start": 6}, {"length": 20, "start": 28}]}}
+	}`, _UnitArtifactABI, bytecode, _UnitArtifactBytecode(math))))
+	require.NoError(t, err)
+
+	require.Equal(t, "Calculator", artifact.GetContractName())
+	require.Equal(t, bytecode, artifact.GetBytecode())
+	require.Contains(t, artifact.GetABI().GetFunctionNames(), "value")
+	require.False(t, artifact.IsLinked())
+	require.Equal(t, []string{"contracts/Math.sol:Math"}, artifact.GetUnlinkedLibraries())
+
+	_, err = artifact.ToContractCreateFlow(big.NewInt(1), "0000000000000000000000000000000000000001")
+	require.ErrorContains(t, err, "contracts/Math.sol:Math")
+
+	linked, err := artifact.Link(map[string]ContractID{"Math": {Contract: 1234}})
+	require.NoError(t, err)
+	require.True(t, linked.IsLinked())
+	require.Empty(t, linked.GetUnlinkedLibraries())
+	require.Equal(t, _UnitArtifactBytecode("00000000000000000000000000000000000004d2", "00000000000000000000000000000000000004d2"), linked.GetBytecode())
+	require.Equal(t, _UnitArtifactBytecode("00000000000000000000000000000000000004d2"), linked.GetDeployedBytecode())
+
+	// linking returns a copy
+	require.False(t, artifact.IsLinked())
+
+	parameters, err := artifact.GetABI().EncodeConstructorParameters(big.NewInt(42), ContractID{Contract: 5})
+	require.NoError(t, err)
+
+	initCode, err := linked.GetInitCode(big.NewInt(42), ContractID{Contract: 5})
+	require.NoError(t, err)
+	expected, err := hex.DecodeString(linked.GetBytecode())
+	require.NoError(t, err)
+	require.Equal(t, append(expected, parameters...), initCode)
+
+	flow, err := linked.ToContractCreateFlow(big.NewInt(42), ContractID{Contract: 5})
+	require.NoError(t, err)
+	require.Equal(t, []byte(linked.GetBytecode()), flow.bytecode)
+	require.Equal(t, parameters, flow.GetConstructorParameters())
+
+	_, err = linked.ToContractCreateFlow(big.NewInt(42))
+	require.Error(t, err)
+}
+
+func TestUnitContractArtifactFoundry(t *testing.T) {
+	math := _ContractArtifactPlaceholder("src/Math.sol:Math")
+	evmAddress := []byte{0xab, 0xcd, 0xef, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+
+	artifact, err := ContractArtifactFromJSON([]byte(fmt.Sprintf(`{
+		"abi": %s,
+		"bytecode": {"object": "0x%s", "linkReferences": {"src/Math.sol": {"Math": [{"start": 6, "length": 20}]}}},
+		"deployedBytecode": {"object": "0x%s", "linkReferences": {}}
+	}`, _UnitArtifactABI, _UnitArtifactBytecode(math), _UnitArtifactBytecode())))
+	require.NoError(t, err)
+	require.Equal(t, []string{"src/Math.sol:Math"}, artifact.GetUnlinkedLibraries())
+
+	linked, err := artifact.Link(map[string]ContractID{"src/Math.sol:Math": {EvmAddress: evmAddress}})
+	require.NoError(t, err)
+	require.Equal(t, _UnitArtifactBytecode(hex.EncodeToString(evmAddress)), linked.GetBytecode())
+}
+
+func TestUnitContractArtifactSolc(t *testing.T) {
+	math := _ContractArtifactPlaceholder("Math.sol:Math")
+	other := _ContractArtifactPlaceholder("Other.sol:Math")
+
+	artifact, err := ContractArtifactFromJSON([]byte(fmt.Sprintf(`{
+		"abi": %s,
+		"evm": {
+			"bytecode": {"object": "%s", "linkReferences": {"Math.sol": {"Math": []}, "Other.sol": {"Math": []}}},
+			"deployedBytecode": {"object": "%s"}
+		}
+	}`, _UnitArtifactABI, _UnitArtifactBytecode(math, other), _UnitArtifactBytecode())))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Math.sol:Math", "Other.sol:Math"}, artifact.GetUnlinkedLibraries())
+
+	_, err = artifact.Link(map[string]ContractID{"Math": {Contract: 1}})
+	require.ErrorContains(t, err, "ambiguous")
+
+	_, err = artifact.Link(map[string]ContractID{"Missing.sol:Math": {Contract: 1}})
+	require.ErrorContains(t, err, "does not refer to library")
+
+	linked, err := artifact.Link(map[string]ContractID{"Math.sol:Math": {Contract: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Other.sol:Math"}, linked.GetUnlinkedLibraries())
+}
+
+func TestUnitContractArtifactWithoutLinkReferences(t *testing.T) {
+	math := _ContractArtifactPlaceholder("contracts/Math.sol:Math")
+
+	artifact, err := ContractArtifactFromJSON([]byte(fmt.Sprintf(`{"abi": %s, "bytecode": "0x%s"}`, _UnitArtifactABI, _UnitArtifactBytecode(math))))
+	require.NoError(t, err)
+	require.Equal(t, []string{math}, artifact.GetUnlinkedLibraries())
+
+	_, err = artifact.Link(map[string]ContractID{"Math": {Contract: 1}})
+	require.Error(t, err)
+
+	linked, err := artifact.Link(map[string]ContractID{"contracts/Math.sol:Math": {Contract: 1}})
+	require.NoError(t, err)
+	require.True(t, linked.IsLinked())
+}
+
+func TestUnitContractArtifactInvalid(t *testing.T) {
+	_, err := ContractArtifactFromJSON([]byte(`{"bytecode": "0x6080"}`))
+	require.ErrorIs(t, err, errContractArtifactNoABI)
+
+	_, err = ContractArtifactFromJSON([]byte(`{"abi": [], "bytecode": "0x"}`))
+	require.ErrorIs(t, err, errContractArtifactNoBytecode)
+
+	_, err = ContractArtifactFromJSON([]byte(`{"abi": [], "bytecode": 1}`))
+	require.Error(t, err)
+
+	artifact, err := ContractArtifactFromJSON([]byte(`{"abi": [], "bytecode": "0x60zz"}`))
+	require.NoError(t, err)
+	_, err = artifact.GetInitCode()
+	require.Error(t, err)
+}
+
+func TestUnitContractEvmAddressFromCreate(t *testing.T) {
+	deployer, err := hex.DecodeString("6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	require.NoError(t, err)
+
+	address, err := ContractEvmAddressFromCreate(deployer, 0)
+	require.NoError(t, err)
+	require.Equal(t, "cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d", hex.EncodeToString(address))
+
+	address, err = ContractEvmAddressFromCreate(deployer, 1)
+	require.NoError(t, err)
+	require.Equal(t, "343c43a37d37dff08ae8c4a11544c718abb4fcf8", hex.EncodeToString(address))
+
+	_, err = ContractEvmAddressFromCreate(deployer[:19], 0)
+	require.ErrorIs(t, err, errContractDeployerInvalidAddress)
+}
+
+func TestUnitContractEvmAddressFromCreate2(t *testing.T) {
+	// examples of EIP-1014
+	deployer, err := hex.DecodeString("deadbeef00000000000000000000000000000000")
+	require.NoError(t, err)
+
+	address, err := ContractEvmAddressFromCreate2(make([]byte, 20), make([]byte, 32), []byte{0})
+	require.NoError(t, err)
+	require.Equal(t, "4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38", hex.EncodeToString(address))
+
+	address, err = ContractEvmAddressFromCreate2(deployer, make([]byte, 32), []byte{0})
+	require.NoError(t, err)
+	require.Equal(t, "b928f69bb1d91cd65274e3c79d8986362984fda3", hex.EncodeToString(address))
+
+	_, err = ContractEvmAddressFromCreate2(deployer, make([]byte, 31), []byte{0})
+	require.ErrorIs(t, err, errContractCreate2InvalidSalt)
+
+	_, err = ContractEvmAddressFromCreate2(deployer[:4], make([]byte, 32), []byte{0})
+	require.ErrorIs(t, err, errContractDeployerInvalidAddress)
+}
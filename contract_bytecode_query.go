@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -62,7 +63,7 @@ func (query *ContractBytecodeQuery) GetContractID() ContractID {
 }
 
 func (query *ContractBytecodeQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -90,7 +91,13 @@ func (query *ContractBytecodeQuery) _Build() *services.Query_ContractGetBytecode
 }
 
 func (query *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *ContractBytecodeQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -102,7 +109,7 @@ func (query *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -117,6 +124,7 @@ func (query *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractBytecodeQueryShouldRetry,
@@ -141,8 +149,8 @@ func (query *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _ContractBytecodeQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetContractGetBytecodeResponse().Header.NodeTransactionPrecheckCode))
+func _ContractBytecodeQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetContractGetBytecodeResponse().Header.NodeTransactionPrecheckCode))
 }
 
 func _ContractBytecodeQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -158,7 +166,13 @@ func _ContractBytecodeQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the ContractBytecodeQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *ContractBytecodeQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]byte, error) {
+	if client == nil || client._GetOperator() == nil {
 		return make([]byte, 0), errNoClientProvided
 	}
 
@@ -170,7 +184,7 @@ func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -183,7 +197,7 @@ func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []byte{}, err
 		}
@@ -202,14 +216,14 @@ func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			if err != nil {
 				return []byte{}, err
 			}
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return []byte{}, err
 		}
@@ -223,6 +237,7 @@ func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractBytecodeQueryShouldRetry,
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -127,7 +128,7 @@ func (query *ContractCallQuery) GetFunctionParameters() []byte {
 }
 
 func (query *ContractCallQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -170,7 +171,13 @@ func (query *ContractCallQuery) _Build() *services.Query_ContractCallLocal {
 }
 
 func (query *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *ContractCallQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -182,7 +189,7 @@ func (query *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -197,6 +204,7 @@ func (query *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractCallQueryShouldRetry,
@@ -221,14 +229,27 @@ func (query *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _ContractCallQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetContractCallLocal().Header.NodeTransactionPrecheckCode))
+func _ContractCallQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetContractCallLocal().Header.NodeTransactionPrecheckCode))
 }
 
 func _ContractCallQueryMapStatusError(_ interface{}, response interface{}) error {
-	return ErrHederaPreCheckStatus{
-		Status: Status(response.(*services.Response).GetContractCallLocal().Header.NodeTransactionPrecheckCode),
+	callLocal := response.(*services.Response).GetContractCallLocal()
+	statusErr := ErrHederaPreCheckStatus{
+		Status: Status(callLocal.Header.NodeTransactionPrecheckCode),
 	}
+
+	if statusErr.Status != StatusContractRevertExecuted {
+		return statusErr
+	}
+
+	var result *ContractFunctionResult
+	if callLocal.FunctionResult != nil {
+		functionResult := _ContractFunctionResultFromProtobuf(callLocal.FunctionResult)
+		result = &functionResult
+	}
+
+	return _NewErrContractRevert(statusErr, statusErr.Status, nil, result)
 }
 
 func _ContractCallQueryGetMethod(_ interface{}, channel *_Channel) _Method {
@@ -238,7 +259,13 @@ func _ContractCallQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the ContractCallQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *ContractCallQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractFunctionResult, error) {
+	if client == nil || client._GetOperator() == nil {
 		return ContractFunctionResult{}, errNoClientProvided
 	}
 
@@ -250,7 +277,7 @@ func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult,
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -263,7 +290,7 @@ func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult,
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return ContractFunctionResult{}, err
 		}
@@ -282,12 +309,12 @@ func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult,
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return ContractFunctionResult{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return ContractFunctionResult{}, err
 		}
@@ -301,6 +328,7 @@ func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult,
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractCallQueryShouldRetry,
@@ -559,7 +559,8 @@ func TestIntegrationContractCallQueryNoFunction(t *testing.T) {
 		Execute(env.Client)
 	assert.Error(t, err)
 	if err != nil {
-		assert.Equal(t, "exceptional precheck status CONTRACT_REVERT_EXECUTED", err.Error())
+		assert.ErrorAs(t, err, &ErrContractRevert{})
+		assert.Contains(t, err.Error(), "exceptional precheck status CONTRACT_REVERT_EXECUTED")
 	}
 
 	resp, err = NewContractDeleteTransaction().
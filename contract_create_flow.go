@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"encoding/hex"
 	"time"
 
@@ -328,14 +329,20 @@ func (this *ContractCreateFlow) _CreateTransactionReceiptQuery(response Transact
 }
 
 func (this *ContractCreateFlow) Execute(client *Client) (TransactionResponse, error) {
+	return this.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the ContractCreateFlow with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (this *ContractCreateFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	this._SplitBytecode()
 
 	if len(this.appendBytecode) > 0 {
-		fileCreateResponse, err := this._CreateFileCreateTransaction(client).Execute(client)
+		fileCreateResponse, err := this._CreateFileCreateTransaction(client).ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
-		fileCreateReceipt, err := this._CreateTransactionReceiptQuery(fileCreateResponse).Execute(client)
+		fileCreateReceipt, err := this._CreateTransactionReceiptQuery(fileCreateResponse).ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
@@ -344,21 +351,21 @@ func (this *ContractCreateFlow) Execute(client *Client) (TransactionResponse, er
 		}
 		fileID := *fileCreateReceipt.FileID
 
-		fileAppendResponse, err := this._CreateFileAppendTransaction(fileID).Execute(client)
+		fileAppendResponse, err := this._CreateFileAppendTransaction(fileID).ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
 
-		_, err = this._CreateTransactionReceiptQuery(fileAppendResponse).Execute(client)
+		_, err = this._CreateTransactionReceiptQuery(fileAppendResponse).ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
 
-		contractCreateResponse, err := this._CreateContractCreateTransaction(fileID).Execute(client)
+		contractCreateResponse, err := this._CreateContractCreateTransaction(fileID).ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
-		_, err = this._CreateTransactionReceiptQuery(contractCreateResponse).Execute(client)
+		_, err = this._CreateTransactionReceiptQuery(contractCreateResponse).ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
@@ -366,12 +373,12 @@ func (this *ContractCreateFlow) Execute(client *Client) (TransactionResponse, er
 		return contractCreateResponse, nil
 	}
 
-	contractCreateResponse, err := this._CreateContractCreateTransactionWithBytecode().Execute(client)
+	contractCreateResponse, err := this._CreateContractCreateTransactionWithBytecode().ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
 	_, err = this._CreateTransactionReceiptQuery(contractCreateResponse).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -233,7 +233,9 @@ func TestIntegrationContractExecuteTransactionNoFunction(t *testing.T) {
 	_, err = resp.SetValidateStatus(true).GetReceipt(env.Client)
 	assert.Error(t, err)
 	if err != nil {
-		assert.Equal(t, "exceptional receipt status: CONTRACT_REVERT_EXECUTED", err.Error())
+		assert.ErrorAs(t, err, &ErrContractRevert{})
+		assert.ErrorAs(t, err, &ErrHederaReceiptStatus{})
+		assert.Contains(t, err.Error(), "exceptional receipt status: CONTRACT_REVERT_EXECUTED")
 	}
 
 	resp, err = NewContractDeleteTransaction().
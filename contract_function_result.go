@@ -62,6 +62,21 @@ type ContractFunctionResult struct {
 	FunctionParameters   []byte
 }
 
+// DecodeWithABI decodes the result as the return values of the named function of the ABI
+func (result ContractFunctionResult) DecodeWithABI(contractABI *ContractABI, name string) ([]interface{}, error) {
+	return contractABI.DecodeFunctionResult(name, result.ContractCallResult)
+}
+
+// DecodeWithABIInto decodes the result as the return values of the named function of the ABI into out
+func (result ContractFunctionResult) DecodeWithABIInto(contractABI *ContractABI, name string, out interface{}) error {
+	return contractABI.DecodeFunctionResultInto(name, result.ContractCallResult, out)
+}
+
+// DecodeEventsWithABI decodes the logs emitted by the call which belong to events of the ABI
+func (result ContractFunctionResult) DecodeEventsWithABI(contractABI *ContractABI) ([]ContractEvent, error) {
+	return contractABI.DecodeEvents(result.LogInfo)
+}
+
 // GetBool gets a _Solidity bool from the result at the given index
 func (result ContractFunctionResult) GetBool(index uint64) bool {
 	return result.GetUint32(index) == 1
@@ -65,10 +65,10 @@ func ContractIDFromString(data string) (ContractID, error) {
 }
 
 func (id *ContractID) ValidateChecksum(client *Client) error {
-	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
+	if !id._IsZero() && client != nil && client.network._ManagedNetwork._GetLedgerID() != nil {
 		var tempChecksum _ParseAddressResult
 		var err error
-		if client.network.ledgerID != nil {
+		if client.network._ManagedNetwork._GetLedgerID() != nil {
 			tempChecksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Contract))
 		}
 		if err != nil {
@@ -82,7 +82,7 @@ func (id *ContractID) ValidateChecksum(client *Client) error {
 			return errChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
-			temp, _ := client.network.ledgerID.ToNetworkName()
+			temp, _ := client.network._ManagedNetwork._GetLedgerID().ToNetworkName()
 			return errors.New(fmt.Sprintf("network mismatch or wrong checksum given, given checksum: %s, correct checksum %s, network: %s",
 				*id.checksum,
 				tempChecksum.correctChecksum,
@@ -95,7 +95,7 @@ func (id *ContractID) ValidateChecksum(client *Client) error {
 
 // Deprecated
 func (id *ContractID) Validate(client *Client) error {
-	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
+	if !id._IsZero() && client != nil && client.network._ManagedNetwork._GetLedgerID() != nil {
 		tempChecksum, err := _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Contract))
 		if err != nil {
 			return err
@@ -108,7 +108,7 @@ func (id *ContractID) Validate(client *Client) error {
 			return errChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
-			temp, _ := client.network.ledgerID.ToNetworkName()
+			temp, _ := client.network._ManagedNetwork._GetLedgerID().ToNetworkName()
 			return errors.New(fmt.Sprintf("network mismatch or wrong checksum given, given checksum: %s, correct checksum %s, network: %s",
 				*id.checksum,
 				tempChecksum.correctChecksum,
@@ -167,7 +167,7 @@ func (id ContractID) ToStringWithChecksum(client Client) (string, error) {
 	}
 	var checksum _ParseAddressResult
 	var err error
-	if client.network.ledgerID != nil {
+	if client.network._ManagedNetwork._GetLedgerID() != nil {
 		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Contract))
 	}
 	if err != nil {
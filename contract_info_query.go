@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -65,7 +66,7 @@ func (query *ContractInfoQuery) GetContractID() ContractID {
 }
 
 func (query *ContractInfoQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -93,7 +94,13 @@ func (query *ContractInfoQuery) _Build() *services.Query_ContractGetInfo {
 }
 
 func (query *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *ContractInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -105,7 +112,7 @@ func (query *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -120,6 +127,7 @@ func (query *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractInfoQueryShouldRetry,
@@ -145,8 +153,8 @@ func (query *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _ContractInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetContractGetInfo().Header.NodeTransactionPrecheckCode))
+func _ContractInfoQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetContractGetInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _ContractInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -162,7 +170,13 @@ func _ContractInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the ContractInfoQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *ContractInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractInfo, error) {
+	if client == nil || client._GetOperator() == nil {
 		return ContractInfo{}, errNoClientProvided
 	}
 
@@ -174,7 +188,7 @@ func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -187,7 +201,7 @@ func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return ContractInfo{}, err
 		}
@@ -206,14 +220,14 @@ func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			if err != nil {
 				return ContractInfo{}, err
 			}
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			if err != nil {
 				return ContractInfo{}, err
@@ -229,6 +243,7 @@ func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractInfoQueryShouldRetry,
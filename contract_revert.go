@@ -0,0 +1,104 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var _ContractRevertErrorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+var _ContractRevertPanicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+// _ContractPanicNames are the meanings of the Panic(uint256) codes the Solidity compiler inserts
+var _ContractPanicNames = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "conversion to an invalid enum value",
+	0x22: "incorrectly encoded storage byte array",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "too much memory allocated",
+	0x51: "call to a zero initialized internal function",
+}
+
+// DecodeWithABI returns a copy of the revert with CustomError decoded from the revert data with the ABI of
+// the contract
+func (e ErrContractRevert) DecodeWithABI(contractABI *ContractABI) (ErrContractRevert, error) {
+	customError, err := contractABI.DecodeError(e.RevertData)
+	if err != nil {
+		return e, err
+	}
+
+	e.CustomError = &customError
+	return e, nil
+}
+
+func _NewErrContractRevert(statusErr error, status Status, contractID *ContractID, result *ContractFunctionResult) ErrContractRevert {
+	revert := ErrContractRevert{
+		Status:     status,
+		ContractID: contractID,
+		Result:     result,
+		err:        statusErr,
+	}
+
+	if result == nil {
+		return revert
+	}
+
+	if result.ContractID != nil {
+		revert.ContractID = result.ContractID
+	}
+
+	// nodes report the revert data as a hex string in the error message, older versions returned it as the
+	// result of the call or a plain text message
+	if data, err := hex.DecodeString(strings.TrimPrefix(result.ErrorMessage, "0x")); err == nil && strings.HasPrefix(result.ErrorMessage, "0x") {
+		revert.RevertData = data
+	} else if len(result.ContractCallResult) > 0 {
+		revert.RevertData = result.ContractCallResult
+	} else {
+		revert.Reason = result.ErrorMessage
+	}
+
+	switch {
+	case len(revert.RevertData) >= 4 && bytes.Equal(revert.RevertData[:4], _ContractRevertErrorSelector):
+		if reason, err := abi.UnpackRevert(revert.RevertData); err == nil {
+			revert.Reason = reason
+		}
+	case len(revert.RevertData) == 36 && bytes.Equal(revert.RevertData[:4], _ContractRevertPanicSelector):
+		revert.PanicCode = new(big.Int).SetBytes(revert.RevertData[4:])
+		revert.PanicName = "unknown panic code"
+		if revert.PanicCode.IsUint64() {
+			if name, ok := _ContractPanicNames[revert.PanicCode.Uint64()]; ok {
+				revert.PanicName = name
+			}
+		}
+	}
+
+	return revert
+}
@@ -0,0 +1,157 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func _UnitRevertData(signature string, args ...[]byte) []byte {
+	data := crypto.Keccak256([]byte(signature))[:4]
+	for _, arg := range args {
+		data = append(data, common.LeftPadBytes(arg, 32)...)
+	}
+
+	return data
+}
+
+func _UnitRevertReason(reason string) []byte {
+	name := "Error"
+	return NewContractFunctionParameters().AddString(reason)._Build(&name)
+}
+
+func TestUnitContractRevertReason(t *testing.T) {
+	data := _UnitRevertReason("insufficient balance")
+	statusErr := ErrHederaPreCheckStatus{Status: StatusContractRevertExecuted}
+
+	revert := _NewErrContractRevert(statusErr, StatusContractRevertExecuted, nil, &ContractFunctionResult{
+		ContractID:   &ContractID{Contract: 1234},
+		ErrorMessage: "0x" + hex.EncodeToString(data),
+	})
+
+	require.Equal(t, "insufficient balance", revert.Reason)
+	require.Equal(t, data, revert.RevertData)
+	require.Equal(t, ContractID{Contract: 1234}, *revert.ContractID)
+	require.Nil(t, revert.PanicCode)
+	require.Equal(t, "exceptional precheck status CONTRACT_REVERT_EXECUTED: contract reverted: insufficient balance", revert.Error())
+
+	var err error = revert
+	var precheckErr ErrHederaPreCheckStatus
+	require.True(t, errors.As(err, &precheckErr))
+	require.Equal(t, StatusContractRevertExecuted, precheckErr.Status)
+
+	// older nodes return the revert data as the result of the call
+	revert = _NewErrContractRevert(statusErr, StatusContractRevertExecuted, nil, &ContractFunctionResult{ContractCallResult: data})
+	require.Equal(t, "insufficient balance", revert.Reason)
+}
+
+func TestUnitContractRevertPanic(t *testing.T) {
+	revert := _NewErrContractRevert(nil, StatusContractRevertExecuted, nil, &ContractFunctionResult{
+		ErrorMessage: "0x" + hex.EncodeToString(_UnitRevertData("Panic(uint256)", []byte{0x11})),
+	})
+
+	require.Equal(t, big.NewInt(0x11), revert.PanicCode)
+	require.Equal(t, "arithmetic overflow or underflow", revert.PanicName)
+	require.Equal(t, "contract panicked with code 0x11: arithmetic overflow or underflow", revert.Error())
+
+	revert = _NewErrContractRevert(nil, StatusContractRevertExecuted, nil, &ContractFunctionResult{
+		ErrorMessage: "0x" + hex.EncodeToString(_UnitRevertData("Panic(uint256)", []byte{0x99})),
+	})
+	require.Equal(t, big.NewInt(0x99), revert.PanicCode)
+	require.Equal(t, "unknown panic code", revert.PanicName)
+}
+
+func TestUnitContractRevertCustomError(t *testing.T) {
+	contractABI, err := ContractABIFromJSON([]byte(`[
+		{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+		{"type":"error","name":"Unauthorized","inputs":[]}
+	]`))
+	require.NoError(t, err)
+
+	data := _UnitRevertData("InsufficientBalance(uint256,uint256)", []byte{5}, []byte{7})
+	revert := _NewErrContractRevert(nil, StatusContractRevertExecuted, nil, &ContractFunctionResult{
+		ErrorMessage: "0x" + hex.EncodeToString(data),
+	})
+	require.Empty(t, revert.Reason)
+	require.Equal(t, "contract reverted with custom error 0x"+hex.EncodeToString(data[:4]), revert.Error())
+
+	decoded, err := revert.DecodeWithABI(contractABI)
+	require.NoError(t, err)
+	require.Nil(t, revert.CustomError)
+	require.Equal(t, "InsufficientBalance", decoded.CustomError.Name)
+	require.Equal(t, "InsufficientBalance(uint256,uint256)", decoded.CustomError.Signature)
+	require.Equal(t, big.NewInt(5), decoded.CustomError.Args["available"])
+	require.Equal(t, big.NewInt(7), decoded.CustomError.Args["required"])
+	require.Equal(t, "contract reverted with InsufficientBalance(uint256,uint256)", decoded.Error())
+
+	customError, err := contractABI.DecodeError(_UnitRevertData("Unauthorized()"))
+	require.NoError(t, err)
+	require.Equal(t, "Unauthorized", customError.Name)
+
+	_, err = contractABI.DecodeError(_UnitRevertData("Missing()"))
+	require.Error(t, err)
+
+	_, err = contractABI.DecodeError([]byte{1})
+	require.Error(t, err)
+}
+
+func TestUnitContractRevertWithoutData(t *testing.T) {
+	revert := _NewErrContractRevert(nil, StatusContractRevertExecuted, nil, &ContractFunctionResult{ErrorMessage: "execution reverted"})
+	require.Equal(t, "execution reverted", revert.Reason)
+	require.Empty(t, revert.RevertData)
+
+	revert = _NewErrContractRevert(nil, StatusContractRevertExecuted, nil, &ContractFunctionResult{})
+	require.Equal(t, "contract reverted without a reason", revert.Error())
+}
+
+func TestUnitTransactionReceiptValidateStatusContractRevert(t *testing.T) {
+	receipt := TransactionReceipt{
+		Status:        StatusContractRevertExecuted,
+		ContractID:    &ContractID{Contract: 1234},
+		TransactionID: &TransactionID{AccountID: &AccountID{Account: 2}},
+	}
+
+	err := receipt.ValidateStatus(true)
+
+	var revert ErrContractRevert
+	require.True(t, errors.As(err, &revert))
+	require.Equal(t, ContractID{Contract: 1234}, *revert.ContractID)
+	require.Nil(t, revert.Result)
+
+	var receiptErr ErrHederaReceiptStatus
+	require.True(t, errors.As(err, &receiptErr))
+	require.Equal(t, AccountID{Account: 2}, *receiptErr.TxID.AccountID)
+
+	require.NoError(t, receipt.ValidateStatus(false))
+
+	receipt.Status = StatusInvalidSignature
+	require.IsType(t, ErrHederaReceiptStatus{}, receipt.ValidateStatus(true))
+}
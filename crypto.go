@@ -25,6 +25,7 @@ import (
 	"crypto/sha512"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/pem"
 	"io"
 	"math/big"
 	"strings"
@@ -342,52 +343,47 @@ func PrivateKeyFromSeedECDSAsecp256k1(seed []byte) (PrivateKey, error) {
 	}, nil
 }
 
+// PrivateKeyFromKeystore recovers a PrivateKey from an encrypted _Keystore encoded as a byte slice.
+// Both the SDK keystore format, holding an Ed25519 or ECDSA key, and Ethereum V3 keystores are supported.
 func PrivateKeyFromKeystore(ks []byte, passphrase string) (PrivateKey, error) {
-	key, err := _Ed25519PrivateKeyFromKeystore(ks, passphrase)
-	if err != nil {
-		return PrivateKey{}, err
-	}
-
-	return PrivateKey{
-		ed25519PrivateKey: key,
-	}, nil
+	return _ParseKeystore(ks, passphrase)
 }
 
-// PrivateKeyReadKeystore recovers an _Ed25519PrivateKey from an encrypted _Keystore file.
+// PrivateKeyReadKeystore recovers a PrivateKey from an encrypted _Keystore file.
 func PrivateKeyReadKeystore(source io.Reader, passphrase string) (PrivateKey, error) {
-	key, err := _Ed25519PrivateKeyReadKeystore(source, passphrase)
+	keystoreBytes, err := io.ReadAll(source)
 	if err != nil {
 		return PrivateKey{}, err
 	}
 
-	return PrivateKey{
-		ed25519PrivateKey: key,
-	}, nil
+	return PrivateKeyFromKeystore(keystoreBytes, passphrase)
 }
 
+// PrivateKeyFromPem recovers an Ed25519 or ECDSA PrivateKey from a PEM encoded PKCS#8 key, which
+// is decrypted with the passphrase when it is an ENCRYPTED PRIVATE KEY. SEC1 EC PRIVATE KEY blocks
+// are accepted for ECDSA keys as well.
 func PrivateKeyFromPem(bytes []byte, passphrase string) (PrivateKey, error) {
-	key, err := _Ed25519PrivateKeyFromPem(bytes, passphrase)
-	if err != nil {
-		return PrivateKey{}, err
-	}
-
-	return PrivateKey{
-		ed25519PrivateKey: key,
-	}, nil
+	return _PrivateKeyFromPem(bytes, passphrase)
 }
 
+// PrivateKeyReadPem recovers a PrivateKey from a PEM file, see PrivateKeyFromPem.
 func PrivateKeyReadPem(source io.Reader, passphrase string) (PrivateKey, error) {
-	// note: Passphrases are currently not supported, but included in the function definition to avoid breaking
-	// changes in the future.
-
-	key, err := _Ed25519PrivateKeyReadPem(source, passphrase)
+	pemFileBytes, err := io.ReadAll(source)
 	if err != nil {
 		return PrivateKey{}, err
 	}
 
-	return PrivateKey{
-		ed25519PrivateKey: key,
-	}, nil
+	return PrivateKeyFromPem(pemFileBytes, passphrase)
+}
+
+// PublicKeyFromPem recovers a PublicKey from a PEM encoded SubjectPublicKeyInfo.
+func PublicKeyFromPem(bytes []byte) (PublicKey, error) {
+	return _PublicKeyFromPem(bytes)
+}
+
+// PublicKeyFromBytesSpki recovers a PublicKey from a DER encoded SubjectPublicKeyInfo.
+func PublicKeyFromBytesSpki(bytes []byte) (PublicKey, error) {
+	return _PublicKeyFromSpki(bytes)
 }
 
 // The use of raw bytes for a Ed25519 public key is deprecated; use PublicKeyFromStringEd25519/ECDSA() instead.
@@ -710,6 +706,23 @@ func (pk PublicKey) BytesRaw() []byte {
 	return []byte{}
 }
 
+// BytesSpki returns the key as a DER encoded SubjectPublicKeyInfo. For Ed25519 this is identical to
+// BytesDer(), ECDSA keys use the standard id-ecPublicKey encoding with the uncompressed point.
+func (pk PublicKey) BytesSpki() []byte {
+	return _PublicKeyToSpki(pk)
+}
+
+// ToPem returns the key as a PEM encoded SubjectPublicKeyInfo.
+func (pk PublicKey) ToPem() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: _PemPublicKeyType, Bytes: pk.BytesSpki()})
+}
+
+// WritePem writes the key to the provided destination, see ToPem.
+func (pk PublicKey) WritePem(destination io.Writer) error {
+	_, err := destination.Write(pk.ToPem())
+	return err
+}
+
 func (pk PublicKey) BytesDer() []byte {
 	if pk.ecdsaPublicKey != nil {
 		return pk.ecdsaPublicKey._BytesDer()
@@ -727,7 +740,11 @@ func (sk PrivateKey) Keystore(passphrase string) ([]byte, error) {
 		return sk.ed25519PrivateKey._Keystore(passphrase)
 	}
 
-	return []byte{}, errors.New("only ed25519 keystore is supported right now")
+	if sk.ecdsaPrivateKey != nil {
+		return sk.ecdsaPrivateKey._Keystore(passphrase)
+	}
+
+	return []byte{}, errors.New("key type not supported, only ed25519 and ECDSASecp256K1 are supported right now")
 }
 
 func (sk PrivateKey) WriteKeystore(destination io.Writer, passphrase string) error {
@@ -735,7 +752,29 @@ func (sk PrivateKey) WriteKeystore(destination io.Writer, passphrase string) err
 		return sk.ed25519PrivateKey._WriteKeystore(destination, passphrase)
 	}
 
-	return errors.New("only writing ed25519 keystore is supported right now")
+	if sk.ecdsaPrivateKey != nil {
+		return sk.ecdsaPrivateKey._WriteKeystore(destination, passphrase)
+	}
+
+	return errors.New("key type not supported, only ed25519 and ECDSASecp256K1 are supported right now")
+}
+
+// ToPem returns the key as a PEM encoded PKCS#8 PRIVATE KEY, or as an ENCRYPTED PRIVATE KEY
+// using PBES2 with PBKDF2-HMAC-SHA256 and AES-256-CBC when a passphrase is given.
+func (sk PrivateKey) ToPem(passphrase string) ([]byte, error) {
+	return _PrivateKeyToPem(sk, passphrase)
+}
+
+// WritePem writes the key to the provided destination, see ToPem.
+func (sk PrivateKey) WritePem(destination io.Writer, passphrase string) error {
+	pemBytes, err := sk.ToPem(passphrase)
+	if err != nil {
+		return err
+	}
+
+	_, err = destination.Write(pemBytes)
+
+	return err
 }
 
 // Sign signs the provided message with the Ed25519PrivateKey.
@@ -55,7 +55,7 @@ func _CustomFixedFeeFromProtobuf(fixedFee *services.FixedFee, customFee CustomFe
 }
 
 func (fee CustomFixedFee) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 	if fee.DenominationTokenID != nil {
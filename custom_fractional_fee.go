@@ -117,7 +117,7 @@ func _CustomFractionalFeeFromProtobuf(fractionalFee *services.FractionalFee, fee
 }
 
 func (fee CustomFractionalFee) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
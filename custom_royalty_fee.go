@@ -103,7 +103,7 @@ func _CustomRoyaltyFeeFromProtobuf(royalty *services.RoyaltyFee, fee CustomFee)
 }
 
 func (fee CustomRoyaltyFee) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums || fee.FallbackFee == nil {
+	if client == nil || !client.GetAutoValidateChecksums() || fee.FallbackFee == nil {
 		return nil
 	}
 
@@ -27,6 +27,7 @@ import (
 	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -211,6 +212,23 @@ func (sk _ECDSAPrivateKey) _BytesDer() []byte {
 	return append(prefix, sk._BytesRaw()...)
 }
 
+// _Keystore returns an encrypted _Keystore containing the _ECDSAPrivateKey.
+func (sk _ECDSAPrivateKey) _Keystore(passphrase string) ([]byte, error) {
+	return _NewKeystoreForKeyType(sk._BytesRaw(), _KeystoreKeyTypeECDSA, passphrase)
+}
+
+// _WriteKeystore writes an encrypted _Keystore containing the _ECDSAPrivateKey to the provided destination.
+func (sk _ECDSAPrivateKey) _WriteKeystore(destination io.Writer, passphrase string) error {
+	keystore, err := sk._Keystore(passphrase)
+	if err != nil {
+		return err
+	}
+
+	_, err = destination.Write(keystore)
+
+	return err
+}
+
 func (sk _ECDSAPrivateKey) _StringDer() string {
 	return fmt.Sprint(hex.EncodeToString(sk._BytesDer()))
 }
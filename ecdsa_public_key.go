@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"encoding/hex"
@@ -139,7 +140,7 @@ func (pk _ECDSAPublicKey) _VerifyTransaction(transaction Transaction) bool {
 		return false
 	}
 
-	_, _ = transaction._BuildAllTransactions()
+	_, _ = transaction._BuildAllTransactions(context.Background())
 
 	for _, value := range transaction.signedTransactions.slice {
 		tx := value.(*services.SignedTransaction)
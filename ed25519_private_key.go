@@ -26,14 +26,12 @@ import (
 	"crypto/rand"
 	"crypto/sha512"
 	"encoding/hex"
-	"encoding/pem"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/pkg/errors"
-	"github.com/youmark/pkcs8"
 )
 
 // _Ed25519PrivateKey is an ed25519 private key.
@@ -174,88 +172,6 @@ func _Ed25519PrivateKeyFromString(s string) (*_Ed25519PrivateKey, error) {
 	return _Ed25519PrivateKeyFromBytes(bytes)
 }
 
-// PrivateKeyFromKeystore recovers an _Ed25519PrivateKey from an encrypted _Keystore encoded as a byte slice.
-func _Ed25519PrivateKeyFromKeystore(ks []byte, passphrase string) (*_Ed25519PrivateKey, error) {
-	key, err := _ParseKeystore(ks, passphrase)
-	if err != nil {
-		return &_Ed25519PrivateKey{}, err
-	}
-
-	if key.ed25519PrivateKey != nil {
-		return key.ed25519PrivateKey, nil
-	}
-
-	return &_Ed25519PrivateKey{}, errors.New("only ed25519 keys are currently supported")
-}
-
-// PrivateKeyReadKeystore recovers an _Ed25519PrivateKey from an encrypted _Keystore file.
-func _Ed25519PrivateKeyReadKeystore(source io.Reader, passphrase string) (*_Ed25519PrivateKey, error) {
-	keystoreBytes, err := io.ReadAll(source)
-	if err != nil {
-		return &_Ed25519PrivateKey{}, err
-	}
-
-	return _Ed25519PrivateKeyFromKeystore(keystoreBytes, passphrase)
-}
-
-func _Ed25519PrivateKeyFromPem(bytes []byte, passphrase string) (*_Ed25519PrivateKey, error) {
-	var blockType string
-
-	if len(passphrase) == 0 {
-		blockType = "PRIVATE KEY"
-	} else {
-		// the pem is encrypted
-		blockType = "ENCRYPTED PRIVATE KEY"
-	}
-
-	var pk *pem.Block
-	for block, rest := pem.Decode(bytes); block != nil; {
-		if block.Type == blockType {
-			pk = block
-			break
-		}
-
-		bytes = rest
-		if len(bytes) == 0 {
-			// no key was found
-			return &_Ed25519PrivateKey{}, _NewErrBadKeyf("pem file did not contain a private key")
-		}
-	}
-
-	if pk == nil {
-		// no key was found
-		return &_Ed25519PrivateKey{}, _NewErrBadKeyf("no PEM data is found")
-	}
-
-	if len(passphrase) == 0 {
-		// key does not need decrypted, end here
-		key, err := PrivateKeyFromString(hex.EncodeToString(pk.Bytes))
-		if err != nil {
-			return &_Ed25519PrivateKey{}, err
-		}
-		return key.ed25519PrivateKey, nil
-	}
-
-	keyI, err := pkcs8.ParsePKCS8PrivateKey(pk.Bytes, []byte(passphrase))
-	if err != nil {
-		return &_Ed25519PrivateKey{}, err
-	}
-
-	return _Ed25519PrivateKeyFromBytes(keyI.(ed25519.PrivateKey))
-}
-
-func _Ed25519PrivateKeyReadPem(source io.Reader, passphrase string) (*_Ed25519PrivateKey, error) {
-	// note: Passphrases are currently not supported, but included in the function definition to avoid breaking
-	// changes in the future.
-
-	pemFileBytes, err := io.ReadAll(source)
-	if err != nil {
-		return &_Ed25519PrivateKey{}, err
-	}
-
-	return _Ed25519PrivateKeyFromPem(pemFileBytes, passphrase)
-}
-
 // _Ed25519PublicKey returns the _Ed25519PublicKey associated with this _Ed25519PrivateKey.
 func (sk _Ed25519PrivateKey) _PublicKey() *_Ed25519PublicKey {
 	return &_Ed25519PublicKey{
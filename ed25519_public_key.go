@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"bytes"
+	"context"
 	"crypto/ed25519"
 	"encoding/hex"
 	"fmt"
@@ -143,7 +144,7 @@ func (pk _Ed25519PublicKey) _VerifyTransaction(transaction Transaction) bool {
 		return false
 	}
 
-	_, _ = transaction._BuildAllTransactions()
+	_, _ = transaction._BuildAllTransactions(context.Background())
 
 	for _, value := range transaction.signedTransactions.slice {
 		tx := value.(*services.SignedTransaction)
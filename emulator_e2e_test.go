@@ -0,0 +1,174 @@
+//go:build all || e2e
+// +build all e2e
+
+package hedera_test
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashgraph/hedera-sdk-go/v2/hederatest"
+)
+
+// _EmulatorTests are the integration tests known to pass against hederatest.Emulator. The others use
+// transactions and queries it does not support, or rely on validation, fees and aliases it does not model.
+var _EmulatorTests = []string{
+	"TestIntegrationAccountBalanceQueryCanExecute",
+	"TestIntegrationAccountBalanceQueryCanGetTokenBalance",
+	"TestIntegrationAccountBalanceQueryGetCost",
+	"TestIntegrationAccountBalanceQuerySetBigMaxPayment",
+	"TestIntegrationAccountBalanceQuerySetSmallMaxPayment",
+	"TestIntegrationAccountBalanceQueryCanSetQueryPayment",
+	"TestIntegrationAccountBalanceQueryCostCanSetPaymentOneTinybar",
+	"TestIntegrationAccountBalanceQueryNoAccountIDError",
+	"TestIntegrationAccountCreateTransactionCanExecute",
+	"TestIntegrationAccountCreateTransactionCanFreezeModify",
+	"TestIntegrationAccountCreateTransactionAddSignature",
+	"TestIntegrationAccountDeleteTransactionCanExecute",
+	"TestIntegrationAccountDeleteTransactionNoSigning",
+	"TestIntegrationVerifySignatureFlowCanExecute",
+	"TestIntegrationVerifySignatureFlowKeyList",
+	"TestIntegrationAccountInfoQueryCanExecute",
+	"TestIntegrationAccountInfoQueryGetCost",
+	"TestIntegrationAccountInfoQuerySetBigMaxPayment",
+	"TestIntegrationAccountInfoQueryNoAccountID",
+	"TestIntegrationAccountStakersQueryCanExecute",
+	"TestIntegrationAccountStakersQueryNoAccountID",
+	"TestIntegrationFileAppendTransactionCanExecute",
+	"TestIntegrationFileAppendTransactionNoFileID",
+	"TestIntegrationFileAppendTransactionNothingSet",
+	"TestIntegrationFileContentsQueryCanExecute",
+	"TestIntegrationFileContentsQueryGetCost",
+	"TestIntegrationFileContentsQuerySetBigMaxPayment",
+	"TestIntegrationFileContentsQueryNoFileID",
+	"TestIntegrationFileCreateTransactionCanExecute",
+	"TestIntegrationFileCreateTransactionNoKey",
+	"TestIntegrationFileDeleteTransactionCanExecute",
+	"TestIntegrationFileDeleteTransactionNothingSet",
+	"TestIntegrationFileInfoQueryCanExecute",
+	"TestIntegrationFileInfoQueryGetCost",
+	"TestIntegrationFileInfoQuerySetBigMaxPayment",
+	"TestIntegrationFileInfoQueryInsufficientFee",
+	"TestIntegrationFileInfoQueryNoFileID",
+	"TestIntegrationLiveHashAddTransactionCanExecute",
+	"TestIntegrationLiveHashQueryCanExecute",
+	"TestIntegrationTokenAssociateTransactionCanExecute",
+	"TestIntegrationTokenAssociateTransactionNoTokenID",
+	"TestIntegrationTokenAssociateTransactionAutoAssociate",
+	"TestIntegrationTokenCreateTransactionCanExecute",
+	"TestIntegrationTokenCreateTransactionMultipleKeys",
+	"TestIntegrationTokenCreateTransactionAdminSign",
+	"TestIntegrationTokenCreateTransactionWithCustomFees",
+	"TestIntegrationTokenCreateTransactionWithRoyaltyCustomFee",
+	"TestIntegrationTokenAccountStillOwnsNfts",
+	"TestIntegrationTokenDeleteTransactionCanExecute",
+	"TestIntegrationTokenDeleteTransactionNoKeys",
+	"TestIntegrationTokenDissociateTransactionCanExecute",
+	"TestIntegrationTokenDissociateTransactionNoSigningOne",
+	"TestIntegrationTokenDissociateTransactionNoTokenID",
+	"TestIntegrationTokenInfoQueryGetCost",
+	"TestIntegrationTokenInfoQuerySetBigMaxPayment",
+	"TestIntegrationTokenInfoQueryNoTokenID",
+	"TestIntegrationTokenMintTransactionCanExecute",
+	"TestIntegrationTokenMintTransactionNoAmount",
+	"TestIntegrationTokenMintTransactionMaxReached",
+	"TestIntegrationCantTransferOnBehalfOfSpenderWithoutAllowanceApproval",
+	"TestIntegrationTokenNftGetInfoByNftIDCanExecute",
+	"TestIntegrationTopicCreateTransactionCanExecute",
+	"TestIntegrationTopicCreateTransactionDifferentKeys",
+	"TestIntegrationTopicCreateTransactionJustSetMemo",
+	"TestIntegrationTopicDeleteTransactionCanExecute",
+	"TestIntegrationTopicDeleteTransactionNoTopicID",
+	"TestIntegrationTopicInfoQueryCanExecute",
+	"TestIntegrationTopicInfoQueryGetCost",
+	"TestIntegrationTopicInfoQuerySetBigMaxPayment",
+	"TestIntegrationTopicInfoQueryInsufficientFee",
+	"TestIntegrationTopicInfoQueryThreshold",
+	"TestIntegrationTopicInfoQueryNoTopicID",
+	"TestIntegrationTransactionAddSignature",
+	"TestIntegrationTransactionSignTransaction",
+	"TestIntegrationTransactionGetHash",
+	"TestIntegrationTransactionReceiptQueryCanExecute",
+	"TestIntegrationTransactionRecordQueryCanExecute",
+	"TestIntegrationTransactionRecordQueryReceiptPaymentZero",
+	"TestIntegrationTransferTransactionCanTransferHbar",
+	"TestIntegrationTransferTransactionTransferHbarNothingSet",
+	"TestIntegrationTransferTransactionTransferHbarPositiveFlippedAmount",
+	"TestIntegrationTransferTransactionCanTransferFromBytes",
+	"TestIntegrationTransferTransactionCanTransferFromBytesAfter",
+	"TestIntegrationTransferTransactionCanTransferSignature",
+}
+
+// TestMain starts an in-process hederatest.Emulator when HEDERA_NETWORK is "emulator" and points the integration
+// tests at it through CONFIG_FILE, so they run without network access. Unless -run selects tests, only
+// _EmulatorTests run.
+func TestMain(m *testing.M) {
+	if os.Getenv("HEDERA_NETWORK") != "emulator" {
+		os.Exit(m.Run())
+	}
+
+	os.Exit(_RunWithEmulator(m))
+}
+
+func _RunWithEmulator(m *testing.M) int {
+	flag.Parse()
+	if run := flag.Lookup("test.run"); run != nil && run.Value.String() == "" {
+		quoted := make([]string, len(_EmulatorTests))
+		for i, name := range _EmulatorTests {
+			quoted[i] = regexp.QuoteMeta(name)
+		}
+		_ = run.Value.Set("^(" + strings.Join(quoted, "|") + ")$")
+	}
+
+	emulator, err := hederatest.NewEmulator(1)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to start the emulator:", err)
+		return 1
+	}
+	defer emulator.Close()
+
+	dir, err := os.MkdirTemp("", "hedera-emulator")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write the emulator client configuration:", err)
+		return 1
+	}
+	defer os.RemoveAll(dir)
+
+	config, err := emulator.ClientConfig()
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, "client.json"), config, 0o600)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write the emulator client configuration:", err)
+		return 1
+	}
+
+	os.Setenv("CONFIG_FILE", filepath.Join(dir, "client.json"))
+
+	return m.Run()
+}
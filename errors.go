@@ -23,6 +23,8 @@ package hedera
 import (
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 
 	// "reflect"
 
@@ -50,6 +52,36 @@ var errNetworkMismatch = errors.New("network mismatch; some IDs have different n
 var errNetworkNameMissing = errors.New("can't derive checksum for ID without knowing which _Network the ID is for")
 var errChecksumMissing = errors.New("no checksum provided")
 var errLockedSlice = errors.New("slice is locked")
+var errPipelineUnsupportedTransaction = errors.New("transaction pipeline requires a pointer to a transaction")
+var errFeeEstimatorUnsupportedTransaction = errors.New("fee estimator requires a pointer to a supported transaction")
+var errFeeEstimatorUnsupportedQuery = errors.New("fee estimator requires a pointer to a supported query")
+var errSigningSessionUnsupportedTransaction = errors.New("signing session requires a pointer to a frozen transaction")
+var errSigningSessionUnexpectedKey = errors.New("key is not required by the signing session")
+var errSigningSessionInvalidSignature = errors.New("signature does not verify against the transaction body")
+var errSigningSessionMismatch = errors.New("signing sessions hold different transactions or required keys")
+var errKeyRequirementUnsupportedTransaction = errors.New("key requirement requires a pointer to a transaction")
+var errDescribeUnsupportedTransaction = errors.New("transaction description requires one of the transaction types")
+var errDescriptionMismatch = errors.New("transaction description does not match the transaction body")
+var errFeeEstimatorInvalidExchangeRate = errors.New("fee estimator requires an exchange rate with positive hbar and cent values")
+var errFeeEstimatorOverflow = errors.New("estimated fee does not fit in an int64 of tinybars")
+var errNoCertHash = errors.New("no cert hash was found in the address book of the node")
+var errCertHashMismatch = errors.New("the certificate of the node does not match the cert hash in its address book")
+var errCertHashRequiresTLS = errors.New("a node which requires a cert hash is only reached over TLS")
+var errEthereumTransactionRequiresECDSA = errors.New("ethereum transactions must be signed with an ECDSA (secp256k1) private key")
+var errEthereumTransactionNoChainID = errors.New("ethereum transaction requires a chain ID")
+var errEthereumTransactionInvalidTo = errors.New("ethereum transaction recipient must be a 20 byte EVM address")
+var errEthereumTransactionInvalidAccessList = errors.New("ethereum access list entries need 20 byte addresses and 32 byte storage keys")
+var errEthereumTransactionLegacyAccessList = errors.New("legacy ethereum transactions cannot carry an access list")
+var errEthereumTransactionUnsupportedType = errors.New("unsupported ethereum transaction type")
+var errEthereumTransactionDataEmpty = errors.New("ethereum transaction data is empty")
+var errInterceptorSkippedRequest = errors.New("an interceptor returned without invoking the request")
+var errNetworkHasNoNodes = errors.New("the network of the client has no nodes")
+var errHbarInvalidDecimal = errors.New("invalid decimal hbar amount")
+var errHbarTooPrecise = errors.New("hbar amount is not a whole number of tinybar")
+var errContractArtifactNoABI = errors.New("contract artifact has no abi")
+var errContractArtifactNoBytecode = errors.New("contract artifact has no bytecode, abstract contracts and interfaces cannot be deployed")
+var errContractDeployerInvalidAddress = errors.New("contract deployer must be a 20 byte EVM address")
+var errContractCreate2InvalidSalt = errors.New("CREATE2 salt must be 32 bytes")
 
 type ErrInvalidNodeAccountIDSet struct {
 	NodeAccountID AccountID
@@ -162,6 +194,48 @@ func (e ErrHederaRecordStatus) Error() string {
 	return fmt.Sprintf("exceptional precheck status %s", e.Status.String())
 }
 
+// ErrContextDone is returned by the context aware execution methods, such as ExecuteWithContext and
+// GetCostWithContext, if the provided context is cancelled or its deadline passes before the request completes.
+type ErrContextDone struct {
+	// Either context.Canceled or context.DeadlineExceeded
+	Err error
+	// Number of attempts that were started before the context was done
+	Attempts int64
+	// The last error received from the network, if any
+	LastError error
+}
+
+// Error() implements the Error interface
+func (e ErrContextDone) Error() string {
+	if e.LastError != nil {
+		return fmt.Sprintf("request stopped after %d attempts: %s (last error: %s)", e.Attempts, e.Err, e.LastError)
+	}
+	return fmt.Sprintf("request stopped after %d attempts: %s", e.Attempts, e.Err)
+}
+
+// Unwrap returns the context error so errors.Is(err, context.Canceled) works as expected
+func (e ErrContextDone) Unwrap() error {
+	return e.Err
+}
+
+// ErrMirrorRest is returned by MirrorRestClient when the mirror node REST API responds with a non successful status
+type ErrMirrorRest struct {
+	// The HTTP status code of the response
+	StatusCode int
+	// The messages in the `_status` object of the response body, if any
+	Messages []string
+	// The URL of the request
+	URL string
+}
+
+// Error() implements the Error interface
+func (e ErrMirrorRest) Error() string {
+	if len(e.Messages) > 0 {
+		return fmt.Sprintf("mirror node request %s failed with status %d: %s", e.URL, e.StatusCode, strings.Join(e.Messages, "; "))
+	}
+	return fmt.Sprintf("mirror node request %s failed with status %d", e.URL, e.StatusCode)
+}
+
 // ErrLocalValidation is returned by TransactionBuilder.Build(*Client) and QueryBuilder.Execute(*Client)
 // if the constructed transaction or query fails local sanity checks.
 type ErrLocalValidation struct {
@@ -172,3 +246,112 @@ type ErrLocalValidation struct {
 func (e ErrLocalValidation) Error() string {
 	return e.message
 }
+
+// ErrTopicSequenceGap is reported to the running hash error handler of a TopicMessageQuery when the mirror node
+// skips one or more messages of the topic.
+type ErrTopicSequenceGap struct {
+	TopicID TopicID
+	// The sequence number of the next message in the verified chain
+	ExpectedSequenceNumber uint64
+	// The sequence number of the message that was received instead
+	SequenceNumber uint64
+}
+
+// Error() implements the Error interface
+func (e ErrTopicSequenceGap) Error() string {
+	return fmt.Sprintf("topic %s: expected message with sequence number %d, received %d", e.TopicID.String(), e.ExpectedSequenceNumber, e.SequenceNumber)
+}
+
+// ErrTopicRunningHashMismatch is reported to the running hash error handler of a TopicMessageQuery when the
+// running hash of a message differs from the one computed locally.
+type ErrTopicRunningHashMismatch struct {
+	TopicID        TopicID
+	SequenceNumber uint64
+	// The running hash computed locally
+	ExpectedRunningHash []byte
+	// The running hash reported by the mirror node
+	RunningHash []byte
+}
+
+// Error() implements the Error interface
+func (e ErrTopicRunningHashMismatch) Error() string {
+	return fmt.Sprintf("topic %s: running hash of message %d is %x, expected %x", e.TopicID.String(), e.SequenceNumber, e.RunningHash, e.ExpectedRunningHash)
+}
+
+// ErrTopicRunningHashUnverifiable is reported to the running hash error handler of a TopicMessageQuery when
+// the running hash of a message cannot be computed locally, because the mirror node did not report the payer
+// of the message or it uses an unsupported running hash version. It does not mean the message was tampered
+// with; verification continues from the running hash reported by the mirror node.
+type ErrTopicRunningHashUnverifiable struct {
+	TopicID        TopicID
+	SequenceNumber uint64
+	Reason         string
+}
+
+// Error() implements the Error interface
+func (e ErrTopicRunningHashUnverifiable) Error() string {
+	return fmt.Sprintf("topic %s: running hash of message %d cannot be verified: %s", e.TopicID.String(), e.SequenceNumber, e.Reason)
+}
+
+// ErrHbarOverflow is returned by the checked arithmetic and conversions of Hbar when the result does not fit in
+// the int64 number of tinybar an Hbar holds.
+type ErrHbarOverflow struct {
+	Operation string
+}
+
+// Error() implements the Error interface
+func (e ErrHbarOverflow) Error() string {
+	return fmt.Sprintf("hbar %s overflows the range of an int64 of tinybar", e.Operation)
+}
+
+// ErrContractRevert is returned when a contract call or create reverts with CONTRACT_REVERT_EXECUTED, by
+// ContractCallQuery, TransactionRecordQuery and receipts validated with TransactionResponse.GetReceipt.
+// It wraps the status error that would otherwise be returned, ErrHederaPreCheckStatus for queries and
+// ErrHederaReceiptStatus for receipts and records, so errors.As still finds it.
+type ErrContractRevert struct {
+	Status     Status
+	ContractID *ContractID
+	// The result of the call, nil when the revert is reported by a receipt, which does not carry it
+	Result *ContractFunctionResult
+	// The raw revert data returned by the contract
+	RevertData []byte
+	// The reason of a revert with Error(string), such as a failed require, or the plain text error message
+	// reported by the node
+	Reason string
+	// The code of a Panic(uint256), such as a failed assert or an arithmetic overflow, and what it means
+	PanicCode *big.Int
+	PanicName string
+	// The custom error the contract reverted with, only set by DecodeWithABI
+	CustomError *ContractCustomError
+	err         error
+}
+
+// Error() implements the Error interface
+func (e ErrContractRevert) Error() string {
+	var reason string
+	switch {
+	case e.CustomError != nil:
+		reason = fmt.Sprintf("contract reverted with %s", e.CustomError.Signature)
+	case e.PanicCode != nil:
+		reason = fmt.Sprintf("contract panicked with code 0x%x: %s", e.PanicCode, e.PanicName)
+	case e.Reason != "":
+		reason = fmt.Sprintf("contract reverted: %s", e.Reason)
+	case len(e.RevertData) >= 4:
+		reason = fmt.Sprintf("contract reverted with custom error 0x%x", e.RevertData[:4])
+	case e.Result == nil:
+		reason = "the revert reason is only reported in the record of the transaction"
+	default:
+		reason = "contract reverted without a reason"
+	}
+
+	if e.err == nil {
+		return reason
+	}
+
+	return fmt.Sprintf("%s: %s", e.err.Error(), reason)
+}
+
+// Unwrap returns the status error the revert was reported with
+func (e ErrContractRevert) Unwrap() error {
+	return e.err
+}
@@ -4,9 +4,12 @@
 package hedera
 
 import (
+	"bytes"
 	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +35,176 @@ func TestUnitEthereumJson(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, hex.EncodeToString(bytSecond), "02f87082012a022f2f83018000947e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181880de0b6b3a764000083123456c001a0df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479a01aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66")
 }
+
+func TestUnitEthereumDataGetters(t *testing.T) {
+	byt, err := hex.DecodeString("02f87082012a022f2f83018000947e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181880de0b6b3a764000083123456c001a0df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479a01aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66")
+	require.NoError(t, err)
+	data, err := EthereumTransactionDataFromBytes(byt)
+	require.NoError(t, err)
+
+	require.Equal(t, EthereumTransactionTypeDynamicFee, data.GetType())
+	require.Equal(t, big.NewInt(298), data.GetChainID())
+	require.Equal(t, uint64(2), data.GetNonce())
+	require.Equal(t, big.NewInt(47), data.GetMaxPriorityFeePerGas())
+	require.Equal(t, big.NewInt(47), data.GetMaxFeePerGas())
+	require.Equal(t, uint64(98304), data.GetGasLimit())
+	require.Equal(t, "7e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181", hex.EncodeToString(data.GetTo()))
+	require.Equal(t, big.NewInt(1_000_000_000_000_000_000), data.GetValue())
+	require.Equal(t, []byte{0x12, 0x34, 0x56}, data.GetCallData())
+	require.Empty(t, data.GetAccessList())
+	require.Equal(t, crypto.Keccak256(byt), data.Hash())
+
+	v, r, s := data.GetSignatureValues()
+	require.Equal(t, big.NewInt(1), v)
+	require.Equal(t, "df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479", hex.EncodeToString(r.Bytes()))
+	require.Equal(t, "1aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66", hex.EncodeToString(s.Bytes()))
+}
+
+func TestUnitEthereumDataAccessList(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	accessList := []EthereumAccessTuple{{
+		Address:     bytes.Repeat([]byte{1}, 20),
+		StorageKeys: [][]byte{bytes.Repeat([]byte{2}, 32), bytes.Repeat([]byte{3}, 32)},
+	}}
+
+	signed, err := NewEthereumTransactionBuilder().
+		SetTransactionType(EthereumTransactionTypeAccessList).
+		SetChainID(296).
+		SetNonce(3).
+		SetGasLimit(50_000).
+		SetGasPrice(big.NewInt(710_000_000_000)).
+		SetToContractID(ContractID{Contract: 1234}).
+		SetCallData([]byte{1, 2, 3}).
+		SetAccessList(accessList).
+		Sign(key)
+	require.NoError(t, err)
+
+	byt, err := signed.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, byte(1), byt[0])
+
+	data, err := EthereumTransactionDataFromBytes(byt)
+	require.NoError(t, err)
+
+	require.Equal(t, EthereumTransactionTypeAccessList, data.GetType())
+	require.Equal(t, big.NewInt(296), data.GetChainID())
+	require.Equal(t, uint64(3), data.GetNonce())
+	require.Equal(t, big.NewInt(710_000_000_000), data.GetGasPrice())
+	require.Equal(t, uint64(50_000), data.GetGasLimit())
+	require.Equal(t, "00000000000000000000000000000000000004d2", hex.EncodeToString(data.GetTo()))
+	require.Equal(t, big.NewInt(0), data.GetValue())
+	require.Equal(t, []byte{1, 2, 3}, data.GetCallData())
+	require.Equal(t, accessList, data.GetAccessList())
+	require.Equal(t, crypto.Keccak256(byt), data.Hash())
+
+	reencoded, err := data.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, byt, reencoded)
+
+	require.True(t, data.VerifySignature(key.PublicKey()))
+
+	json, err := data.ToJson()
+	require.NoError(t, err)
+	fromJson, err := EthereumTransactionDataFromJson(json)
+	require.NoError(t, err)
+	require.Equal(t, EthereumTransactionTypeAccessList, fromJson.GetType())
+	require.Equal(t, data.Hash(), fromJson.Hash())
+}
+
+func TestUnitEthereumDataLegacy(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	signed, err := NewEthereumTransactionBuilder().
+		SetTransactionType(EthereumTransactionTypeLegacy).
+		SetChainID(296).
+		SetGasPrice(big.NewInt(710_000_000_000)).
+		SetTo(bytes.Repeat([]byte{0xab}, 20)).
+		SetValue(NewHbar(1)).
+		Sign(key)
+	require.NoError(t, err)
+
+	byt, err := signed.ToBytes()
+	require.NoError(t, err)
+
+	data, err := EthereumTransactionDataFromBytes(byt)
+	require.NoError(t, err)
+
+	require.Equal(t, EthereumTransactionTypeLegacy, data.GetType())
+	require.Equal(t, big.NewInt(296), data.GetChainID())
+	require.Equal(t, big.NewInt(710_000_000_000), data.GetGasPrice())
+	require.Equal(t, big.NewInt(710_000_000_000), data.GetMaxFeePerGas())
+	require.Empty(t, data.GetAccessList())
+	require.Equal(t, crypto.Keccak256(byt), data.Hash())
+	require.True(t, data.VerifySignature(key.PublicKey()))
+
+	json, err := data.ToJson()
+	require.NoError(t, err)
+	fromJson, err := EthereumTransactionDataFromJson(json)
+	require.NoError(t, err)
+	require.Equal(t, EthereumTransactionTypeLegacy, fromJson.GetType())
+	require.Equal(t, data.Hash(), fromJson.Hash())
+}
+
+func TestUnitEthereumDataVerifySignature(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	otherKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	data, err := NewEthereumTransactionBuilder().SetChainID(296).Sign(key)
+	require.NoError(t, err)
+
+	require.True(t, data.VerifySignature(key.PublicKey()))
+	require.False(t, data.VerifySignature(otherKey.PublicKey()))
+	require.False(t, data.VerifySignature(ed25519Key.PublicKey()))
+
+	// changing the signed fields invalidates the signature
+	data.eip1559.Nonce++
+	require.False(t, data.VerifySignature(key.PublicKey()))
+}
+
+func TestUnitEthereumDataFromBytesInvalid(t *testing.T) {
+	_, err := EthereumTransactionDataFromBytes([]byte{})
+	require.ErrorIs(t, err, errEthereumTransactionDataEmpty)
+
+	_, err = EthereumTransactionDataFromBytes([]byte{3, 0xc0})
+	require.ErrorIs(t, err, errEthereumTransactionUnsupportedType)
+
+	_, err = EthereumTransactionDataFromBytes([]byte{1, 0xc0})
+	require.Error(t, err)
+}
+
+func TestUnitEthereumDataEmpty(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	data := &EthereumTransactionData{}
+	require.NotPanics(t, func() {
+		require.Nil(t, data.GetChainID())
+		require.Equal(t, uint64(0), data.GetNonce())
+		require.Nil(t, data.GetGasPrice())
+		require.Nil(t, data.GetMaxPriorityFeePerGas())
+		require.Nil(t, data.GetMaxFeePerGas())
+		require.Equal(t, uint64(0), data.GetGasLimit())
+		require.Nil(t, data.GetTo())
+		require.Nil(t, data.GetValue())
+		require.Nil(t, data.GetCallData())
+		require.Empty(t, data.GetAccessList())
+		v, r, s := data.GetSignatureValues()
+		require.Nil(t, v)
+		require.Nil(t, r)
+		require.Nil(t, s)
+		require.Nil(t, data.Hash())
+		require.False(t, data.VerifySignature(key.PublicKey()))
+	})
+
+	_, err = data.RecoverSender()
+	require.ErrorIs(t, err, errEthereumTransactionDataEmpty)
+	_, err = data.ToBytes()
+	require.ErrorIs(t, err, errEthereumTransactionDataEmpty)
+}
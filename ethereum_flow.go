@@ -1,5 +1,6 @@
 package hedera
 
+import "context"
 import "github.com/pkg/errors"
 
 type EthereumFlow struct {
@@ -78,7 +79,7 @@ func (transaction *EthereumFlow) GetNodeAccountIDs() []AccountID {
 	return transaction.nodeAccountIDs
 }
 
-func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (FileID, error) {
+func (transaction *EthereumFlow) _CreateFile(ctx context.Context, callData []byte, client *Client) (FileID, error) {
 	fileCreate := NewFileCreateTransaction()
 	if len(transaction.nodeAccountIDs) > 0 {
 		fileCreate.SetNodeAccountIDs(transaction.nodeAccountIDs)
@@ -87,12 +88,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 	if len(callData) < 4097 {
 		resp, err := fileCreate.
 			SetContents(callData).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
 
-		receipt, err := resp.GetReceipt(client)
+		receipt, err := resp.GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
@@ -102,12 +103,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 
 	resp, err := fileCreate.
 		SetContents(callData[:4097]).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	receipt, err := resp.GetReceipt(client)
+	receipt, err := resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -117,12 +118,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 	resp, err = NewFileAppendTransaction().
 		SetFileID(fileID).
 		SetContents(callData[4097:]).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -131,6 +132,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 }
 
 func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the EthereumFlow with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (transaction *EthereumFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	if transaction.ethereumData == nil {
 		return TransactionResponse{}, errors.New("cannot submit ethereum transaction with no ethereum data")
 	}
@@ -161,7 +168,7 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 			SetEthereumData(dataBytes)
 	} else {
 		fileID, err := transaction.
-			_CreateFile(dataBytes, client)
+			_CreateFile(ctx, dataBytes, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
@@ -174,12 +181,12 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 	}
 
 	resp, err := ethereumTransaction.
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -4,6 +4,7 @@
 package hedera
 
 import (
+	"context"
 	"encoding/hex"
 	"testing"
 
@@ -163,7 +164,7 @@ func TestUnitEthereumFlowCoverage(t *testing.T) {
 		SetEthereumDataBytes(byt).
 		SetMaxGasAllowance(NewHbar(2))
 
-	transaction._CreateFile(byt, client)
+	transaction._CreateFile(context.Background(), byt, client)
 
 	require.NoError(t, err)
 	transaction.GetTransactionID()
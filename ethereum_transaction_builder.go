@@ -0,0 +1,351 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EthereumTransactionType is the EIP-2718 type of an Ethereum transaction
+type EthereumTransactionType uint8
+
+const (
+	// EthereumTransactionTypeLegacy is a legacy transaction, signed with EIP-155 replay protection
+	EthereumTransactionTypeLegacy EthereumTransactionType = types.LegacyTxType
+	// EthereumTransactionTypeAccessList is an EIP-2930 transaction with an access list
+	EthereumTransactionTypeAccessList EthereumTransactionType = types.AccessListTxType
+	// EthereumTransactionTypeDynamicFee is an EIP-1559 transaction with a priority fee and a fee cap
+	EthereumTransactionTypeDynamicFee EthereumTransactionType = types.DynamicFeeTxType
+)
+
+// EthereumAccessTuple is an entry of an EIP-2930 access list, the storage keys of one contract a
+// transaction declares it is going to access
+type EthereumAccessTuple struct {
+	Address     []byte
+	StorageKeys [][]byte
+}
+
+// EthereumTransactionBuilder builds the RLP encoded Ethereum transactions carried by
+// EthereumTransaction and EthereumFlow, and signs them with an ECDSA (secp256k1) PrivateKey.
+// Gas prices, fees and the value are in weibar, 1 tinybar is 10^10 weibar.
+type EthereumTransactionBuilder struct {
+	transactionType EthereumTransactionType
+	chainID         uint64
+	nonce           uint64
+	gasLimit        uint64
+	gasPrice        *big.Int
+	maxPriorityGas  *big.Int
+	maxGas          *big.Int
+	to              []byte
+	value           *big.Int
+	callData        []byte
+	accessList      []EthereumAccessTuple
+}
+
+// NewEthereumTransactionBuilder creates an EthereumTransactionBuilder for an EIP-1559 transaction
+func NewEthereumTransactionBuilder() *EthereumTransactionBuilder {
+	return &EthereumTransactionBuilder{
+		transactionType: EthereumTransactionTypeDynamicFee,
+		gasPrice:        new(big.Int),
+		maxPriorityGas:  new(big.Int),
+		maxGas:          new(big.Int),
+		value:           new(big.Int),
+	}
+}
+
+// SetTransactionType sets the type of the transaction, EIP-1559 by default
+func (builder *EthereumTransactionBuilder) SetTransactionType(transactionType EthereumTransactionType) *EthereumTransactionBuilder {
+	builder.transactionType = transactionType
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetTransactionType() EthereumTransactionType {
+	return builder.transactionType
+}
+
+// SetChainID sets the chain ID of the ledger the transaction is for, see LedgerID.ToChainID
+func (builder *EthereumTransactionBuilder) SetChainID(chainID uint64) *EthereumTransactionBuilder {
+	builder.chainID = chainID
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetChainID() uint64 {
+	return builder.chainID
+}
+
+// SetNonce sets the Ethereum nonce of the sender
+func (builder *EthereumTransactionBuilder) SetNonce(nonce uint64) *EthereumTransactionBuilder {
+	builder.nonce = nonce
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetNonce() uint64 {
+	return builder.nonce
+}
+
+// SetGasLimit sets the amount of gas the transaction may use
+func (builder *EthereumTransactionBuilder) SetGasLimit(gasLimit uint64) *EthereumTransactionBuilder {
+	builder.gasLimit = gasLimit
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetGasLimit() uint64 {
+	return builder.gasLimit
+}
+
+// SetGasPrice sets the price per gas in weibar of legacy and EIP-2930 transactions
+func (builder *EthereumTransactionBuilder) SetGasPrice(gasPrice *big.Int) *EthereumTransactionBuilder {
+	builder.gasPrice = new(big.Int).Set(gasPrice)
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetGasPrice() *big.Int {
+	return new(big.Int).Set(builder.gasPrice)
+}
+
+// SetMaxPriorityFeePerGas sets the priority fee per gas in weibar of EIP-1559 transactions
+func (builder *EthereumTransactionBuilder) SetMaxPriorityFeePerGas(maxPriorityGas *big.Int) *EthereumTransactionBuilder {
+	builder.maxPriorityGas = new(big.Int).Set(maxPriorityGas)
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetMaxPriorityFeePerGas() *big.Int {
+	return new(big.Int).Set(builder.maxPriorityGas)
+}
+
+// SetMaxFeePerGas sets the maximum fee per gas in weibar of EIP-1559 transactions
+func (builder *EthereumTransactionBuilder) SetMaxFeePerGas(maxGas *big.Int) *EthereumTransactionBuilder {
+	builder.maxGas = new(big.Int).Set(maxGas)
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetMaxFeePerGas() *big.Int {
+	return new(big.Int).Set(builder.maxGas)
+}
+
+// SetTo sets the 20 byte EVM address the transaction is sent to. Without one the transaction
+// creates a contract from its call data.
+func (builder *EthereumTransactionBuilder) SetTo(evmAddress []byte) *EthereumTransactionBuilder {
+	builder.to = evmAddress
+	return builder
+}
+
+// SetToContractID sends the transaction to a contract, by its EVM address if it has one
+func (builder *EthereumTransactionBuilder) SetToContractID(contractID ContractID) *EthereumTransactionBuilder {
+	return builder.SetTo(_ContractIDEvmAddress(contractID))
+}
+
+// SetToAccountID sends the transaction to an account, by its EVM address alias if it has one
+func (builder *EthereumTransactionBuilder) SetToAccountID(accountID AccountID) *EthereumTransactionBuilder {
+	if accountID.AliasEvmAddress != nil {
+		return builder.SetTo(*accountID.AliasEvmAddress)
+	}
+
+	address, _ := hex.DecodeString(accountID.ToSolidityAddress())
+	return builder.SetTo(address)
+}
+
+func (builder *EthereumTransactionBuilder) GetTo() []byte {
+	return builder.to
+}
+
+// SetValue sets the amount of hbar sent with the transaction
+func (builder *EthereumTransactionBuilder) SetValue(value Hbar) *EthereumTransactionBuilder {
+	builder.value = value.AsWeibar()
+	return builder
+}
+
+// SetValueWeibar sets the amount of weibar sent with the transaction
+func (builder *EthereumTransactionBuilder) SetValueWeibar(value *big.Int) *EthereumTransactionBuilder {
+	builder.value = new(big.Int).Set(value)
+	return builder
+}
+
+// GetValueWeibar returns the amount of weibar sent with the transaction
+func (builder *EthereumTransactionBuilder) GetValueWeibar() *big.Int {
+	return new(big.Int).Set(builder.value)
+}
+
+// SetCallData sets the call data of the transaction, the bytecode when it creates a contract
+func (builder *EthereumTransactionBuilder) SetCallData(callData []byte) *EthereumTransactionBuilder {
+	builder.callData = callData
+	return builder
+}
+
+// SetFunction sets the call data to a call of the function name with params
+func (builder *EthereumTransactionBuilder) SetFunction(name string, params *ContractFunctionParameters) *EthereumTransactionBuilder {
+	if params == nil {
+		params = NewContractFunctionParameters()
+	}
+
+	builder.callData = params._Build(&name)
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetCallData() []byte {
+	return builder.callData
+}
+
+// SetAccessList sets the access list of EIP-2930 and EIP-1559 transactions
+func (builder *EthereumTransactionBuilder) SetAccessList(accessList []EthereumAccessTuple) *EthereumTransactionBuilder {
+	builder.accessList = accessList
+	return builder
+}
+
+func (builder *EthereumTransactionBuilder) GetAccessList() []EthereumAccessTuple {
+	return builder.accessList
+}
+
+// Sign signs the transaction with an ECDSA (secp256k1) private key and returns it ready to be set on
+// an EthereumTransaction or EthereumFlow
+func (builder *EthereumTransactionBuilder) Sign(privateKey PrivateKey) (*EthereumTransactionData, error) {
+	if privateKey.ecdsaPrivateKey == nil {
+		return nil, errEthereumTransactionRequiresECDSA
+	}
+
+	if builder.chainID == 0 {
+		return nil, errEthereumTransactionNoChainID
+	}
+
+	var to *common.Address
+	if builder.to != nil {
+		if len(builder.to) != common.AddressLength {
+			return nil, errEthereumTransactionInvalidTo
+		}
+
+		address := common.BytesToAddress(builder.to)
+		to = &address
+	}
+
+	accessList, err := _EthereumAccessListToTypes(builder.accessList)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := new(big.Int).SetUint64(builder.chainID)
+	data := &EthereumTransactionData{}
+
+	var tx *types.Transaction
+	switch builder.transactionType {
+	case EthereumTransactionTypeLegacy:
+		if len(accessList) > 0 {
+			return nil, errEthereumTransactionLegacyAccessList
+		}
+
+		data.legacy = &types.LegacyTx{
+			Nonce:    builder.nonce,
+			GasPrice: builder.GetGasPrice(),
+			Gas:      builder.gasLimit,
+			To:       to,
+			Value:    builder.GetValueWeibar(),
+			Data:     builder.callData,
+		}
+		tx, err = types.SignNewTx(privateKey.ecdsaPrivateKey.keyData, types.NewEIP155Signer(chainID), data.legacy)
+	case EthereumTransactionTypeAccessList:
+		data.eip2930 = &types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      builder.nonce,
+			GasPrice:   builder.GetGasPrice(),
+			Gas:        builder.gasLimit,
+			To:         to,
+			Value:      builder.GetValueWeibar(),
+			Data:       builder.callData,
+			AccessList: accessList,
+		}
+		tx, err = types.SignNewTx(privateKey.ecdsaPrivateKey.keyData, types.NewEIP2930Signer(chainID), data.eip2930)
+	case EthereumTransactionTypeDynamicFee:
+		data.eip1559 = &types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      builder.nonce,
+			GasTipCap:  builder.GetMaxPriorityFeePerGas(),
+			GasFeeCap:  builder.GetMaxFeePerGas(),
+			Gas:        builder.gasLimit,
+			To:         to,
+			Value:      builder.GetValueWeibar(),
+			Data:       builder.callData,
+			AccessList: accessList,
+		}
+		tx, err = types.SignNewTx(privateKey.ecdsaPrivateKey.keyData, types.NewLondonSigner(chainID), data.eip1559)
+	default:
+		return nil, errEthereumTransactionUnsupportedType
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	switch {
+	case data.legacy != nil:
+		data.legacy.V, data.legacy.R, data.legacy.S = v, r, s
+	case data.eip2930 != nil:
+		data.eip2930.V, data.eip2930.R, data.eip2930.S = v, r, s
+	default:
+		data.eip1559.V, data.eip1559.R, data.eip1559.S = v, r, s
+	}
+
+	return data, nil
+}
+
+func _EthereumAccessListToTypes(accessList []EthereumAccessTuple) (types.AccessList, error) {
+	result := make(types.AccessList, 0, len(accessList))
+	for _, tuple := range accessList {
+		if len(tuple.Address) != common.AddressLength {
+			return nil, errEthereumTransactionInvalidAccessList
+		}
+
+		storageKeys := make([]common.Hash, 0, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			if len(key) != common.HashLength {
+				return nil, errEthereumTransactionInvalidAccessList
+			}
+
+			storageKeys = append(storageKeys, common.BytesToHash(key))
+		}
+
+		result = append(result, types.AccessTuple{
+			Address:     common.BytesToAddress(tuple.Address),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return result, nil
+}
+
+func _EthereumAccessListFromTypes(accessList types.AccessList) []EthereumAccessTuple {
+	result := make([]EthereumAccessTuple, 0, len(accessList))
+	for _, tuple := range accessList {
+		storageKeys := make([][]byte, 0, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			storageKeys = append(storageKeys, key.Bytes())
+		}
+
+		result = append(result, EthereumAccessTuple{
+			Address:     tuple.Address.Bytes(),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return result
+}
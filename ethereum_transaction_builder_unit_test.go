@@ -0,0 +1,206 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewUnitEthereumBuilder(transactionType EthereumTransactionType) *EthereumTransactionBuilder {
+	return NewEthereumTransactionBuilder().
+		SetTransactionType(transactionType).
+		SetChainID(296).
+		SetNonce(7).
+		SetGasLimit(100_000).
+		SetGasPrice(big.NewInt(710_000_000_000)).
+		SetMaxPriorityFeePerGas(big.NewInt(1)).
+		SetMaxFeePerGas(big.NewInt(710_000_000_000)).
+		SetToContractID(ContractID{Contract: 1234}).
+		SetValue(NewHbar(2)).
+		SetFunction("setMessage", NewContractFunctionParameters().AddString("hello"))
+}
+
+func TestUnitEthereumTransactionBuilderSign(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	accessList := []EthereumAccessTuple{{
+		Address:     bytes.Repeat([]byte{1}, 20),
+		StorageKeys: [][]byte{bytes.Repeat([]byte{2}, 32)},
+	}}
+
+	for _, transactionType := range []EthereumTransactionType{
+		EthereumTransactionTypeLegacy,
+		EthereumTransactionTypeAccessList,
+		EthereumTransactionTypeDynamicFee,
+	} {
+		builder := _NewUnitEthereumBuilder(transactionType)
+		if transactionType != EthereumTransactionTypeLegacy {
+			builder.SetAccessList(accessList)
+		}
+
+		data, err := builder.Sign(key)
+		require.NoError(t, err)
+
+		encoded, err := data.ToBytes()
+		require.NoError(t, err)
+
+		var tx types.Transaction
+		require.NoError(t, tx.UnmarshalBinary(encoded))
+		require.Equal(t, uint8(transactionType), tx.Type())
+		require.True(t, tx.Protected())
+		require.Equal(t, big.NewInt(296), tx.ChainId())
+		require.Equal(t, uint64(7), tx.Nonce())
+		require.Equal(t, uint64(100_000), tx.Gas())
+		require.Equal(t, "00000000000000000000000000000000000004d2", tx.To().Hex()[2:])
+		require.Equal(t, new(big.Int).Mul(big.NewInt(2), big.NewInt(1_000_000_000_000_000_000)), tx.Value())
+		require.Equal(t, NewContractFunctionParameters().AddString("hello")._Build(&[]string{"setMessage"}[0]), tx.Data())
+
+		sender, err := data.RecoverSender()
+		require.NoError(t, err)
+		require.Equal(t, key.PublicKey().ToEvmAddress(), sender)
+
+		switch transactionType {
+		case EthereumTransactionTypeDynamicFee:
+			require.Equal(t, big.NewInt(1), tx.GasTipCap())
+			require.Equal(t, big.NewInt(710_000_000_000), tx.GasFeeCap())
+			require.Len(t, tx.AccessList(), 1)
+		case EthereumTransactionTypeAccessList:
+			require.Equal(t, big.NewInt(710_000_000_000), tx.GasPrice())
+			require.Len(t, tx.AccessList(), 1)
+		default:
+			require.Equal(t, big.NewInt(710_000_000_000), tx.GasPrice())
+			v, _, _ := tx.RawSignatureValues()
+			require.Contains(t, []int64{296*2 + 35, 296*2 + 36}, v.Int64())
+		}
+	}
+}
+
+func TestUnitEthereumTransactionBuilderRoundTrip(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	for _, transactionType := range []EthereumTransactionType{EthereumTransactionTypeLegacy, EthereumTransactionTypeDynamicFee} {
+		data, err := _NewUnitEthereumBuilder(transactionType).Sign(key)
+		require.NoError(t, err)
+
+		encoded, err := data.ToBytes()
+		require.NoError(t, err)
+
+		decoded, err := EthereumTransactionDataFromBytes(encoded)
+		require.NoError(t, err)
+
+		reencoded, err := decoded.ToBytes()
+		require.NoError(t, err)
+		require.Equal(t, encoded, reencoded)
+
+		sender, err := decoded.RecoverSender()
+		require.NoError(t, err)
+		require.Equal(t, key.PublicKey().ToEvmAddress(), sender)
+	}
+}
+
+func TestUnitEthereumTransactionBuilderContractCreate(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	data, err := NewEthereumTransactionBuilder().
+		SetChainID(296).
+		SetCallData([]byte{0x60, 0x80}).
+		Sign(key)
+	require.NoError(t, err)
+
+	encoded, err := data.ToBytes()
+	require.NoError(t, err)
+
+	var tx types.Transaction
+	require.NoError(t, tx.UnmarshalBinary(encoded))
+	require.Nil(t, tx.To())
+	require.Equal(t, []byte{0x60, 0x80}, data._GetData())
+}
+
+func TestUnitEthereumTransactionBuilderTo(t *testing.T) {
+	evmAddress := bytes.Repeat([]byte{0xab}, 20)
+
+	builder := NewEthereumTransactionBuilder().SetToAccountID(AccountID{Account: 5})
+	require.Equal(t, "0000000000000000000000000000000000000005", hex.EncodeToString(builder.GetTo()))
+
+	builder.SetToAccountID(AccountID{AliasEvmAddress: &evmAddress})
+	require.Equal(t, evmAddress, builder.GetTo())
+
+	builder.SetToContractID(ContractID{EvmAddress: evmAddress})
+	require.Equal(t, evmAddress, builder.GetTo())
+}
+
+func TestUnitEthereumTransactionBuilderErrors(t *testing.T) {
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	_, err = _NewUnitEthereumBuilder(EthereumTransactionTypeDynamicFee).Sign(ed25519Key)
+	require.ErrorIs(t, err, errEthereumTransactionRequiresECDSA)
+
+	_, err = _NewUnitEthereumBuilder(EthereumTransactionTypeDynamicFee).SetChainID(0).Sign(ecdsaKey)
+	require.ErrorIs(t, err, errEthereumTransactionNoChainID)
+
+	_, err = _NewUnitEthereumBuilder(EthereumTransactionTypeDynamicFee).SetTo([]byte{1, 2}).Sign(ecdsaKey)
+	require.ErrorIs(t, err, errEthereumTransactionInvalidTo)
+
+	_, err = _NewUnitEthereumBuilder(EthereumTransactionTypeDynamicFee).
+		SetAccessList([]EthereumAccessTuple{{Address: []byte{1}}}).
+		Sign(ecdsaKey)
+	require.ErrorIs(t, err, errEthereumTransactionInvalidAccessList)
+
+	_, err = _NewUnitEthereumBuilder(EthereumTransactionTypeLegacy).
+		SetAccessList([]EthereumAccessTuple{{Address: bytes.Repeat([]byte{1}, 20)}}).
+		Sign(ecdsaKey)
+	require.ErrorIs(t, err, errEthereumTransactionLegacyAccessList)
+
+	_, err = _NewUnitEthereumBuilder(EthereumTransactionType(3)).Sign(ecdsaKey)
+	require.ErrorIs(t, err, errEthereumTransactionUnsupportedType)
+}
+
+func TestUnitLedgerIDToChainID(t *testing.T) {
+	chainID, err := NewLedgerIDMainnet().ToChainID()
+	require.NoError(t, err)
+	require.Equal(t, uint64(295), chainID)
+
+	chainID, err = NewLedgerIDTestnet().ToChainID()
+	require.NoError(t, err)
+	require.Equal(t, uint64(296), chainID)
+
+	chainID, err = NewLedgerIDPreviewnet().ToChainID()
+	require.NoError(t, err)
+	require.Equal(t, uint64(297), chainID)
+
+	_, err = LedgerIDFromBytes([]byte{42}).ToChainID()
+	require.Error(t, err)
+}
@@ -1,7 +1,9 @@
 package hedera
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"math/big"
 
 	"github.com/pkg/errors"
 
@@ -9,27 +11,39 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
+// EthereumTransactionData holds a legacy, EIP-2930 or EIP-1559 Ethereum transaction. The getters of a
+// zero value EthereumTransactionData return zero values, and RecoverSender and ToBytes return an error.
 type EthereumTransactionData struct {
 	eip1559 *types.DynamicFeeTx
+	eip2930 *types.AccessListTx
 	legacy  *types.LegacyTx
 }
 
 func EthereumTransactionDataFromBytes(b []byte) (*EthereumTransactionData, error) {
+	if len(b) == 0 {
+		return nil, errEthereumTransactionDataEmpty
+	}
+
 	var transactionData EthereumTransactionData
-	if b[0] == 2 {
-		byt := b
-		byt = append(byt[:0], byt[0+1:]...)
-		err := rlp.DecodeBytes(byt, &transactionData.eip1559)
+	switch {
+	case b[0] == types.DynamicFeeTxType:
+		err := rlp.DecodeBytes(b[1:], &transactionData.eip1559)
 		if err != nil {
 			return nil, err
 		}
-
-		return &transactionData, nil
-	}
-
-	err := rlp.DecodeBytes(b, &transactionData.legacy)
-	if err != nil {
-		return nil, err
+	case b[0] == types.AccessListTxType:
+		err := rlp.DecodeBytes(b[1:], &transactionData.eip2930)
+		if err != nil {
+			return nil, err
+		}
+	case b[0] >= 0xc0:
+		// legacy transactions are not prefixed with a type, they start with an RLP list header
+		err := rlp.DecodeBytes(b, &transactionData.legacy)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errEthereumTransactionUnsupportedType
 	}
 
 	return &transactionData, nil
@@ -48,6 +62,20 @@ func (ethereumTxData *EthereumTransactionData) ToBytes() ([]byte, error) {
 		return byt, nil
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		byt, err = rlp.EncodeToBytes(ethereumTxData.eip2930)
+		if err != nil {
+			return []byte{}, err
+		}
+		byt = append([]byte{1}, byt...)
+
+		return byt, nil
+	}
+
+	if ethereumTxData.legacy == nil {
+		return []byte{}, errEthereumTransactionDataEmpty
+	}
+
 	byt, err = rlp.EncodeToBytes(ethereumTxData.legacy)
 	if err != nil {
 		return []byte{}, err
@@ -61,6 +89,14 @@ func (ethereumTxData *EthereumTransactionData) _GetData() []byte {
 		return ethereumTxData.eip1559.Data
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		return ethereumTxData.eip2930.Data
+	}
+
+	if ethereumTxData.legacy == nil {
+		return nil
+	}
+
 	return ethereumTxData.legacy.Data
 }
 
@@ -70,6 +106,11 @@ func (ethereumTxData *EthereumTransactionData) _SetData(data []byte) *EthereumTr
 		return ethereumTxData
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		ethereumTxData.eip2930.Data = data
+		return ethereumTxData
+	}
+
 	ethereumTxData.legacy.Data = data
 	return ethereumTxData
 }
@@ -86,6 +127,15 @@ func (ethereumTxData *EthereumTransactionData) ToJson() ([]byte, error) {
 		return byt, nil
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		byt, err = json.Marshal(ethereumTxData.eip2930)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		return byt, nil
+	}
+
 	byt, err = json.Marshal(ethereumTxData.legacy)
 	if err != nil {
 		return []byte{}, err
@@ -95,21 +145,231 @@ func (ethereumTxData *EthereumTransactionData) ToJson() ([]byte, error) {
 }
 
 func EthereumTransactionDataFromJson(b []byte) (*EthereumTransactionData, error) {
-	var eip1559 types.DynamicFeeTx
-	var leg types.LegacyTx
-	err := json.Unmarshal(b, &eip1559)
-	if err != nil {
-		err = json.Unmarshal(b, &leg)
-		if err != nil {
-			return nil, errors.New("Json bytes are neither eip1559 or legacy format")
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, errors.New("Json bytes are neither eip1559, eip2930 or legacy format")
+	}
+
+	// every type decodes from the JSON of the others, so tell them apart by their fields
+	_, hasFeeCap := fields["GasFeeCap"]
+	_, hasAccessList := fields["AccessList"]
+
+	switch {
+	case hasFeeCap:
+		var eip1559 types.DynamicFeeTx
+		if err := json.Unmarshal(b, &eip1559); err != nil {
+			return nil, errors.New("Json bytes are neither eip1559, eip2930 or legacy format")
 		}
 
 		return &EthereumTransactionData{
-			legacy: &leg,
+			eip1559: &eip1559,
+		}, nil
+	case hasAccessList:
+		var eip2930 types.AccessListTx
+		if err := json.Unmarshal(b, &eip2930); err != nil {
+			return nil, errors.New("Json bytes are neither eip1559, eip2930 or legacy format")
+		}
+
+		return &EthereumTransactionData{
+			eip2930: &eip2930,
 		}, nil
 	}
 
+	var leg types.LegacyTx
+	if err := json.Unmarshal(b, &leg); err != nil {
+		return nil, errors.New("Json bytes are neither eip1559, eip2930 or legacy format")
+	}
+
 	return &EthereumTransactionData{
-		eip1559: &eip1559,
+		legacy: &leg,
 	}, nil
 }
+
+// GetType returns the EIP-2718 type of the transaction
+func (ethereumTxData *EthereumTransactionData) GetType() EthereumTransactionType {
+	if ethereumTxData.eip1559 != nil {
+		return EthereumTransactionTypeDynamicFee
+	}
+
+	if ethereumTxData.eip2930 != nil {
+		return EthereumTransactionTypeAccessList
+	}
+
+	return EthereumTransactionTypeLegacy
+}
+
+// GetChainID returns the chain ID the transaction is signed for. For legacy transactions it is
+// derived from the EIP-155 signature, and is 0 for transactions without replay protection.
+func (ethereumTxData *EthereumTransactionData) GetChainID() *big.Int {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil
+	}
+
+	return tx.ChainId()
+}
+
+// GetNonce returns the nonce of the sender
+func (ethereumTxData *EthereumTransactionData) GetNonce() uint64 {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return 0
+	}
+
+	return tx.Nonce()
+}
+
+// GetGasPrice returns the gas price in weibar; for EIP-1559 transactions this is the fee cap
+func (ethereumTxData *EthereumTransactionData) GetGasPrice() *big.Int {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil
+	}
+
+	return tx.GasPrice()
+}
+
+// GetMaxPriorityFeePerGas returns the gas tip cap in weibar; for legacy and EIP-2930 transactions this is the gas price
+func (ethereumTxData *EthereumTransactionData) GetMaxPriorityFeePerGas() *big.Int {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil
+	}
+
+	return tx.GasTipCap()
+}
+
+// GetMaxFeePerGas returns the gas fee cap in weibar; for legacy and EIP-2930 transactions this is the gas price
+func (ethereumTxData *EthereumTransactionData) GetMaxFeePerGas() *big.Int {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil
+	}
+
+	return tx.GasFeeCap()
+}
+
+// GetGasLimit returns the gas limit of the transaction
+func (ethereumTxData *EthereumTransactionData) GetGasLimit() uint64 {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return 0
+	}
+
+	return tx.Gas()
+}
+
+// GetTo returns the EVM address of the recipient, or nil if the transaction creates a contract
+func (ethereumTxData *EthereumTransactionData) GetTo() []byte {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil || tx.To() == nil {
+		return nil
+	}
+
+	return tx.To().Bytes()
+}
+
+// GetValue returns the value transferred, in weibar
+func (ethereumTxData *EthereumTransactionData) GetValue() *big.Int {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil
+	}
+
+	return tx.Value()
+}
+
+// GetCallData returns the call data, or the init code of a contract create
+func (ethereumTxData *EthereumTransactionData) GetCallData() []byte {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil
+	}
+
+	return tx.Data()
+}
+
+// GetAccessList returns the EIP-2930 access list, which is always empty for legacy transactions
+func (ethereumTxData *EthereumTransactionData) GetAccessList() []EthereumAccessTuple {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return _EthereumAccessListFromTypes(nil)
+	}
+
+	return _EthereumAccessListFromTypes(tx.AccessList())
+}
+
+// GetSignatureValues returns the V, R and S values of the signature
+func (ethereumTxData *EthereumTransactionData) GetSignatureValues() (v, r, s *big.Int) {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil, nil, nil
+	}
+
+	return tx.RawSignatureValues()
+}
+
+// Hash returns the Keccak-256 hash of the signed transaction, the hash it is known by on the EVM
+func (ethereumTxData *EthereumTransactionData) Hash() []byte {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return nil
+	}
+
+	return tx.Hash().Bytes()
+}
+
+// VerifySignature returns whether the transaction is signed by the ECDSA (secp256k1) public key
+func (ethereumTxData *EthereumTransactionData) VerifySignature(publicKey PublicKey) bool {
+	if publicKey.ecdsaPublicKey == nil {
+		return false
+	}
+
+	sender, err := ethereumTxData.RecoverSender()
+	if err != nil {
+		return false
+	}
+
+	return sender == publicKey.ToEvmAddress()
+}
+
+// RecoverSender returns the EVM address, hex encoded, of the account which signed the transaction
+func (ethereumTxData *EthereumTransactionData) RecoverSender() (string, error) {
+	tx := ethereumTxData._ToTransaction()
+	if tx == nil {
+		return "", errEthereumTransactionDataEmpty
+	}
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		signer = types.LatestSignerForChainID(tx.ChainId())
+	}
+
+	sender, err := types.Sender(signer, tx)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(sender.Bytes()), nil
+}
+
+// _ToTransaction returns the transaction as a go-ethereum transaction, or nil when the data is empty
+func (ethereumTxData *EthereumTransactionData) _ToTransaction() *types.Transaction {
+	if ethereumTxData == nil {
+		return nil
+	}
+
+	if ethereumTxData.eip1559 != nil {
+		return types.NewTx(ethereumTxData.eip1559)
+	}
+
+	if ethereumTxData.eip2930 != nil {
+		return types.NewTx(ethereumTxData.eip2930)
+	}
+
+	if ethereumTxData.legacy == nil {
+		return nil
+	}
+
+	return types.NewTx(ethereumTxData.legacy)
+}
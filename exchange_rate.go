@@ -33,6 +33,15 @@ type ExchangeRate struct {
 	expirationTime *services.TimestampSeconds
 }
 
+// NewExchangeRate creates an ExchangeRate stating that hbars hbar are worth cents cents, for use with data obtained
+// outside of a receipt such as the exchange rate file.
+func NewExchangeRate(hbars int32, cents int32) ExchangeRate {
+	return ExchangeRate{
+		Hbars: hbars,
+		cents: cents,
+	}
+}
+
 func _ExchangeRateFromProtobuf(protoExchange *services.ExchangeRate) ExchangeRate {
 	if protoExchange == nil {
 		return ExchangeRate{}
@@ -49,6 +58,11 @@ func _ExchangeRateFromProtobuf(protoExchange *services.ExchangeRate) ExchangeRat
 	}
 }
 
+// GetCents returns the number of cents the Hbars of this rate are worth.
+func (exchange *ExchangeRate) GetCents() int32 {
+	return exchange.cents
+}
+
 func (exchange *ExchangeRate) _ToProtobuf() *services.ExchangeRate {
 	return &services.ExchangeRate{
 		HbarEquiv:      exchange.Hbars,
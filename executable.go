@@ -23,16 +23,13 @@ package hedera
 import (
 	"context"
 	"encoding/hex"
-	"os"
+	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 
 	protobuf "google.golang.org/protobuf/proto"
 
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
-
 	"github.com/pkg/errors"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -41,30 +38,6 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-var logCtx zerolog.Logger
-
-// A required init function to setup logging at the correct level
-func init() { // nolint
-	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-
-	if os.Getenv("HEDERA_SDK_GO_LOG_PRETTY") != "" {
-		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
-	}
-
-	switch os.Getenv("HEDERA_SDK_GO_LOG_LEVEL") {
-	case "DEBUG":
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
-	case "TRACE":
-		zerolog.SetGlobalLevel(zerolog.TraceLevel)
-	case "INFO":
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	default:
-		zerolog.SetGlobalLevel(zerolog.Disabled)
-	}
-
-	logCtx = log.With().Str("module", "hedera-sdk-go").Logger()
-}
-
 const maxAttempts = 10
 
 type _ExecutionState uint32
@@ -89,11 +62,96 @@ type _Method struct {
 	) (*services.TransactionResponse, error)
 }
 
+// _AttemptLimiter bounds the attempts of an execution per node. The executor finds it in the context
+// of the execution and acquires a slot on the node of every attempt right before sending it.
+type _AttemptLimiter interface {
+	_AcquireAttempt(ctx context.Context, nodeAccountID AccountID) (release func(), err error)
+}
+
+type _AttemptLimiterKey struct{}
+
+func _ContextWithAttemptLimiter(ctx context.Context, limiter _AttemptLimiter) context.Context {
+	return context.WithValue(ctx, _AttemptLimiterKey{}, limiter)
+}
+
+// _ExecutionStats collects what happened during one execution so it can be reported
+// on the execution span and metrics once the execution is over.
+type _ExecutionStats struct {
+	span         Span
+	meter        Meter
+	logger       Logger
+	requestType  string
+	attempts     int64
+	node         AccountID
+	status       string
+	protoRequest interface{}
+}
+
 func _Execute( // nolint
+	ctx context.Context,
 	client *Client,
 	request interface{},
-	shouldRetry func(string, interface{}, interface{}) _ExecutionState,
-	makeRequest func(interface{}) interface{},
+	shouldRetry func(Logger, interface{}, interface{}) _ExecutionState,
+	makeRequest func(interface{}) (interface{}, error),
+	advanceRequest func(interface{}),
+	getNodeAccountID func(interface{}) AccountID,
+	getMethod func(interface{}, *_Channel) _Method,
+	mapStatusError func(interface{}, interface{}) error,
+	mapResponse func(interface{}, interface{}, AccountID, interface{}) (interface{}, error),
+	logID string,
+	deadline *time.Duration,
+	maxBackoff *time.Duration,
+	minBackoff *time.Duration,
+	maxRetry int,
+) (interface{}, error) {
+	requestType := logID
+	if index := strings.Index(logID, ":"); index >= 0 {
+		requestType = logID[:index]
+	}
+
+	ctx, span := client._GetTracer().Start(ctx, _ExecutableSpanName(request, requestType), Attribute{AttributeRequestType, requestType})
+	defer span.End()
+
+	stats := _ExecutionStats{
+		span:        span,
+		meter:       client._GetMeter(),
+		logger:      client._GetLogger().With("requestId", logID),
+		requestType: requestType,
+	}
+	start := time.Now()
+
+	resp, err := _ExecuteAttempts(ctx, client, &stats, request, shouldRetry, makeRequest, advanceRequest, getNodeAccountID,
+		getMethod, mapStatusError, mapResponse, logID, deadline, maxBackoff, minBackoff, maxRetry)
+
+	if err != nil {
+		span.RecordError(err)
+		stats.status = _ExecutableErrorStatus(err)
+	}
+
+	attributes := []Attribute{
+		{AttributeNodeAccountID, stats.node.String()},
+		{AttributeStatus, stats.status},
+		{AttributeRetryCount, _ExecutableRetryCount(stats.attempts)},
+	}
+	if transactionID := _ExecutableTransactionID(request, stats.protoRequest); transactionID != "" {
+		attributes = append(attributes, Attribute{AttributeTransactionID, transactionID})
+	}
+	span.SetAttributes(attributes...)
+
+	stats.meter.AddCounter(ctx, MetricRequests, 1, Attribute{AttributeRequestType, requestType}, Attribute{AttributeStatus, stats.status})
+	stats.meter.RecordHistogram(ctx, MetricRequestDuration, _DurationToMilliseconds(time.Since(start)),
+		Attribute{AttributeRequestType, requestType}, Attribute{AttributeStatus, stats.status})
+
+	return resp, err
+}
+
+func _ExecuteAttempts( // nolint
+	ctx context.Context,
+	client *Client,
+	stats *_ExecutionStats,
+	request interface{},
+	shouldRetry func(Logger, interface{}, interface{}) _ExecutionState,
+	makeRequest func(interface{}) (interface{}, error),
 	advanceRequest func(interface{}),
 	getNodeAccountID func(interface{}) AccountID,
 	getMethod func(interface{}, *_Channel) _Method,
@@ -111,8 +169,8 @@ func _Execute( // nolint
 	backOff.MaxInterval = *maxBackoff
 	backOff.Multiplier = 2
 
-	if client.maxAttempts != nil {
-		maxAttempts = *client.maxAttempts
+	if clientMaxAttempts := client._GetMaxAttempts(); clientMaxAttempts != nil {
+		maxAttempts = *clientMaxAttempts
 	} else {
 		maxAttempts = maxRetry
 	}
@@ -122,17 +180,39 @@ func _Execute( // nolint
 	var attempt int64
 	var errPersistent error
 	var marshaledRequest []byte
+	// signed is false until every node and chunk specific body of a transaction is signed, and again
+	// once its transaction ID is regenerated
+	var signed bool
 
 	for attempt = int64(0); attempt < int64(maxAttempts); attempt, *currentBackoff = attempt+1, *currentBackoff*2 {
 		var protoRequest interface{}
 		var node *_Node
+		var err error
+
+		if ctx.Err() != nil {
+			return _ExecutableContextDone(ctx, request, attempt, errPersistent)
+		}
 
 		if transaction, ok := request.(*Transaction); ok {
 			if attempt > 0 && transaction.nodeAccountIDs._Length() > 1 {
 				advanceRequest(request)
 			}
 
-			protoRequest = makeRequest(request)
+			if !signed {
+				if err := transaction._SignAll(ctx); err != nil {
+					if ctx.Err() != nil {
+						return _ExecutableContextDone(ctx, request, attempt, err)
+					}
+
+					return TransactionResponse{}, err
+				}
+				signed = true
+			}
+
+			protoRequest, err = makeRequest(request)
+			if err != nil {
+				return TransactionResponse{}, err
+			}
 			nodeAccountID := getNodeAccountID(request)
 			if node, ok = client.network._GetNodeForAccountID(nodeAccountID); !ok {
 				return TransactionResponse{}, ErrInvalidNodeAccountIDSet{nodeAccountID}
@@ -141,13 +221,22 @@ func _Execute( // nolint
 			marshaledRequest, _ = protobuf.Marshal(protoRequest.(*services.Transaction))
 		} else if query, ok := request.(*Query); ok {
 			if query.nodeAccountIDs.locked && query.nodeAccountIDs._Length() > 0 {
-				protoRequest = makeRequest(request)
+				protoRequest, err = makeRequest(request)
+				if err != nil {
+					return &services.Response{}, err
+				}
 				nodeAccountID := getNodeAccountID(request)
 				if node, ok = client.network._GetNodeForAccountID(nodeAccountID); !ok {
 					return &services.Response{}, ErrInvalidNodeAccountIDSet{nodeAccountID}
 				}
 			} else {
-				node = client.network._GetNode()
+				if node, err = client.network._GetNodeWithContext(ctx); err != nil {
+					if ctx.Err() != nil {
+						return _ExecutableContextDone(ctx, request, attempt, errPersistent)
+					}
+
+					return &services.Response{}, err
+				}
 				if len(query.paymentTransactions) > 0 {
 					var paymentTransaction services.TransactionBody
 					_ = protobuf.Unmarshal(query.paymentTransactions[0].BodyBytes, &paymentTransaction) // nolint
@@ -157,34 +246,52 @@ func _Execute( // nolint
 					transferTx.CryptoTransfer.Transfers.AccountAmounts[0].AccountID = node.accountID._ToProtobuf()
 					query.paymentTransactions[0].BodyBytes, _ = protobuf.Marshal(&paymentTransaction) // nolint
 
-					signature := client.operator.signer(query.paymentTransactions[0].BodyBytes) // nolint
+					operator := client._GetOperator()
+					signature, err := operator.signer.Sign(ctx, query.paymentTransactions[0].BodyBytes) // nolint
+					if err != nil {
+						return &services.Response{}, errors.Wrap(err, "failed to sign query payment")
+					}
 					sigPairs := make([]*services.SignaturePair, 0)
-					sigPairs = append(sigPairs, client.operator.publicKey._ToSignaturePairProtobuf(signature))
+					sigPairs = append(sigPairs, operator.publicKey._ToSignaturePairProtobuf(signature))
 
 					query.paymentTransactions[0].SigMap = &services.SignatureMap{ // nolint
 						SigPair: sigPairs,
 					}
 				}
 				query.nodeAccountIDs._Set(0, node.accountID)
-				protoRequest = makeRequest(request)
+				protoRequest, err = makeRequest(request)
+				if err != nil {
+					return &services.Response{}, err
+				}
 			}
 			marshaledRequest, _ = protobuf.Marshal(protoRequest.(*services.Query))
 		}
 
 		node._InUse()
 
-		logCtx.Trace().Str("requestId", logID).Str("nodeAccountID", node.accountID.String()).Str("nodeIPAddress", node.address._String()).
-			Str("Request Proto:", hex.EncodeToString(marshaledRequest)).Msg("executing")
+		stats.node = node.accountID
+		stats.attempts = attempt + 1
+		stats.protoRequest = protoRequest
+
+		logger := stats.logger
+		logger.Trace("executing", "nodeAccountID", node.accountID.String(), "nodeIPAddress", node.address._String(),
+			"Request Proto:", hex.EncodeToString(marshaledRequest))
 
 		if !node._IsHealthy() {
-			logCtx.Trace().Str("requestId", logID).Str("delay", node._Wait().String()).Msg("node is unhealthy, waiting before continuing")
-			_DelayForAttempt(logID, backOff.NextBackOff(), attempt)
+			logger.Trace("node is unhealthy, waiting before continuing", "delay", node._Wait().String())
+			stats.span.AddEvent(EventNodeUnhealthy,
+				Attribute{AttributeNodeAccountID, node.accountID.String()},
+				Attribute{AttributeBackoffDelay, _DurationToMilliseconds(node._Wait())},
+			)
+			if !stats._DelayForAttempt(ctx, backOff.NextBackOff(), attempt) {
+				return _ExecutableContextDone(ctx, request, attempt+1, errPersistent)
+			}
 			continue
 		}
 
-		logCtx.Trace().Str("requestId", logID).Msg("updating node account ID index")
+		logger.Trace("updating node account ID index")
 
-		channel, err := node._GetChannel()
+		channel, err := node._GetChannel(logger)
 		if err != nil {
 			client.network._IncreaseBackoff(node)
 			continue
@@ -195,35 +302,97 @@ func _Execute( // nolint
 		method := getMethod(request, channel)
 
 		var resp interface{}
-
-		ctx := context.TODO()
-		var cancel context.CancelFunc
-		if deadline != nil {
-			grpcDeadline := time.Now().Add(*deadline)
-			ctx, cancel = context.WithDeadline(ctx, grpcDeadline)
+		var marshaledResponse []byte
+		var state _ExecutionState
+		var invoked bool
+
+		requestAttempt := &RequestAttempt{
+			RequestID:     logID,
+			NodeAccountID: node.accountID,
+			NodeAddress:   node.address._String(),
+			Attempt:       attempt,
+			Request:       marshaledRequest,
+			Annotations:   make(map[string]string),
 		}
 
-		logCtx.Trace().Str("requestId", logID).Msg("executing gRPC call")
+		invoke := func(invokeCtx context.Context, requestAttempt *RequestAttempt) error {
+			invoked = true
+			grpcCtx := invokeCtx
+			var cancel context.CancelFunc
+			if deadline != nil {
+				grpcDeadline := time.Now().Add(*deadline)
+				grpcCtx, cancel = context.WithDeadline(invokeCtx, grpcDeadline)
+			}
 
-		var marshaledResponse []byte
-		if method.query != nil {
-			resp, err = method.query(ctx, protoRequest.(*services.Query))
-			if err == nil {
-				marshaledResponse, _ = protobuf.Marshal(resp.(*services.Response))
+			logger.Trace("executing gRPC call")
+
+			start := time.Now()
+			if method.query != nil {
+				resp, err = method.query(grpcCtx, protoRequest.(*services.Query))
+				if err == nil {
+					marshaledResponse, _ = protobuf.Marshal(resp.(*services.Response))
+				}
+			} else {
+				resp, err = method.transaction(grpcCtx, protoRequest.(*services.Transaction))
+				if err == nil {
+					marshaledResponse, _ = protobuf.Marshal(resp.(*services.TransactionResponse))
+				}
 			}
-		} else {
-			resp, err = method.transaction(ctx, protoRequest.(*services.Transaction))
+			requestAttempt.Latency = time.Since(start)
+
+			if cancel != nil {
+				cancel()
+			}
+
+			requestAttempt.Response = marshaledResponse
+			requestAttempt.Err = err
 			if err == nil {
-				marshaledResponse, _ = protobuf.Marshal(resp.(*services.TransactionResponse))
+				state = shouldRetry(logger, request, resp)
+				if state != executionStateFinished {
+					requestAttempt.Err = mapStatusError(request, resp)
+				}
 			}
+
+			return nil
 		}
 
-		if cancel != nil {
-			cancel()
+		release := func() {}
+		if limiter, ok := ctx.Value(_AttemptLimiterKey{}).(_AttemptLimiter); ok {
+			if release, err = limiter._AcquireAttempt(ctx, node.accountID); err != nil {
+				return _ExecutableContextDone(ctx, request, attempt, errPersistent)
+			}
+		}
+
+		abortErr := client._InterceptorChain(invoke)(ctx, requestAttempt)
+		release()
+
+		stats._RecordAttempt(ctx, requestAttempt)
+
+		if abortErr != nil {
+			logger.Trace("request aborted by interceptor", "error", abortErr.Error())
+			if _, ok := request.(*Transaction); ok {
+				return TransactionResponse{}, abortErr
+			}
+
+			return &services.Response{}, abortErr
+		}
+
+		if !invoked {
+			logger.Trace("request skipped by interceptor")
+			if _, ok := request.(*Transaction); ok {
+				return TransactionResponse{}, errInterceptorSkippedRequest
+			}
+
+			return &services.Response{}, errInterceptorSkippedRequest
+		}
+
+		if err != nil && ctx.Err() != nil {
+			// The caller's context ended the call, not the node, so the node keeps its health
+			return _ExecutableContextDone(ctx, request, attempt+1, err)
 		}
 		if err != nil {
 			errPersistent = err
-			if _ExecutableDefaultRetryHandler(logID, err) {
+			if _ExecutableDefaultRetryHandler(logger, err) {
 				client.network._IncreaseBackoff(node)
 				continue
 			}
@@ -239,19 +408,19 @@ func _Execute( // nolint
 		}
 
 		node._DecreaseBackoff()
-		switch shouldRetry(logID, request, resp) {
+		switch state {
 		case executionStateRetry:
 			errPersistent = mapStatusError(request, resp)
-			_DelayForAttempt(logID, backOff.NextBackOff(), attempt)
+			if !stats._DelayForAttempt(ctx, backOff.NextBackOff(), attempt) {
+				return _ExecutableContextDone(ctx, request, attempt+1, errPersistent)
+			}
 			continue
 		case executionStateExpired:
 			if transaction, ok := request.(*Transaction); ok {
 				if !client.GetOperatorAccountID()._IsZero() && transaction.regenerateTransactionID && !transaction.transactionIDs.locked {
-					logCtx.Trace().Str("requestId", logID).Msg("received `TRANSACTION_EXPIRED` with transaction ID regeneration enabled; regenerating")
+					logger.Trace("received `TRANSACTION_EXPIRED` with transaction ID regeneration enabled; regenerating")
 					transaction.transactionIDs._Set(transaction.transactionIDs.index, TransactionIDGenerate(client.GetOperatorAccountID()))
-					if err != nil {
-						panic(err)
-					}
+					signed = false
 					continue
 				} else {
 					return TransactionResponse{}, mapStatusError(request, resp)
@@ -266,7 +435,11 @@ func _Execute( // nolint
 
 			return &services.Response{}, mapStatusError(request, resp)
 		case executionStateFinished:
-			logCtx.Trace().Str("Response Proto:", hex.EncodeToString(marshaledResponse)).Msg("finished")
+			if statusErr, ok := mapStatusError(request, resp).(ErrHederaPreCheckStatus); ok {
+				stats.status = statusErr.Status.String()
+			}
+
+			logger.Trace("finished", "Response Proto:", hex.EncodeToString(marshaledResponse))
 
 			return mapResponse(request, resp, node.accountID, protoRequest)
 		}
@@ -283,14 +456,137 @@ func _Execute( // nolint
 	return &services.Response{}, errPersistent
 }
 
-func _DelayForAttempt(logID string, backoff time.Duration, attempt int64) {
-	logCtx.Trace().Str("requestId", logID).Dur("delay", backoff).Int64("attempt", attempt+1).Msg("retrying request attempt")
-	time.Sleep(backoff)
+// _DelayForAttempt waits for the backoff duration, returning false if the context
+// is done before the wait is over.
+func _DelayForAttempt(ctx context.Context, logger Logger, backoff time.Duration, attempt int64) bool {
+	logger.Trace("retrying request attempt", "delay", backoff, "attempt", attempt+1)
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (stats *_ExecutionStats) _DelayForAttempt(ctx context.Context, backoff time.Duration, attempt int64) bool {
+	stats.span.AddEvent(EventBackoff,
+		Attribute{AttributeAttempt, attempt},
+		Attribute{AttributeBackoffDelay, _DurationToMilliseconds(backoff)},
+	)
+	stats.meter.RecordHistogram(ctx, MetricBackoffDelay, _DurationToMilliseconds(backoff), Attribute{AttributeRequestType, stats.requestType})
+
+	return _DelayForAttempt(ctx, stats.logger, backoff, attempt)
+}
+
+func (stats *_ExecutionStats) _RecordAttempt(ctx context.Context, attempt *RequestAttempt) {
+	attributes := []Attribute{
+		{AttributeAttempt, attempt.Attempt},
+		{AttributeNodeAccountID, attempt.NodeAccountID.String()},
+		{AttributeLatency, _DurationToMilliseconds(attempt.Latency)},
+	}
+	if attempt.Err != nil {
+		attributes = append(attributes, Attribute{AttributeError, attempt.Err.Error()})
+	}
+
+	stats.span.AddEvent(EventAttempt, attributes...)
+	stats.meter.AddCounter(ctx, MetricAttempts, 1,
+		Attribute{AttributeRequestType, stats.requestType},
+		Attribute{AttributeNodeAccountID, attempt.NodeAccountID.String()},
+	)
+}
+
+func _ExecutableSpanName(request interface{}, requestType string) string {
+	if _, ok := request.(*Transaction); ok {
+		return SpanTransactionExecute
+	}
+
+	if requestType == "TransactionReceiptQuery" {
+		return SpanReceiptPoll
+	}
+
+	return SpanQueryExecute
+}
+
+func _ExecutableRetryCount(attempts int64) int64 {
+	if attempts == 0 {
+		return 0
+	}
+
+	return attempts - 1
+}
+
+func _ExecutableErrorStatus(err error) string {
+	var precheckErr ErrHederaPreCheckStatus
+	if errors.As(err, &precheckErr) {
+		return precheckErr.Status.String()
+	}
+
+	var contextErr ErrContextDone
+	if errors.As(err, &contextErr) {
+		return "CONTEXT_DONE"
+	}
+
+	if grpcErr, ok := status.FromError(errors.Cause(err)); ok {
+		return grpcErr.Code().String()
+	}
+
+	return "ERROR"
+}
+
+func _ExecutableTransactionID(request interface{}, protoRequest interface{}) string {
+	if transaction, ok := request.(*Transaction); ok {
+		if transaction.transactionIDs._Length() > 0 {
+			return transaction.transactionIDs._GetCurrent().(TransactionID).String()
+		}
+
+		return ""
+	}
+
+	if pb, ok := protoRequest.(*services.Query); ok {
+		switch q := pb.Query.(type) {
+		case *services.Query_TransactionGetReceipt:
+			if q.TransactionGetReceipt.TransactionID != nil {
+				return _TransactionIDFromProtobuf(q.TransactionGetReceipt.TransactionID).String()
+			}
+		case *services.Query_TransactionGetRecord:
+			if q.TransactionGetRecord.TransactionID != nil {
+				return _TransactionIDFromProtobuf(q.TransactionGetRecord.TransactionID).String()
+			}
+		}
+	}
+
+	if query, ok := request.(*Query); ok && query.paymentTransactionIDs._Length() > 0 {
+		return query.paymentTransactionIDs._GetCurrent().(TransactionID).String()
+	}
+
+	return ""
+}
+
+func _DurationToMilliseconds(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
+
+func _ExecutableContextDone(ctx context.Context, request interface{}, attempt int64, lastErr error) (interface{}, error) {
+	err := ErrContextDone{
+		Err:       ctx.Err(),
+		Attempts:  attempt,
+		LastError: lastErr,
+	}
+
+	if _, ok := request.(*Transaction); ok {
+		return TransactionResponse{}, err
+	}
+
+	return &services.Response{}, err
 }
 
-func _ExecutableDefaultRetryHandler(logID string, err error) bool {
+func _ExecutableDefaultRetryHandler(logger Logger, err error) bool {
 	code := status.Code(err)
-	logCtx.Trace().Str("requestId", logID).Str("status", code.String()).Msg("received gRPC error with status code")
+	logger.Trace("received gRPC error with status code", "status", code.String())
 	switch code {
 	case codes.ResourceExhausted, codes.Unavailable:
 		return true
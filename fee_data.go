@@ -31,6 +31,7 @@ type FeeData struct {
 	NodeData    *FeeComponents
 	NetworkData *FeeComponents
 	ServiceData *FeeComponents
+	FeeDataType FeeDataType
 }
 
 func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
@@ -57,6 +58,7 @@ func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
 		NodeData:    &nodeData,
 		NetworkData: &networkData,
 		ServiceData: &serviceData,
+		FeeDataType: FeeDataType(feeData.GetSubType()),
 	}, nil
 }
 
@@ -80,6 +82,7 @@ func (feeData FeeData) _ToProtobuf() *services.FeeData {
 		Nodedata:    nodeData,
 		Networkdata: networkData,
 		Servicedata: serviceData,
+		SubType:     services.SubType(feeData.FeeDataType),
 	}
 }
 
@@ -111,5 +114,5 @@ func FeeDataFromBytes(data []byte) (FeeData, error) {
 }
 
 func (feeData FeeData) String() string {
-	return fmt.Sprintf("\nNodedata: %s\nNetworkdata: %s\nServicedata: %s\n", feeData.NodeData.String(), feeData.NetworkData.String(), feeData.ServiceData.String())
+	return fmt.Sprintf("\nNodedata: %s\nNetworkdata: %s\nServicedata: %s\nFeeDataType: %s\n", feeData.NodeData.String(), feeData.NetworkData.String(), feeData.ServiceData.String(), feeData.FeeDataType.String())
 }
@@ -0,0 +1,497 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Sizes, in bytes, and storage periods the network uses when it turns a transaction or query into usage metrics.
+const (
+	_FeeIntSize                    = 4
+	_FeeLongSize                   = 8
+	_FeeBoolSize                   = 4
+	_FeeEntityIDSize               = 3 * _FeeLongSize
+	_FeeKeySize                    = 32
+	_FeeTransactionHashSize        = 48
+	_FeeExchangeRateSize           = 2*_FeeIntSize + _FeeLongSize
+	_FeeReceiptSize                = _FeeIntSize + 2*_FeeExchangeRateSize
+	_FeeTransactionIDSize          = _FeeEntityIDSize + _FeeLongSize
+	_FeeAccountAmountSize          = _FeeEntityIDSize + _FeeLongSize
+	_FeeNftTransferSize            = 2*_FeeEntityIDSize + _FeeLongSize
+	_FeeRecordSize                 = _FeeReceiptSize + _FeeTransactionHashSize + _FeeLongSize + _FeeTransactionIDSize + _FeeLongSize
+	_FeeAccountSize                = 8*_FeeLongSize + _FeeBoolSize
+	_FeeQueryHeaderSize            = 212
+	_FeeQueryResponseHeaderSize    = 2*_FeeIntSize + _FeeLongSize
+	_FeeSignaturePairSize          = 102
+	_FeeReceiptStorageSeconds      = 180
+	_FeeComponentDivisor           = 1000
+	_FeeSecondsPerHour             = 3600
+	_FeeDefaultFileLifetimeSeconds = 7890000
+)
+
+// FeeEstimator computes, without contacting the network, what a transaction or query is expected to cost. It prices
+// usage metrics derived from the built request against a FeeSchedule, and converts the result to hbar with an
+// ExchangeRate.
+//
+// The network's own usage estimators know the state a transaction touches, such as the lifetime left on a file being
+// appended to or the custom fees of a transferred token; an estimate only sees the request, so treat the result as a
+// close approximation rather than the exact fee charged. A fee that does not fit in an int64 of tinybars, which only
+// an unrealistic schedule, usage or exchange rate produces, is reported as an error.
+type FeeEstimator struct {
+	feeSchedule       FeeSchedule
+	exchangeRate      ExchangeRate
+	signatureCount    int
+	queryResponseSize int64
+}
+
+// FeeEstimate is the estimated cost of a transaction or query, broken down into the fee paid to the node that
+// submits it, the fee paid to the network for reaching consensus and storing the receipt, and the fee for the
+// service itself.
+type FeeEstimate struct {
+	RequestType RequestType
+	FeeDataType FeeDataType
+	// Usage holds the usage metrics the fees were priced against, in the same units the fee schedule prices.
+	Usage      FeeData
+	NodeFee    Hbar
+	NetworkFee Hbar
+	ServiceFee Hbar
+	Total      Hbar
+}
+
+// NewFeeEstimator creates a FeeEstimator which prices usage with the given fee schedule and exchange rate.
+func NewFeeEstimator(feeSchedule FeeSchedule, exchangeRate ExchangeRate) *FeeEstimator {
+	return &FeeEstimator{
+		feeSchedule:  feeSchedule,
+		exchangeRate: exchangeRate,
+	}
+}
+
+// SetFeeSchedule sets the fee schedule usage is priced with.
+func (estimator *FeeEstimator) SetFeeSchedule(feeSchedule FeeSchedule) *FeeEstimator {
+	estimator.feeSchedule = feeSchedule
+	return estimator
+}
+
+func (estimator *FeeEstimator) GetFeeSchedule() FeeSchedule {
+	return estimator.feeSchedule
+}
+
+// SetExchangeRate sets the exchange rate used to convert fees from tinycents to hbar.
+func (estimator *FeeEstimator) SetExchangeRate(exchangeRate ExchangeRate) *FeeEstimator {
+	estimator.exchangeRate = exchangeRate
+	return estimator
+}
+
+func (estimator *FeeEstimator) GetExchangeRate() ExchangeRate {
+	return estimator.exchangeRate
+}
+
+// SetSignatureCount sets the number of signatures a transaction will carry when it is submitted. By default the
+// signatures already on the transaction are counted, with a minimum of one for the payer.
+func (estimator *FeeEstimator) SetSignatureCount(signatureCount int) *FeeEstimator {
+	estimator.signatureCount = signatureCount
+	return estimator
+}
+
+func (estimator *FeeEstimator) GetSignatureCount() int {
+	return estimator.signatureCount
+}
+
+// SetQueryResponseSize sets the size, in bytes, of the response a query is expected to return. By default a size
+// typical for the kind of query is assumed; queries with variable sized responses, such as file contents, should set it.
+func (estimator *FeeEstimator) SetQueryResponseSize(queryResponseSize int64) *FeeEstimator {
+	estimator.queryResponseSize = queryResponseSize
+	return estimator
+}
+
+func (estimator *FeeEstimator) GetQueryResponseSize() int64 {
+	return estimator.queryResponseSize
+}
+
+// EstimateTransaction estimates the fee of a frozen transaction. The transaction must be passed as a pointer, as
+// returned by its constructor.
+func (estimator *FeeEstimator) EstimateTransaction(transaction interface{}) (FeeEstimate, error) {
+	base, ok := transaction.(interface{ _GetTransaction() *Transaction })
+	if !ok {
+		return FeeEstimate{}, errFeeEstimatorUnsupportedTransaction
+	}
+
+	tx := base._GetTransaction()
+	if !tx._IsFrozen() || tx.signedTransactions._Length() == 0 {
+		return FeeEstimate{}, errTransactionIsNotFrozen
+	}
+
+	signedTransaction := tx.signedTransactions._Get(0).(*services.SignedTransaction)
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(signedTransaction.BodyBytes, &body); err != nil {
+		return FeeEstimate{}, errors.Wrap(err, "failed to parse transaction body")
+	}
+
+	requestType, ok := _RequestTypeFromTransactionBody(&body)
+	if !ok {
+		return FeeEstimate{}, errFeeEstimatorUnsupportedTransaction
+	}
+
+	signatureCount := estimator.signatureCount
+	signed := len(signedTransaction.GetSigMap().GetSigPair())
+	if signatureCount <= 0 {
+		signatureCount = signed
+		if signatureCount == 0 {
+			signatureCount = 1
+		}
+	}
+
+	size := int64(protobuf.Size(signedTransaction))
+	if signatureCount > signed {
+		size += int64(signatureCount-signed) * _FeeSignaturePairSize
+	}
+
+	usage := FeeData{
+		NodeData: &FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: size,
+			TransactionVerification:  1,
+			ResponseMemoryByte:       _FeeIntSize,
+		},
+		NetworkData: &FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: size,
+			TransactionVerification:  int64(signatureCount),
+			TransactionRamByteHour:   _FeeByteHours(_FeeReceiptSize, _FeeReceiptStorageSeconds),
+		},
+		ServiceData: _FeeEstimatorServiceUsage(&body),
+		FeeDataType: _FeeEstimatorFeeDataType(&body),
+	}
+
+	return estimator._Estimate(requestType, usage)
+}
+
+// EstimateQuery estimates the cost of answering a query, excluding the fee of the transaction paying for it. The
+// query must be passed as a pointer, as returned by its constructor.
+func (estimator *FeeEstimator) EstimateQuery(query interface{}) (FeeEstimate, error) {
+	pb, requestType, responseSize, ok := _FeeEstimatorQuery(query)
+	if !ok {
+		return FeeEstimate{}, errFeeEstimatorUnsupportedQuery
+	}
+
+	if estimator.queryResponseSize > 0 {
+		responseSize = estimator.queryResponseSize
+	}
+
+	usage := FeeData{
+		NodeData: &FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: _FeeQueryHeaderSize + int64(protobuf.Size(pb)),
+			ResponseMemoryByte:       _FeeQueryResponseHeaderSize + responseSize,
+		},
+		NetworkData: &FeeComponents{},
+		ServiceData: &FeeComponents{},
+		FeeDataType: FeeDataTypeDefault,
+	}
+
+	if call, ok := query.(*ContractCallQuery); ok {
+		usage.NodeData.ContractTransactionGas = int64(call.gas)
+	}
+
+	return estimator._Estimate(requestType, usage)
+}
+
+func (estimator *FeeEstimator) _Estimate(requestType RequestType, usage FeeData) (FeeEstimate, error) {
+	if estimator.exchangeRate.Hbars <= 0 || estimator.exchangeRate.cents <= 0 {
+		return FeeEstimate{}, errFeeEstimatorInvalidExchangeRate
+	}
+
+	prices := estimator._Prices(requestType, usage.FeeDataType)
+	if prices == nil {
+		return FeeEstimate{}, errors.Errorf("fee schedule has no fees for %s", requestType.String())
+	}
+
+	var fees [3]Hbar
+	var total big.Int
+	for i, component := range [][2]*FeeComponents{
+		{prices.NodeData, usage.NodeData},
+		{prices.NetworkData, usage.NetworkData},
+		{prices.ServiceData, usage.ServiceData},
+	} {
+		tinybars := estimator._Tinybars(_FeeComponentTinycents(component[0], component[1]))
+		if !tinybars.IsInt64() {
+			return FeeEstimate{}, errFeeEstimatorOverflow
+		}
+
+		fees[i] = HbarFromTinybar(tinybars.Int64())
+		total.Add(&total, tinybars)
+	}
+	if !total.IsInt64() {
+		return FeeEstimate{}, errFeeEstimatorOverflow
+	}
+
+	estimate := FeeEstimate{
+		RequestType: requestType,
+		FeeDataType: prices.FeeDataType,
+		Usage:       usage,
+		NodeFee:     fees[0],
+		NetworkFee:  fees[1],
+		ServiceFee:  fees[2],
+		Total:       HbarFromTinybar(total.Int64()),
+	}
+
+	return estimate, nil
+}
+
+// _Prices finds the prices of the request type, falling back to the default prices when the schedule lists none for
+// the requested fee data type.
+func (estimator *FeeEstimator) _Prices(requestType RequestType, feeDataType FeeDataType) *FeeData {
+	for _, schedule := range estimator.feeSchedule.TransactionFeeSchedules {
+		if schedule.RequestType != requestType {
+			continue
+		}
+
+		var fallback *FeeData
+		for _, fees := range schedule.Fees {
+			if fees == nil {
+				continue
+			}
+			if fees.FeeDataType == feeDataType {
+				return fees
+			}
+			if fees.FeeDataType == FeeDataTypeDefault || fallback == nil {
+				fallback = fees
+			}
+		}
+
+		if fallback != nil {
+			return fallback
+		}
+
+		return schedule.FeeData
+	}
+
+	return nil
+}
+
+// _Tinybars converts tinycents to tinybars at the exchange rate of the estimator. The arithmetic is exact, so the
+// caller can tell a fee too large for an int64 of tinybars from a real one.
+func (estimator *FeeEstimator) _Tinybars(tinycents *big.Int) *big.Int {
+	tinybars := new(big.Int).Mul(tinycents, big.NewInt(int64(estimator.exchangeRate.Hbars)))
+	return tinybars.Quo(tinybars, big.NewInt(int64(estimator.exchangeRate.cents)))
+}
+
+// _FeeComponentTinycents prices usage the way the network does: fee schedules are denominated in thousandths of a
+// tinycent and clamped to the component's bounds, and any non-zero fee costs at least one tinycent.
+func _FeeComponentTinycents(prices *FeeComponents, usage *FeeComponents) *big.Int {
+	total := new(big.Int)
+	if prices == nil || usage == nil {
+		return total
+	}
+
+	for _, term := range [][2]int64{
+		{prices.Constant, usage.Constant},
+		{prices.TransactionBandwidthByte, usage.TransactionBandwidthByte},
+		{prices.TransactionVerification, usage.TransactionVerification},
+		{prices.TransactionRamByteHour, usage.TransactionRamByteHour},
+		{prices.TransactionStorageByteHour, usage.TransactionStorageByteHour},
+		{prices.ContractTransactionGas, usage.ContractTransactionGas},
+		{prices.TransferVolumeHbar, usage.TransferVolumeHbar},
+		{prices.ResponseMemoryByte, usage.ResponseMemoryByte},
+		{prices.ResponseDiscByte, usage.ResponseDiscByte},
+	} {
+		total.Add(total, new(big.Int).Mul(big.NewInt(term[0]), big.NewInt(term[1])))
+	}
+
+	if total.Cmp(big.NewInt(prices.Min)) < 0 {
+		total.SetInt64(prices.Min)
+	}
+	if prices.Max > 0 && total.Cmp(big.NewInt(prices.Max)) > 0 {
+		total.SetInt64(prices.Max)
+	}
+
+	positive := total.Sign() > 0
+	total.Quo(total, big.NewInt(_FeeComponentDivisor))
+	if total.Sign() == 0 && positive {
+		total.SetInt64(1)
+	}
+
+	return total
+}
+
+// _FeeByteHours converts bytes kept for the given number of seconds into byte hours, rounding up.
+func _FeeByteHours(bytes int64, seconds int64) int64 {
+	if bytes <= 0 || seconds <= 0 {
+		return 0
+	}
+
+	return (bytes*seconds + _FeeSecondsPerHour - 1) / _FeeSecondsPerHour
+}
+
+// _FeeEstimatorServiceUsage derives the usage of the service itself: the record kept for the transaction, state the
+// transaction creates for as long as the body says it lives, and gas offered to contracts.
+func _FeeEstimatorServiceUsage(body *services.TransactionBody) *FeeComponents {
+	recordSize := int64(_FeeRecordSize + len(body.Memo))
+	validStart := body.GetTransactionID().GetTransactionValidStart()
+	usage := FeeComponents{Constant: 1}
+
+	switch data := body.Data.(type) {
+	case *services.TransactionBody_CryptoTransfer:
+		recordSize += int64(len(data.CryptoTransfer.GetTransfers().GetAccountAmounts())) * _FeeAccountAmountSize
+		for _, tokenTransfers := range data.CryptoTransfer.GetTokenTransfers() {
+			recordSize += _FeeEntityIDSize +
+				int64(len(tokenTransfers.GetTransfers()))*_FeeAccountAmountSize +
+				int64(len(tokenTransfers.GetNftTransfers()))*_FeeNftTransferSize
+		}
+	case *services.TransactionBody_CryptoCreateAccount:
+		state := _FeeAccountSize + int64(protobuf.Size(data.CryptoCreateAccount))
+		usage.TransactionStorageByteHour = _FeeByteHours(state, data.CryptoCreateAccount.GetAutoRenewPeriod().GetSeconds())
+	case *services.TransactionBody_ConsensusCreateTopic:
+		state := _FeeEntityIDSize + int64(protobuf.Size(data.ConsensusCreateTopic))
+		usage.TransactionStorageByteHour = _FeeByteHours(state, data.ConsensusCreateTopic.GetAutoRenewPeriod().GetSeconds())
+	case *services.TransactionBody_TokenCreation:
+		state := _FeeEntityIDSize + int64(protobuf.Size(data.TokenCreation))
+		usage.TransactionStorageByteHour = _FeeByteHours(state, data.TokenCreation.GetAutoRenewPeriod().GetSeconds())
+	case *services.TransactionBody_FileCreate:
+		lifetime := int64(_FeeDefaultFileLifetimeSeconds)
+		if expiration := data.FileCreate.GetExpirationTime(); expiration != nil && validStart != nil {
+			lifetime = expiration.Seconds - validStart.Seconds
+		}
+		usage.TransactionStorageByteHour = _FeeByteHours(int64(protobuf.Size(data.FileCreate)), lifetime)
+	case *services.TransactionBody_FileAppend:
+		usage.TransactionStorageByteHour = _FeeByteHours(int64(len(data.FileAppend.GetContents())), _FeeDefaultFileLifetimeSeconds)
+	case *services.TransactionBody_FileUpdate:
+		lifetime := int64(_FeeDefaultFileLifetimeSeconds)
+		if expiration := data.FileUpdate.GetExpirationTime(); expiration != nil && validStart != nil {
+			lifetime = expiration.Seconds - validStart.Seconds
+		}
+		usage.TransactionStorageByteHour = _FeeByteHours(int64(len(data.FileUpdate.GetContents())), lifetime)
+	case *services.TransactionBody_ContractCreateInstance:
+		usage.ContractTransactionGas = data.ContractCreateInstance.GetGas()
+		state := _FeeAccountSize + int64(len(data.ContractCreateInstance.GetInitcode()))
+		usage.TransactionStorageByteHour = _FeeByteHours(state, data.ContractCreateInstance.GetAutoRenewPeriod().GetSeconds())
+	case *services.TransactionBody_ContractCall:
+		usage.ContractTransactionGas = data.ContractCall.GetGas()
+	case *services.TransactionBody_EthereumTransaction:
+		var ethereumTransaction types.Transaction
+		if err := ethereumTransaction.UnmarshalBinary(data.EthereumTransaction.GetEthereumData()); err == nil {
+			usage.ContractTransactionGas = int64(ethereumTransaction.Gas())
+		}
+	}
+
+	usage.TransactionRamByteHour = _FeeByteHours(recordSize, _FeeReceiptStorageSeconds)
+
+	return &usage
+}
+
+// _FeeEstimatorFeeDataType picks the prices that apply to the body. Whether a token charges custom fees is only known
+// to the network, so only token creation can select the custom fee prices.
+func _FeeEstimatorFeeDataType(body *services.TransactionBody) FeeDataType {
+	switch data := body.Data.(type) {
+	case *services.TransactionBody_CryptoTransfer:
+		feeDataType := FeeDataTypeDefault
+		for _, tokenTransfers := range data.CryptoTransfer.GetTokenTransfers() {
+			if len(tokenTransfers.GetNftTransfers()) > 0 {
+				return FeeDataTypeTokenNonFungibleUnique
+			}
+			feeDataType = FeeDataTypeTokenFungibleCommon
+		}
+		return feeDataType
+	case *services.TransactionBody_TokenCreation:
+		customFees := len(data.TokenCreation.GetCustomFees()) > 0
+		if data.TokenCreation.GetTokenType() == services.TokenType_NON_FUNGIBLE_UNIQUE {
+			if customFees {
+				return FeeDataTypeTokenNonFungibleUniqueWithCustomFees
+			}
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		if customFees {
+			return FeeDataTypeTokenFungibleCommonWithCustomFees
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenMint:
+		if len(data.TokenMint.GetMetadata()) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenBurn:
+		if len(data.TokenBurn.GetSerialNumbers()) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenWipe:
+		if len(data.TokenWipe.GetSerialNumbers()) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_ScheduleCreate:
+		if _, ok := data.ScheduleCreate.GetScheduledTransactionBody().GetData().(*services.SchedulableTransactionBody_ContractCall); ok {
+			return FeeDataTypeScheduleCreateContractCall
+		}
+	}
+
+	return FeeDataTypeDefault
+}
+
+// _FeeEstimatorQuery builds the protobuf of a query, along with its request type and the size of a typical response.
+func _FeeEstimatorQuery(query interface{}) (*services.Query, RequestType, int64, bool) { // nolint
+	switch query := query.(type) {
+	case *AccountBalanceQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeCryptoGetAccountBalance, _FeeEntityIDSize + _FeeLongSize, true
+	case *AccountInfoQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeCryptoGetInfo, _FeeAccountSize + _FeeKeySize, true
+	case *AccountRecordsQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeCryptoGetAccountRecords, _FeeRecordSize, true
+	case *AccountStakersQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeCryptoGetStakers, _FeeAccountAmountSize, true
+	case *ContractBytecodeQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeContractGetBytecode, 0, true
+	case *ContractCallQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeContractCallLocal, int64(query.maxResultSize), true
+	case *ContractInfoQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeContractGetInfo, _FeeAccountSize + _FeeKeySize, true
+	case *FileContentsQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeFileGetContents, 0, true
+	case *FileInfoQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeFileGetInfo, _FeeEntityIDSize + _FeeLongSize + _FeeBoolSize + _FeeKeySize, true
+	case *LiveHashQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeCryptoGetLiveHash, _FeeTransactionHashSize + _FeeKeySize, true
+	case *NetworkVersionInfoQuery:
+		pb := services.Query_NetworkGetVersionInfo{NetworkGetVersionInfo: &services.NetworkGetVersionInfoQuery{}}
+		return &services.Query{Query: &pb}, RequestTypeGetVersionInfo, 6 * _FeeIntSize, true
+	case *ScheduleInfoQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeScheduleGetInfo, 0, true
+	case *TokenInfoQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeTokenGetInfo, 0, true
+	case *TokenNftInfoQuery:
+		return &services.Query{Query: query._BuildByNft()}, RequestTypeTokenGetNftInfo, _FeeEntityIDSize + 2*_FeeLongSize, true
+	case *TopicInfoQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeConsensusGetTopicInfo, _FeeTransactionHashSize + 2*_FeeLongSize + _FeeKeySize, true
+	case *TransactionReceiptQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeTransactionGetReceipt, _FeeReceiptSize, true
+	case *TransactionRecordQuery:
+		return &services.Query{Query: query._Build()}, RequestTypeTransactionGetRecord, _FeeRecordSize, true
+	}
+
+	return nil, RequestTypeNone, 0, false
+}
@@ -0,0 +1,273 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"math"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewUnitFeeEstimator(t *testing.T) *FeeEstimator {
+	dat, err := os.ReadFile("./fee_schedule/fee_schedule.pb")
+	require.NoError(t, err)
+	feeSchedules, err := FeeSchedulesFromBytes(dat)
+	require.NoError(t, err)
+
+	// 1 hbar is worth 6 cents
+	return NewFeeEstimator(feeSchedules.GetCurrent(), NewExchangeRate(30000, 180000))
+}
+
+func _NewUnitFeeEstimatorTransfer() *TransferTransaction {
+	return NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(NewTransactionIDWithValidStart(AccountID{Account: 1800}, time.Unix(1600000000, 0))).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1))
+}
+
+func TestUnitFeeEstimatorCryptoTransfer(t *testing.T) {
+	estimator := _NewUnitFeeEstimator(t)
+
+	transfer, err := _NewUnitFeeEstimatorTransfer().Freeze()
+	require.NoError(t, err)
+
+	estimate, err := estimator.EstimateTransaction(transfer)
+	require.NoError(t, err)
+
+	assert.Equal(t, RequestTypeCryptoTransfer, estimate.RequestType)
+	assert.Equal(t, FeeDataTypeDefault, estimate.FeeDataType)
+	assert.Equal(t, int64(1), estimate.Usage.NetworkData.TransactionVerification)
+	assert.Equal(t, estimate.Total.AsTinybar(), estimate.NodeFee.AsTinybar()+estimate.NetworkFee.AsTinybar()+estimate.ServiceFee.AsTinybar())
+
+	prices := estimator._Prices(RequestTypeCryptoTransfer, FeeDataTypeDefault)
+	require.NotNil(t, prices)
+	usage := estimate.Usage.NodeData
+	nodeTinycents := (prices.NodeData.Constant +
+		prices.NodeData.TransactionBandwidthByte*usage.TransactionBandwidthByte +
+		prices.NodeData.TransactionVerification +
+		prices.NodeData.ResponseMemoryByte*4) / 1000
+	assert.Equal(t, nodeTinycents*30000/180000, estimate.NodeFee.AsTinybar())
+
+	// A hbar transfer costs around a hundredth of a cent
+	assert.Greater(t, estimate.Total.AsTinybar(), HbarFrom(0.001, HbarUnits.Hbar).AsTinybar())
+	assert.Less(t, estimate.Total.AsTinybar(), HbarFrom(0.003, HbarUnits.Hbar).AsTinybar())
+}
+
+func TestUnitFeeEstimatorSignatureCount(t *testing.T) {
+	estimator := _NewUnitFeeEstimator(t)
+
+	transfer, err := _NewUnitFeeEstimatorTransfer().Freeze()
+	require.NoError(t, err)
+
+	single, err := estimator.EstimateTransaction(transfer)
+	require.NoError(t, err)
+
+	estimator.SetSignatureCount(3)
+	multiple, err := estimator.EstimateTransaction(transfer)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), multiple.Usage.NetworkData.TransactionVerification)
+	assert.Equal(t, single.Usage.NodeData.TransactionBandwidthByte+2*_FeeSignaturePairSize, multiple.Usage.NodeData.TransactionBandwidthByte)
+	assert.Greater(t, multiple.NetworkFee.AsTinybar(), single.NetworkFee.AsTinybar())
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	estimator.SetSignatureCount(0)
+	signed, err := estimator.EstimateTransaction(transfer.Sign(key))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), signed.Usage.NetworkData.TransactionVerification)
+	assert.InDelta(t, single.Usage.NodeData.TransactionBandwidthByte, signed.Usage.NodeData.TransactionBandwidthByte, 4)
+}
+
+func TestUnitFeeEstimatorFeeDataType(t *testing.T) {
+	estimator := _NewUnitFeeEstimator(t)
+	tokenID := TokenID{Token: 5}
+
+	fungible, err := _NewUnitFeeEstimatorTransfer().
+		AddTokenTransfer(tokenID, AccountID{Account: 1800}, -1).
+		AddTokenTransfer(tokenID, AccountID{Account: 2}, 1).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err := estimator.EstimateTransaction(fungible)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeTokenFungibleCommon, estimate.FeeDataType)
+
+	nft, err := _NewUnitFeeEstimatorTransfer().
+		AddNftTransfer(tokenID.Nft(1), AccountID{Account: 1800}, AccountID{Account: 2}).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err = estimator.EstimateTransaction(nft)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeTokenNonFungibleUnique, estimate.FeeDataType)
+
+	mint, err := NewTokenMintTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetTokenID(tokenID).
+		SetMetadata([]byte{1, 2, 3}).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err = estimator.EstimateTransaction(mint)
+	require.NoError(t, err)
+	assert.Equal(t, RequestTypeTokenMint, estimate.RequestType)
+	assert.Equal(t, FeeDataTypeTokenNonFungibleUnique, estimate.FeeDataType)
+}
+
+func TestUnitFeeEstimatorStorage(t *testing.T) {
+	estimator := _NewUnitFeeEstimator(t)
+	validStart := time.Unix(1600000000, 0)
+
+	create := func(lifetime time.Duration) *FileCreateTransaction {
+		transaction, err := NewFileCreateTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionID(NewTransactionIDWithValidStart(AccountID{Account: 1800}, validStart)).
+			SetContents(make([]byte, 1024)).
+			SetExpirationTime(validStart.Add(lifetime)).
+			Freeze()
+		require.NoError(t, err)
+		return transaction
+	}
+
+	short, err := estimator.EstimateTransaction(create(24 * time.Hour))
+	require.NoError(t, err)
+	long, err := estimator.EstimateTransaction(create(90 * 24 * time.Hour))
+	require.NoError(t, err)
+
+	assert.Equal(t, RequestTypeFileCreate, short.RequestType)
+	assert.Equal(t, short.Usage.ServiceData.TransactionStorageByteHour*90, long.Usage.ServiceData.TransactionStorageByteHour)
+	assert.Greater(t, long.ServiceFee.AsTinybar(), short.ServiceFee.AsTinybar())
+
+	call, err := NewContractExecuteTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetContractID(ContractID{Contract: 5}).
+		SetGas(100000).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err := estimator.EstimateTransaction(call)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100000), estimate.Usage.ServiceData.ContractTransactionGas)
+}
+
+func TestUnitFeeEstimatorQuery(t *testing.T) {
+	estimator := _NewUnitFeeEstimator(t)
+
+	estimate, err := estimator.EstimateQuery(NewAccountInfoQuery().SetAccountID(AccountID{Account: 5}))
+	require.NoError(t, err)
+
+	assert.Equal(t, RequestTypeCryptoGetInfo, estimate.RequestType)
+	assert.Greater(t, estimate.NodeFee.AsTinybar(), int64(0))
+	assert.Equal(t, int64(0), estimate.NetworkFee.AsTinybar())
+	assert.Equal(t, int64(0), estimate.ServiceFee.AsTinybar())
+	assert.Equal(t, estimate.NodeFee, estimate.Total)
+
+	larger, err := estimator.
+		SetQueryResponseSize(10000).
+		EstimateQuery(NewFileContentsQuery().SetFileID(FileID{File: 150}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(_FeeQueryResponseHeaderSize+10000), larger.Usage.NodeData.ResponseMemoryByte)
+}
+
+func TestUnitFeeEstimatorErrors(t *testing.T) {
+	estimator := _NewUnitFeeEstimator(t)
+
+	_, err := estimator.EstimateTransaction(_NewUnitFeeEstimatorTransfer())
+	assert.ErrorIs(t, err, errTransactionIsNotFrozen)
+
+	transfer, err := _NewUnitFeeEstimatorTransfer().Freeze()
+	require.NoError(t, err)
+
+	_, err = estimator.EstimateTransaction(*transfer)
+	assert.ErrorIs(t, err, errFeeEstimatorUnsupportedTransaction)
+
+	_, err = estimator.EstimateQuery(NewTopicMessageQuery())
+	assert.ErrorIs(t, err, errFeeEstimatorUnsupportedQuery)
+
+	_, err = estimator.SetExchangeRate(ExchangeRate{}).EstimateTransaction(transfer)
+	assert.ErrorIs(t, err, errFeeEstimatorInvalidExchangeRate)
+
+	_, err = NewFeeEstimator(FeeSchedule{}, NewExchangeRate(1, 12)).EstimateTransaction(transfer)
+	assert.Error(t, err)
+}
+
+func TestUnitFeeEstimatorOverflow(t *testing.T) {
+	transfer, err := _NewUnitFeeEstimatorTransfer().Freeze()
+	require.NoError(t, err)
+
+	schedule := func(prices FeeComponents) FeeSchedule {
+		return FeeSchedule{TransactionFeeSchedules: []TransactionFeeSchedule{{
+			RequestType: RequestTypeCryptoTransfer,
+			Fees:        []*FeeData{{NodeData: &prices, FeeDataType: FeeDataTypeDefault}},
+		}}}
+	}
+
+	// The largest fee a schedule can hold fits at a realistic rate
+	estimate, err := NewFeeEstimator(schedule(FeeComponents{Constant: math.MaxInt64}), NewExchangeRate(1, 12)).
+		EstimateTransaction(transfer)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64/1000/12), estimate.NodeFee.AsTinybar())
+
+	// Price times usage exceeds an int64 before it is divided into tinycents
+	estimate, err = NewFeeEstimator(schedule(FeeComponents{TransactionBandwidthByte: math.MaxInt64}), NewExchangeRate(1, 1)).
+		EstimateTransaction(transfer)
+	require.NoError(t, err)
+	bandwidth := estimate.Usage.NodeData.TransactionBandwidthByte
+	require.Greater(t, bandwidth, int64(1))
+	expected := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(bandwidth))
+	assert.Equal(t, expected.Quo(expected, big.NewInt(1000)).Int64(), estimate.NodeFee.AsTinybar())
+
+	_, err = NewFeeEstimator(schedule(FeeComponents{TransactionBandwidthByte: math.MaxInt64}), NewExchangeRate(1000, 1)).
+		EstimateTransaction(transfer)
+	assert.ErrorIs(t, err, errFeeEstimatorOverflow)
+
+	// The exchange rate pushes a fee that fits in tinycents past an int64 of tinybars
+	_, err = NewFeeEstimator(schedule(FeeComponents{Constant: math.MaxInt64}), NewExchangeRate(math.MaxInt32, 1)).
+		EstimateTransaction(transfer)
+	assert.ErrorIs(t, err, errFeeEstimatorOverflow)
+}
+
+func TestUnitFeeDataType(t *testing.T) {
+	feeData := FeeData{
+		NodeData:    &FeeComponents{Constant: 1},
+		NetworkData: &FeeComponents{Constant: 2},
+		ServiceData: &FeeComponents{Constant: 3},
+		FeeDataType: FeeDataTypeTokenNonFungibleUnique,
+	}
+
+	fromBytes, err := FeeDataFromBytes(feeData.ToBytes())
+	require.NoError(t, err)
+	assert.Equal(t, feeData, fromBytes)
+	assert.Equal(t, "TOKEN_NON_FUNGIBLE_UNIQUE", fromBytes.FeeDataType.String())
+}
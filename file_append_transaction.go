@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -117,7 +118,7 @@ func (transaction *FileAppendTransaction) GetContents() []byte {
 }
 
 func (transaction *FileAppendTransaction) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -209,7 +210,7 @@ func (transaction *FileAppendTransaction) SignWithOperator(
 
 	if client == nil {
 		return nil, errNoClientProvided
-	} else if client.operator == nil {
+	} else if client._GetOperator() == nil {
 		return nil, errClientOperatorSigning
 	}
 
@@ -219,7 +220,7 @@ func (transaction *FileAppendTransaction) SignWithOperator(
 			return transaction, err
 		}
 	}
-	return transaction.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return transaction.SignWithSigner(client._GetOperator().signer), nil
 }
 
 // SignWith executes the TransactionSigner and adds the resulting signature data to the Transaction's signature map
@@ -235,9 +236,30 @@ func (transaction *FileAppendTransaction) SignWith(
 	return transaction
 }
 
+// SignWithSigner uses the Signer to sign the transaction and adds the resulting signature data to the
+// Transaction's signature map with the Signer's public key as the map key.
+func (transaction *FileAppendTransaction) SignWithSigner(
+	signer Signer,
+) *FileAppendTransaction {
+	if !transaction._KeyAlreadySigned(signer.GetPublicKey()) {
+		transaction._SignWithSigner(signer)
+	}
+
+	return transaction
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *FileAppendTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (transaction *FileAppendTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -247,7 +269,7 @@ func (transaction *FileAppendTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
-	list, err := transaction.ExecuteAll(client)
+	list, err := transaction.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		if len(list) > 0 {
@@ -270,10 +292,23 @@ func (transaction *FileAppendTransaction) Execute(
 func (transaction *FileAppendTransaction) ExecuteAll(
 	client *Client,
 ) ([]TransactionResponse, error) {
-	if client == nil || client.operator == nil {
+	return transaction.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes all the Transactions with the provided client. The context bounds
+// every attempt, the backoff waits between attempts and node selection.
+func (transaction *FileAppendTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
+) ([]TransactionResponse, error) {
+	if client == nil || client._GetOperator() == nil {
 		return []TransactionResponse{}, errNoClientProvided
 	}
 
+	if err := transaction._SelectNodesForExecute(ctx, client); err != nil {
+		return []TransactionResponse{}, err
+	}
+
 	if !transaction.IsFrozen() {
 		_, err := transaction.FreezeWith(client)
 		if err != nil {
@@ -289,10 +324,7 @@ func (transaction *FileAppendTransaction) ExecuteAll(
 	}
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		transaction.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		transaction.SignWithSigner(client._GetOperator().signer)
 	}
 
 	size := transaction.signedTransactions._Length() / transaction.nodeAccountIDs._Length()
@@ -300,6 +332,7 @@ func (transaction *FileAppendTransaction) ExecuteAll(
 
 	for i := 0; i < size; i++ {
 		resp, err := _Execute(
+			ctx,
 			client,
 			&transaction.Transaction,
 			_TransactionShouldRetry,
@@ -325,7 +358,7 @@ func (transaction *FileAppendTransaction) ExecuteAll(
 		_, err = NewTransactionReceiptQuery().
 			SetNodeAccountIDs([]AccountID{resp.(TransactionResponse).NodeID}).
 			SetTransactionID(resp.(TransactionResponse).TransactionID).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return list, err
 		}
@@ -68,7 +68,7 @@ func FileIDFromString(data string) (FileID, error) {
 }
 
 func (id *FileID) ValidateChecksum(client *Client) error {
-	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
+	if !id._IsZero() && client != nil && client.network._ManagedNetwork._GetLedgerID() != nil {
 		var tempChecksum _ParseAddressResult
 		var err error
 		tempChecksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.File))
@@ -83,7 +83,7 @@ func (id *FileID) ValidateChecksum(client *Client) error {
 			return errChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
-			temp, _ := client.network.ledgerID.ToNetworkName()
+			temp, _ := client.network._ManagedNetwork._GetLedgerID().ToNetworkName()
 			return errors.New(fmt.Sprintf("network mismatch or wrong checksum given, given checksum: %s, correct checksum %s, network: %s",
 				*id.checksum,
 				tempChecksum.correctChecksum,
@@ -109,7 +109,7 @@ func (id *FileID) Validate(client *Client) error {
 			return errChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
-			temp, _ := client.network.ledgerID.ToNetworkName()
+			temp, _ := client.network._ManagedNetwork._GetLedgerID().ToNetworkName()
 			return errors.New(fmt.Sprintf("network mismatch or wrong checksum given, given checksum: %s, correct checksum %s, network: %s",
 				*id.checksum,
 				tempChecksum.correctChecksum,
@@ -147,7 +147,7 @@ func (id FileID) ToStringWithChecksum(client Client) (string, error) {
 	}
 	var checksum _ParseAddressResult
 	var err error
-	if client.network.ledgerID != nil {
+	if client.network._ManagedNetwork._GetLedgerID() != nil {
 		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.File))
 	}
 	if err != nil {
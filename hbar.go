@@ -21,10 +21,13 @@ package hedera
  */
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -43,7 +46,13 @@ var MinHbar = Hbar{math.MinInt64}
 // ZeroHbar wraps a 0 value of Hbar.
 var ZeroHbar = Hbar{0}
 
-// HbarFrom creates a representation of Hbar in tinybar on the unit provided
+// _WeibarPerTinybar is the number of weibar, the EVM denomination of hbar, in a tinybar
+var _WeibarPerTinybar = big.NewInt(10_000_000_000)
+
+var _HbarDecimalRegex = regexp.MustCompile(`^([+-])?(\d+)(?:\.(\d+))?$`)
+
+// HbarFrom creates a representation of Hbar in tinybar on the unit provided.
+// The amount is converted through float64, use HbarFromDecimalString for exact amounts.
 func HbarFrom(bars float64, unit HbarUnit) Hbar {
 	return HbarFromTinybar(int64(bars * float64(unit._NumberOfTinybar())))
 }
@@ -121,3 +130,145 @@ func (hbar Hbar) Negated() Hbar {
 		tinybar: -hbar.tinybar,
 	}
 }
+
+// AsWeibar returns the equivalent amount of weibar, the denomination of hbar on the EVM. 1 tinybar is 10^10 weibar.
+func (hbar Hbar) AsWeibar() *big.Int {
+	return new(big.Int).Mul(big.NewInt(hbar.tinybar), _WeibarPerTinybar)
+}
+
+// HbarFromWeibar creates a representation of Hbar from an amount of weibar, which must be a whole number of tinybar
+func HbarFromWeibar(weibar *big.Int) (Hbar, error) {
+	tinybar, remainder := new(big.Int).QuoRem(weibar, _WeibarPerTinybar, new(big.Int))
+	if remainder.Sign() != 0 {
+		return Hbar{}, errHbarTooPrecise
+	}
+
+	return _HbarFromBigTinybar(tinybar, "conversion from weibar")
+}
+
+// HbarFromDecimalString parses a decimal amount of the unit provided, such as "1.5" hbar, without rounding.
+// It returns an error if the amount is not a whole number of tinybar or does not fit in an Hbar.
+func HbarFromDecimalString(amount string, unit HbarUnit) (Hbar, error) {
+	matchArray := _HbarDecimalRegex.FindStringSubmatch(amount)
+	if len(matchArray) == 0 {
+		return Hbar{}, errHbarInvalidDecimal
+	}
+
+	value, _ := new(big.Int).SetString(matchArray[2]+matchArray[3], 10)
+	value.Mul(value, big.NewInt(unit._NumberOfTinybar()))
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(matchArray[3]))), nil)
+	tinybar, remainder := value.QuoRem(value, scale, new(big.Int))
+	if remainder.Sign() != 0 {
+		return Hbar{}, errHbarTooPrecise
+	}
+
+	if matchArray[1] == "-" {
+		tinybar.Neg(tinybar)
+	}
+
+	return _HbarFromBigTinybar(tinybar, "parsing "+strconv.Quote(amount))
+}
+
+// ToDecimalString formats the amount as a decimal number of the unit provided, without rounding or a symbol
+func (hbar Hbar) ToDecimalString(unit HbarUnit) string {
+	return _DescribeAmount(hbar.tinybar, unit._Decimals())
+}
+
+// Add returns the sum of both amounts, or ErrHbarOverflow if it does not fit in an Hbar
+func (hbar Hbar) Add(other Hbar) (Hbar, error) {
+	sum := hbar.tinybar + other.tinybar
+	if (other.tinybar > 0 && sum < hbar.tinybar) || (other.tinybar < 0 && sum > hbar.tinybar) {
+		return Hbar{}, ErrHbarOverflow{Operation: "addition"}
+	}
+
+	return Hbar{sum}, nil
+}
+
+// Sub returns the difference of both amounts, or ErrHbarOverflow if it does not fit in an Hbar
+func (hbar Hbar) Sub(other Hbar) (Hbar, error) {
+	difference := hbar.tinybar - other.tinybar
+	if (other.tinybar > 0 && difference > hbar.tinybar) || (other.tinybar < 0 && difference < hbar.tinybar) {
+		return Hbar{}, ErrHbarOverflow{Operation: "subtraction"}
+	}
+
+	return Hbar{difference}, nil
+}
+
+// Mul returns the amount multiplied by factor, or ErrHbarOverflow if it does not fit in an Hbar
+func (hbar Hbar) Mul(factor int64) (Hbar, error) {
+	product := new(big.Int).Mul(big.NewInt(hbar.tinybar), big.NewInt(factor))
+	return _HbarFromBigTinybar(product, "multiplication")
+}
+
+// Cmp compares both amounts and returns -1, 0 or +1 if hbar is less than, equal to or greater than other
+func (hbar Hbar) Cmp(other Hbar) int {
+	switch {
+	case hbar.tinybar < other.tinybar:
+		return -1
+	case hbar.tinybar > other.tinybar:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MarshalText encodes the amount as a decimal number of hbar, such as "1.5"
+func (hbar Hbar) MarshalText() ([]byte, error) {
+	return []byte(hbar.ToDecimalString(HbarUnits.Hbar)), nil
+}
+
+// UnmarshalText decodes a decimal number of hbar, optionally followed by the symbol of another unit such as "5 tℏ"
+func (hbar *Hbar) UnmarshalText(text []byte) error {
+	amount := string(text)
+	unit := HbarUnits.Hbar
+	if index := strings.LastIndex(amount, " "); index >= 0 {
+		symbol := amount[index+1:]
+		unit = _HbarUnitFromString(symbol)
+		if unit.Symbol() != symbol {
+			return errHbarInvalidDecimal
+		}
+
+		amount = amount[:index]
+	}
+
+	value, err := HbarFromDecimalString(amount, unit)
+	if err != nil {
+		return err
+	}
+
+	*hbar = value
+	return nil
+}
+
+// MarshalJSON encodes the amount as a JSON string holding a decimal number of hbar, which cannot lose precision.
+// A struct embedding Hbar promotes this method and is encoded as the amount alone; use a named field instead.
+func (hbar Hbar) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hbar.ToDecimalString(HbarUnits.Hbar))
+}
+
+// UnmarshalJSON decodes a JSON string or number holding a decimal number of hbar
+func (hbar *Hbar) UnmarshalJSON(data []byte) error {
+	var text string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	} else {
+		var number json.Number
+		if err := json.Unmarshal(data, &number); err != nil {
+			return err
+		}
+		text = number.String()
+	}
+
+	return hbar.UnmarshalText([]byte(text))
+}
+
+func _HbarFromBigTinybar(tinybar *big.Int, operation string) (Hbar, error) {
+	if !tinybar.IsInt64() {
+		return Hbar{}, ErrHbarOverflow{Operation: operation}
+	}
+
+	return Hbar{tinybar.Int64()}, nil
+}
@@ -20,6 +20,8 @@ package hedera
  *
  */
 
+import "strconv"
+
 type HbarUnit string
 
 var HbarUnits = struct {
@@ -85,3 +87,8 @@ func (unit HbarUnit) _NumberOfTinybar() int64 {
 
 	panic("unreachable: HbarUnit.Symbol() switch statement is non-exhaustive")
 }
+
+// _Decimals returns the number of decimal places of a tinybar amount in the unit
+func (unit HbarUnit) _Decimals() uint32 {
+	return uint32(len(strconv.FormatInt(unit._NumberOfTinybar(), 10)) - 1)
+}
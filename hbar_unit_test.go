@@ -24,6 +24,8 @@ package hedera
  */
 
 import (
+	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,3 +96,132 @@ func TestUnitHbarUnit(t *testing.T) {
 	hbar2, err = HbarFromString("1.151.")
 	assert.Error(t, err)
 }
+
+func TestUnitHbarWeibar(t *testing.T) {
+	weibar, _ := new(big.Int).SetString("150000000000000000", 10)
+	require.Equal(t, weibar, HbarFromTinybar(15_000_000).AsWeibar())
+
+	hbar, err := HbarFromWeibar(weibar)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15_000_000), hbar.AsTinybar())
+
+	maxWeibar := MaxHbar.AsWeibar()
+	hbar, err = HbarFromWeibar(maxWeibar)
+	require.NoError(t, err)
+	assert.Equal(t, MaxHbar, hbar)
+
+	_, err = HbarFromWeibar(new(big.Int).Add(maxWeibar, _WeibarPerTinybar))
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+
+	_, err = HbarFromWeibar(big.NewInt(1))
+	require.ErrorIs(t, err, errHbarTooPrecise)
+}
+
+func TestUnitHbarArithmetic(t *testing.T) {
+	sum, err := HbarFromTinybar(5).Add(HbarFromTinybar(-7))
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), sum.AsTinybar())
+
+	_, err = MaxHbar.Add(HbarFromTinybar(1))
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+	_, err = MinHbar.Add(HbarFromTinybar(-1))
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+
+	difference, err := HbarFromTinybar(5).Sub(HbarFromTinybar(7))
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), difference.AsTinybar())
+
+	_, err = MinHbar.Sub(HbarFromTinybar(1))
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+	_, err = ZeroHbar.Sub(MinHbar)
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+
+	product, err := NewHbar(3).Mul(-4)
+	require.NoError(t, err)
+	assert.Equal(t, NewHbar(-12), product)
+
+	_, err = MaxHbar.Mul(2)
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+	_, err = MinHbar.Mul(-1)
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+
+	assert.Equal(t, -1, NewHbar(1).Cmp(NewHbar(2)))
+	assert.Equal(t, 0, NewHbar(2).Cmp(HbarFromTinybar(200_000_000)))
+	assert.Equal(t, 1, ZeroHbar.Cmp(MinHbar))
+}
+
+func TestUnitHbarDecimalString(t *testing.T) {
+	for _, test := range []struct {
+		amount  string
+		unit    HbarUnit
+		tinybar int64
+	}{
+		{"1.5", HbarUnits.Hbar, 150_000_000},
+		{"-0.00000001", HbarUnits.Hbar, -1},
+		{"+42", HbarUnits.Tinybar, 42},
+		{"0.10", HbarUnits.Hbar, 10_000_000},
+		{"92233720368.54775807", HbarUnits.Hbar, MaxHbar.AsTinybar()},
+		{"-92233720368.54775808", HbarUnits.Hbar, MinHbar.AsTinybar()},
+		{"1.23456789", HbarUnits.Kilobar, 123_456_789_000},
+	} {
+		hbar, err := HbarFromDecimalString(test.amount, test.unit)
+		require.NoError(t, err, test.amount)
+		assert.Equal(t, test.tinybar, hbar.AsTinybar(), test.amount)
+	}
+
+	assert.Equal(t, "1.5", HbarFromTinybar(150_000_000).ToDecimalString(HbarUnits.Hbar))
+	assert.Equal(t, "-0.00000001", HbarFromTinybar(-1).ToDecimalString(HbarUnits.Hbar))
+	assert.Equal(t, "-92233720368.54775808", MinHbar.ToDecimalString(HbarUnits.Hbar))
+	assert.Equal(t, "1500", HbarFromTinybar(150_000_000).ToDecimalString(HbarUnits.Millibar))
+	assert.Equal(t, "0", ZeroHbar.ToDecimalString(HbarUnits.Gigabar))
+
+	_, err := HbarFromDecimalString("0.000000001", HbarUnits.Hbar)
+	require.ErrorIs(t, err, errHbarTooPrecise)
+	_, err = HbarFromDecimalString("0.5", HbarUnits.Tinybar)
+	require.ErrorIs(t, err, errHbarTooPrecise)
+	_, err = HbarFromDecimalString("92233720368.54775808", HbarUnits.Hbar)
+	require.ErrorAs(t, err, &ErrHbarOverflow{})
+	_, err = HbarFromDecimalString("1e8", HbarUnits.Hbar)
+	require.ErrorIs(t, err, errHbarInvalidDecimal)
+	_, err = HbarFromDecimalString("1.", HbarUnits.Hbar)
+	require.ErrorIs(t, err, errHbarInvalidDecimal)
+}
+
+func TestUnitHbarJson(t *testing.T) {
+	type payment struct {
+		Amount Hbar `json:"amount"`
+	}
+
+	encoded, err := json.Marshal(payment{Amount: HbarFromTinybar(-123_456_789)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"-1.23456789"}`, string(encoded))
+
+	var decoded payment
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, int64(-123_456_789), decoded.Amount.AsTinybar())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":0.1}`), &decoded))
+	assert.Equal(t, int64(10_000_000), decoded.Amount.AsTinybar())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"5 tℏ"}`), &decoded))
+	assert.Equal(t, int64(5), decoded.Amount.AsTinybar())
+
+	require.Error(t, json.Unmarshal([]byte(`{"amount":"5 xℏ"}`), &decoded))
+	require.Error(t, json.Unmarshal([]byte(`{"amount":true}`), &decoded))
+
+	// An embedded Hbar encodes the whole struct
+	type embedded struct {
+		Hbar
+		Memo string `json:"memo"`
+	}
+	encoded, err = json.Marshal(embedded{Hbar: NewHbar(1), Memo: "lost"})
+	require.NoError(t, err)
+	assert.Equal(t, `"1"`, string(encoded))
+
+	text, err := MaxHbar.MarshalText()
+	require.NoError(t, err)
+
+	var hbar Hbar
+	require.NoError(t, hbar.UnmarshalText(text))
+	assert.Equal(t, MaxHbar, hbar)
+}
@@ -0,0 +1,222 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/json"
+	"net"
+	"sync"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// EmulatorOperatorAccountID is the account the Emulator's client pays with. It
+// starts with the whole supply of 50,000,000,000 hbar.
+var EmulatorOperatorAccountID = hedera.AccountID{Account: 2}
+
+// Emulator is a stateful in-process Hedera network. Unlike Network, which
+// answers from scripts, it applies every transaction it receives to a single
+// ledger shared by all of its nodes, and serves the results through receipts,
+// records, queries and its mirror node.
+//
+// The emulator supports these transactions:
+//   - CryptoCreate, CryptoDelete and CryptoTransfer, including token and NFT transfers
+//   - TokenCreate, TokenDelete, TokenAssociate, TokenDissociate and TokenMint
+//   - ConsensusCreateTopic, ConsensusDeleteTopic and ConsensusSubmitMessage, including chunks
+//   - FileCreate, FileAppend and FileDelete
+//
+// and these queries, besides receipts and records:
+//   - CryptoGetAccountBalance and CryptoGetInfo
+//   - TokenGetInfo and TokenGetNftInfo
+//   - ConsensusGetTopicInfo
+//   - FileGetContents and FileGetInfo
+//
+// Other requests are answered with NOT_SUPPORTED. Signatures are verified and
+// the keys of payers, senders, treasuries and entities are enforced. No fees
+// are charged and queries cost nothing.
+//
+// Topic subscriptions through the mirror node stream the messages of the
+// topic which were already submitted and stay open for new ones.
+type Emulator struct {
+	mu          sync.Mutex
+	ledger      *_Ledger
+	nodes       []*_EmulatorNode
+	mirror      *Mirror
+	client      *hedera.Client
+	operatorKey hedera.PrivateKey
+	requests    []Request
+}
+
+type _EmulatorNode struct {
+	accountID hedera.AccountID
+	listener  net.Listener
+	server    *grpc.Server
+}
+
+// NewEmulator starts an emulated network of nodeCount consensus nodes with
+// account IDs 0.0.3, 0.0.4, ... and a mirror node, all listening on loopback.
+func NewEmulator(nodeCount int) (*Emulator, error) {
+	if nodeCount < 1 {
+		return nil, errors.New("hederatest: an emulator needs at least one node")
+	}
+
+	operatorKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		return nil, err
+	}
+
+	operatorPublicKey := _PublicKeyToProtobuf(operatorKey.PublicKey())
+
+	emulator := &Emulator{
+		ledger:      _NewLedger(),
+		operatorKey: operatorKey,
+	}
+	emulator.ledger._AddAccount(int64(EmulatorOperatorAccountID.Account), operatorPublicKey, _LedgerTotalSupply)
+
+	emulator.mirror, err = _NewMirror(true)
+	if err != nil {
+		emulator.Close()
+		return nil, err
+	}
+
+	addresses := make(map[string]hedera.AccountID, nodeCount)
+	addressBook := services.NodeAddressBook{}
+	for i := 0; i < nodeCount; i++ {
+		accountID := hedera.AccountID{Account: uint64(3 + i)}
+		emulator.ledger._AddAccount(int64(accountID.Account), operatorPublicKey, 0)
+
+		node := &_EmulatorNode{
+			accountID: accountID,
+			server:    grpc.NewServer(),
+		}
+		emulator.nodes = append(emulator.nodes, node)
+
+		if err := _RegisterNodeServices(node.server, accountID, emulator._Handle); err != nil {
+			emulator.Close()
+			return nil, err
+		}
+
+		if node.listener, err = _Serve(node.server); err != nil {
+			emulator.Close()
+			return nil, err
+		}
+
+		// The book names the loopback endpoints, so clients which update their network from the
+		// mirror node keep talking to the emulator.
+		addresses[node.listener.Addr().String()] = accountID
+		addressBook.NodeAddress = append(addressBook.NodeAddress, &services.NodeAddress{
+			NodeAccountId: _AccountIDToProtobuf(int64(accountID.Account)),
+			NodeId:        int64(i),
+			ServiceEndpoint: []*services.ServiceEndpoint{{
+				IpAddressV4: []byte{127, 0, 0, 1},
+				Port:        int32(node.listener.Addr().(*net.TCPAddr).Port),
+			}},
+		})
+	}
+
+	addressBookBytes, err := protobuf.Marshal(&addressBook)
+	if err != nil {
+		emulator.Close()
+		return nil, err
+	}
+	book, err := hedera.NodeAddressBookFromBytes(addressBookBytes)
+	if err != nil {
+		emulator.Close()
+		return nil, err
+	}
+
+	emulator.mirror.SetAddressBook(book)
+	emulator.client = _NewClient(addresses, emulator.mirror.Address(), EmulatorOperatorAccountID, operatorKey)
+
+	return emulator, nil
+}
+
+// Client returns the client connected to the emulator, with
+// EmulatorOperatorAccountID as operator. It is closed by Close.
+func (emulator *Emulator) Client() *hedera.Client {
+	return emulator.client
+}
+
+// ClientConfig returns a configuration for hedera.ClientFromConfig which connects
+// to the emulator with EmulatorOperatorAccountID as operator, for code which
+// builds its own client from a configuration file, such as the SDK's
+// integration tests.
+func (emulator *Emulator) ClientConfig() ([]byte, error) {
+	network := make(map[string]string, len(emulator.nodes))
+	for _, node := range emulator.nodes {
+		network[node.listener.Addr().String()] = node.accountID.String()
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"network":       network,
+		"mirrorNetwork": []string{emulator.mirror.Address()},
+		"operator": map[string]string{
+			"accountId":  EmulatorOperatorAccountID.String(),
+			"privateKey": emulator.operatorKey.String(),
+		},
+	}, "", "    ")
+}
+
+// OperatorKey returns the private key of EmulatorOperatorAccountID.
+func (emulator *Emulator) OperatorKey() hedera.PrivateKey {
+	return emulator.operatorKey
+}
+
+// Requests returns every request received by any node, in the order they
+// arrived.
+func (emulator *Emulator) Requests() []Request {
+	emulator.mu.Lock()
+	defer emulator.mu.Unlock()
+
+	return append([]Request{}, emulator.requests...)
+}
+
+// Close closes the client and stops every server.
+func (emulator *Emulator) Close() {
+	if emulator.client != nil {
+		_ = emulator.client.Close()
+	}
+
+	for _, node := range emulator.nodes {
+		node.server.Stop()
+	}
+
+	if emulator.mirror != nil {
+		emulator.mirror._Close()
+	}
+}
+
+func (emulator *Emulator) _Handle(request Request) (interface{}, error) {
+	emulator.mu.Lock()
+	defer emulator.mu.Unlock()
+
+	emulator.requests = append(emulator.requests, request)
+
+	if request.Transaction != nil {
+		return emulator._Submit(request), nil
+	}
+
+	return emulator._Query(request)
+}
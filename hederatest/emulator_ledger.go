@@ -0,0 +1,279 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+)
+
+// _LedgerTotalSupply is the total supply of hbar in tinybars.
+const _LedgerTotalSupply = int64(50_000_000_000 * 100_000_000)
+
+// _LedgerFirstEntity is the number of the first entity created on the ledger.
+const _LedgerFirstEntity = int64(1001)
+
+// _LedgerDefaultAutoRenewPeriod is the auto renew period of entities which do
+// not set one, 90 days.
+const _LedgerDefaultAutoRenewPeriod = int64(7776000)
+
+// _Ledger is the state of an Emulator. Every entity lives in shard 0, realm 0
+// and shares one sequence of entity numbers.
+type _Ledger struct {
+	nextEntity    int64
+	consensusTime time.Time
+	accounts      map[int64]*_LedgerAccount
+	tokens        map[int64]*_LedgerToken
+	topics        map[int64]*_LedgerTopic
+	files         map[int64]*_LedgerFile
+	records       map[string]*services.TransactionRecord
+}
+
+type _LedgerAccount struct {
+	key                           *services.Key
+	balance                       int64
+	memo                          string
+	receiverSigRequired           bool
+	autoRenewPeriod               int64
+	expiry                        time.Time
+	maxAutomaticTokenAssociations int32
+	// tokens holds the balance of every associated token, the number of
+	// serials owned for NFTs.
+	tokens map[int64]int64
+}
+
+type _LedgerToken struct {
+	name        string
+	symbol      string
+	memo        string
+	decimals    uint32
+	tokenType   services.TokenType
+	supplyType  services.TokenSupplyType
+	totalSupply int64
+	maxSupply   int64
+	treasury    int64
+	adminKey    *services.Key
+	kycKey      *services.Key
+	freezeKey   *services.Key
+	wipeKey     *services.Key
+	supplyKey   *services.Key
+	feeKey      *services.Key
+	pauseKey    *services.Key
+	expiry      time.Time
+	nfts        map[int64]*_LedgerNft
+	lastSerial  int64
+	deleted     bool
+}
+
+type _LedgerNft struct {
+	owner    int64
+	metadata []byte
+	created  time.Time
+}
+
+type _LedgerTopic struct {
+	memo             string
+	adminKey         *services.Key
+	submitKey        *services.Key
+	autoRenewPeriod  int64
+	autoRenewAccount *services.AccountID
+	expiry           time.Time
+	sequenceNumber   uint64
+	runningHash      []byte
+}
+
+type _LedgerFile struct {
+	keys     *services.KeyList
+	contents []byte
+	memo     string
+	expiry   time.Time
+	deleted  bool
+}
+
+func _NewLedger() *_Ledger {
+	return &_Ledger{
+		nextEntity: _LedgerFirstEntity,
+		accounts:   make(map[int64]*_LedgerAccount),
+		tokens:     make(map[int64]*_LedgerToken),
+		topics:     make(map[int64]*_LedgerTopic),
+		files:      make(map[int64]*_LedgerFile),
+		records:    make(map[string]*services.TransactionRecord),
+	}
+}
+
+func (ledger *_Ledger) _AddAccount(num int64, key *services.Key, balance int64) *_LedgerAccount {
+	account := &_LedgerAccount{
+		key:             key,
+		balance:         balance,
+		autoRenewPeriod: _LedgerDefaultAutoRenewPeriod,
+		expiry:          time.Now().Add(time.Duration(_LedgerDefaultAutoRenewPeriod) * time.Second),
+		tokens:          make(map[int64]int64),
+	}
+	ledger.accounts[num] = account
+
+	return account
+}
+
+func (ledger *_Ledger) _NextEntity() int64 {
+	num := ledger.nextEntity
+	ledger.nextEntity++
+
+	return num
+}
+
+// _NextConsensusTime returns the wall clock time, moved forward if needed so
+// that consensus timestamps strictly increase.
+func (ledger *_Ledger) _NextConsensusTime() time.Time {
+	now := time.Now()
+	if !now.After(ledger.consensusTime) {
+		now = ledger.consensusTime.Add(time.Nanosecond)
+	}
+	ledger.consensusTime = now
+
+	return now
+}
+
+func (ledger *_Ledger) _Account(id *services.AccountID) (int64, *_LedgerAccount, bool) {
+	if id == nil || id.ShardNum != 0 || id.RealmNum != 0 {
+		return 0, nil, false
+	}
+
+	account, ok := ledger.accounts[id.GetAccountNum()]
+	return id.GetAccountNum(), account, ok
+}
+
+func (ledger *_Ledger) _Token(id *services.TokenID) (int64, *_LedgerToken, bool) {
+	if id == nil || id.ShardNum != 0 || id.RealmNum != 0 {
+		return 0, nil, false
+	}
+
+	token, ok := ledger.tokens[id.TokenNum]
+	return id.TokenNum, token, ok
+}
+
+func (ledger *_Ledger) _Topic(id *services.TopicID) (int64, *_LedgerTopic, bool) {
+	if id == nil || id.ShardNum != 0 || id.RealmNum != 0 {
+		return 0, nil, false
+	}
+
+	topic, ok := ledger.topics[id.TopicNum]
+	return id.TopicNum, topic, ok
+}
+
+func (ledger *_Ledger) _File(id *services.FileID) (int64, *_LedgerFile, bool) {
+	if id == nil || id.ShardNum != 0 || id.RealmNum != 0 {
+		return 0, nil, false
+	}
+
+	file, ok := ledger.files[id.FileNum]
+	return id.FileNum, file, ok
+}
+
+func _AccountIDToProtobuf(num int64) *services.AccountID {
+	return &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: num}}
+}
+
+func _TransactionIDKey(transactionID *services.TransactionID) string {
+	return fmt.Sprintf("%d.%d.%d@%d.%d/%t/%d",
+		transactionID.GetAccountID().GetShardNum(),
+		transactionID.GetAccountID().GetRealmNum(),
+		transactionID.GetAccountID().GetAccountNum(),
+		transactionID.GetTransactionValidStart().GetSeconds(),
+		transactionID.GetTransactionValidStart().GetNanos(),
+		transactionID.GetScheduled(),
+		transactionID.GetNonce(),
+	)
+}
+
+func _TimestampToProtobuf(t time.Time) *services.Timestamp {
+	return &services.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+}
+
+func _PublicKeyToProtobuf(publicKey hedera.PublicKey) *services.Key {
+	if raw := publicKey.BytesRaw(); len(raw) == ed25519.PublicKeySize {
+		return &services.Key{Key: &services.Key_Ed25519{Ed25519: raw}}
+	}
+
+	return &services.Key{Key: &services.Key_ECDSASecp256K1{ECDSASecp256K1: publicKey.BytesRaw()}}
+}
+
+// _Signers returns the public keys with a valid signature over the body of
+// signedTransaction, as keys of _KeySatisfied.
+func _Signers(signedTransaction *services.SignedTransaction) map[string]bool {
+	signers := make(map[string]bool)
+	for _, sigPair := range signedTransaction.GetSigMap().GetSigPair() {
+		switch signature := sigPair.Signature.(type) {
+		case *services.SignaturePair_Ed25519:
+			if len(sigPair.PubKeyPrefix) == ed25519.PublicKeySize &&
+				ed25519.Verify(sigPair.PubKeyPrefix, signedTransaction.BodyBytes, signature.Ed25519) {
+				signers["ed25519:"+string(sigPair.PubKeyPrefix)] = true
+			}
+		case *services.SignaturePair_ECDSASecp256K1:
+			if len(signature.ECDSASecp256K1) == 64 &&
+				crypto.VerifySignature(sigPair.PubKeyPrefix, crypto.Keccak256(signedTransaction.BodyBytes), signature.ECDSASecp256K1) {
+				signers["ecdsa:"+string(sigPair.PubKeyPrefix)] = true
+			}
+		}
+	}
+
+	return signers
+}
+
+// _KeySatisfied returns true if the signers satisfy key. A missing key
+// requires no signature; contract and unsupported keys are never satisfied.
+func _KeySatisfied(key *services.Key, signers map[string]bool) bool {
+	switch key := key.GetKey().(type) {
+	case nil:
+		return true
+	case *services.Key_Ed25519:
+		return signers["ed25519:"+string(key.Ed25519)]
+	case *services.Key_ECDSASecp256K1:
+		return signers["ecdsa:"+string(key.ECDSASecp256K1)]
+	case *services.Key_KeyList:
+		return _KeyListSatisfied(key.KeyList, signers)
+	case *services.Key_ThresholdKey:
+		satisfied := uint32(0)
+		for _, child := range key.ThresholdKey.GetKeys().GetKeys() {
+			if _KeySatisfied(child, signers) {
+				satisfied++
+			}
+		}
+
+		return satisfied >= key.ThresholdKey.Threshold
+	default:
+		return false
+	}
+}
+
+func _KeyListSatisfied(keys *services.KeyList, signers map[string]bool) bool {
+	for _, child := range keys.GetKeys() {
+		if !_KeySatisfied(child, signers) {
+			return false
+		}
+	}
+
+	return true
+}
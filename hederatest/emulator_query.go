@@ -0,0 +1,205 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+)
+
+// _LedgerID is the ledger ID reported in the emulator's entity infos.
+var _LedgerID = []byte{0}
+
+func (emulator *Emulator) _Query(request Request) (interface{}, error) {
+	response, message, err := _QueryResponse(request, hedera.StatusOk, 0)
+	if err != nil || request.IsCostQuery() {
+		return response, err
+	}
+
+	if precheck := emulator.ledger._Answer(request.Query, message.Interface()); precheck != services.ResponseCodeEnum_OK {
+		response, _, err = _QueryResponse(request, hedera.Status(precheck), 0)
+	}
+
+	return response, err
+}
+
+// _Answer fills answer, the typed response to query, and returns its
+// precheck code.
+func (ledger *_Ledger) _Answer(query *services.Query, answer interface{}) services.ResponseCodeEnum {
+	switch answer := answer.(type) {
+	case *services.CryptoGetAccountBalanceResponse:
+		num, account, ok := ledger._Account(query.GetCryptogetAccountBalance().GetAccountID())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+		}
+
+		answer.AccountID = _AccountIDToProtobuf(num)
+		answer.Balance = uint64(account.balance)
+		for _, tokenNum := range _SortedNums(account.tokens) {
+			answer.TokenBalances = append(answer.TokenBalances, &services.TokenBalance{
+				TokenId:  &services.TokenID{TokenNum: tokenNum},
+				Balance:  uint64(account.tokens[tokenNum]),
+				Decimals: ledger.tokens[tokenNum].decimals,
+			})
+		}
+	case *services.CryptoGetInfoResponse:
+		num, account, ok := ledger._Account(query.GetCryptoGetInfo().GetAccountID())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+		}
+
+		answer.AccountInfo = &services.CryptoGetInfoResponse_AccountInfo{
+			AccountID:                     _AccountIDToProtobuf(num),
+			Key:                           account.key,
+			Balance:                       uint64(account.balance),
+			ReceiverSigRequired:           account.receiverSigRequired,
+			ExpirationTime:                _TimestampToProtobuf(account.expiry),
+			AutoRenewPeriod:               &services.Duration{Seconds: account.autoRenewPeriod},
+			Memo:                          account.memo,
+			MaxAutomaticTokenAssociations: account.maxAutomaticTokenAssociations,
+			LedgerId:                      _LedgerID,
+		}
+		for _, tokenNum := range _SortedNums(account.tokens) {
+			token := ledger.tokens[tokenNum]
+			answer.AccountInfo.TokenRelationships = append(answer.AccountInfo.TokenRelationships, &services.TokenRelationship{
+				TokenId:  &services.TokenID{TokenNum: tokenNum},
+				Symbol:   token.symbol,
+				Balance:  uint64(account.tokens[tokenNum]),
+				Decimals: token.decimals,
+			})
+			if token.tokenType == services.TokenType_NON_FUNGIBLE_UNIQUE {
+				answer.AccountInfo.OwnedNfts += account.tokens[tokenNum]
+			}
+		}
+	case *services.TransactionGetReceiptResponse:
+		record, ok := ledger.records[_TransactionIDKey(query.GetTransactionGetReceipt().GetTransactionID())]
+		if !ok {
+			return services.ResponseCodeEnum_RECEIPT_NOT_FOUND
+		}
+
+		answer.Receipt = record.Receipt
+	case *services.TransactionGetRecordResponse:
+		record, ok := ledger.records[_TransactionIDKey(query.GetTransactionGetRecord().GetTransactionID())]
+		if !ok {
+			return services.ResponseCodeEnum_RECORD_NOT_FOUND
+		}
+
+		answer.TransactionRecord = record
+	case *services.FileGetContentsResponse:
+		num, file, ok := ledger._File(query.GetFileGetContents().GetFileID())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_FILE_ID
+		}
+
+		if file.deleted {
+			return services.ResponseCodeEnum_FILE_DELETED
+		}
+
+		answer.FileContents = &services.FileGetContentsResponse_FileContents{
+			FileID:   &services.FileID{FileNum: num},
+			Contents: file.contents,
+		}
+	case *services.FileGetInfoResponse:
+		num, file, ok := ledger._File(query.GetFileGetInfo().GetFileID())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_FILE_ID
+		}
+
+		answer.FileInfo = &services.FileGetInfoResponse_FileInfo{
+			FileID:         &services.FileID{FileNum: num},
+			Size:           int64(len(file.contents)),
+			ExpirationTime: _TimestampToProtobuf(file.expiry),
+			Deleted:        file.deleted,
+			Keys:           file.keys,
+			Memo:           file.memo,
+			LedgerId:       _LedgerID,
+		}
+	case *services.TokenGetInfoResponse:
+		num, token, ok := ledger._Token(query.GetTokenGetInfo().GetToken())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+
+		answer.TokenInfo = &services.TokenInfo{
+			TokenId:        &services.TokenID{TokenNum: num},
+			Name:           token.name,
+			Symbol:         token.symbol,
+			Decimals:       token.decimals,
+			TotalSupply:    uint64(token.totalSupply),
+			Treasury:       _AccountIDToProtobuf(token.treasury),
+			AdminKey:       token.adminKey,
+			KycKey:         token.kycKey,
+			FreezeKey:      token.freezeKey,
+			WipeKey:        token.wipeKey,
+			SupplyKey:      token.supplyKey,
+			FeeScheduleKey: token.feeKey,
+			PauseKey:       token.pauseKey,
+			Expiry:         _TimestampToProtobuf(token.expiry),
+			Memo:           token.memo,
+			TokenType:      token.tokenType,
+			SupplyType:     token.supplyType,
+			MaxSupply:      token.maxSupply,
+			Deleted:        token.deleted,
+			LedgerId:       _LedgerID,
+		}
+	case *services.TokenGetNftInfoResponse:
+		nftID := query.GetTokenGetNftInfo().GetNftID()
+		_, token, ok := ledger._Token(nftID.GetTokenID())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_NFT_ID
+		}
+
+		nft, ok := token.nfts[nftID.GetSerialNumber()]
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_NFT_ID
+		}
+
+		answer.Nft = &services.TokenNftInfo{
+			NftID:        nftID,
+			AccountID:    _AccountIDToProtobuf(nft.owner),
+			CreationTime: _TimestampToProtobuf(nft.created),
+			Metadata:     nft.metadata,
+			LedgerId:     _LedgerID,
+		}
+	case *services.ConsensusGetTopicInfoResponse:
+		num, topic, ok := ledger._Topic(query.GetConsensusGetTopicInfo().GetTopicID())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOPIC_ID
+		}
+
+		answer.TopicID = &services.TopicID{TopicNum: num}
+		answer.TopicInfo = &services.ConsensusTopicInfo{
+			Memo:             topic.memo,
+			RunningHash:      topic.runningHash,
+			SequenceNumber:   topic.sequenceNumber,
+			ExpirationTime:   _TimestampToProtobuf(topic.expiry),
+			AdminKey:         topic.adminKey,
+			SubmitKey:        topic.submitKey,
+			AutoRenewPeriod:  &services.Duration{Seconds: topic.autoRenewPeriod},
+			AutoRenewAccount: topic.autoRenewAccount,
+			LedgerId:         _LedgerID,
+		}
+	default:
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+
+	return services.ResponseCodeEnum_OK
+}
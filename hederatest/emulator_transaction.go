@@ -0,0 +1,1007 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	_LedgerMaxMessageSize  = 1024
+	_LedgerMaxFileSize     = 1024 * 1024
+	_LedgerMaxNftMetadata  = 100
+	_LedgerRunningHashSize = 48
+)
+
+// _LedgerTransaction is a transaction being applied to the ledger. Handlers
+// validate it completely before changing any state, so a failed transaction
+// leaves the ledger untouched.
+type _LedgerTransaction struct {
+	body          *services.TransactionBody
+	signers       map[string]bool
+	payer         int64
+	consensusTime time.Time
+	record        *services.TransactionRecord
+	// topicMessage is published to the mirror node once the transaction
+	// succeeded.
+	topicID      hedera.TopicID
+	topicMessage *mirror.ConsensusTopicResponse
+}
+
+func (transaction *_LedgerTransaction) _Signed(key *services.Key) bool {
+	return _KeySatisfied(key, transaction.signers)
+}
+
+// _CheckTransfer checks the signatures needed to move delta into or out of
+// account.
+func (transaction *_LedgerTransaction) _CheckTransfer(account *_LedgerAccount, delta int64) services.ResponseCodeEnum {
+	if delta < 0 && !transaction._Signed(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if delta > 0 && account.receiverSigRequired && !transaction._Signed(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return services.ResponseCodeEnum_OK
+}
+
+func _Precheck(code services.ResponseCodeEnum) *services.TransactionResponse {
+	return &services.TransactionResponse{NodeTransactionPrecheckCode: code}
+}
+
+func (emulator *Emulator) _Submit(request Request) *services.TransactionResponse {
+	ledger := emulator.ledger
+
+	signedTransaction, err := request.SignedTransaction()
+	if err != nil {
+		return _Precheck(services.ResponseCodeEnum_INVALID_TRANSACTION)
+	}
+
+	body := &services.TransactionBody{}
+	if err := protobuf.Unmarshal(signedTransaction.BodyBytes, body); err != nil {
+		return _Precheck(services.ResponseCodeEnum_INVALID_TRANSACTION_BODY)
+	}
+
+	if body.TransactionID.GetAccountID() == nil || body.TransactionID.GetTransactionValidStart() == nil {
+		return _Precheck(services.ResponseCodeEnum_INVALID_TRANSACTION_ID)
+	}
+
+	if body.GetNodeAccountID().GetAccountNum() != int64(request.NodeAccountID.Account) {
+		return _Precheck(services.ResponseCodeEnum_INVALID_NODE_ACCOUNT)
+	}
+
+	apply := ledger._Handler(body)
+	if apply == nil {
+		return _Precheck(services.ResponseCodeEnum_NOT_SUPPORTED)
+	}
+
+	key := _TransactionIDKey(body.TransactionID)
+	if _, ok := ledger.records[key]; ok {
+		return _Precheck(services.ResponseCodeEnum_DUPLICATE_TRANSACTION)
+	}
+
+	payerNum, payer, ok := ledger._Account(body.TransactionID.AccountID)
+	if !ok {
+		return _Precheck(services.ResponseCodeEnum_PAYER_ACCOUNT_NOT_FOUND)
+	}
+
+	signers := _Signers(signedTransaction)
+	if !_KeySatisfied(payer.key, signers) {
+		return _Precheck(services.ResponseCodeEnum_INVALID_SIGNATURE)
+	}
+
+	data := request.Transaction.SignedTransactionBytes
+	if len(data) == 0 {
+		data, _ = protobuf.Marshal(request.Transaction)
+	}
+	hash := sha512.Sum384(data)
+
+	consensusTime := ledger._NextConsensusTime()
+	transaction := &_LedgerTransaction{
+		body:          body,
+		signers:       signers,
+		payer:         payerNum,
+		consensusTime: consensusTime,
+		record: &services.TransactionRecord{
+			Receipt:            &services.TransactionReceipt{},
+			TransactionHash:    hash[:],
+			ConsensusTimestamp: _TimestampToProtobuf(consensusTime),
+			TransactionID:      body.TransactionID,
+			Memo:               body.Memo,
+			TransferList:       &services.TransferList{},
+		},
+	}
+
+	transaction.record.Receipt.Status = apply(transaction)
+	ledger.records[key] = transaction.record
+
+	if transaction.record.Receipt.Status == services.ResponseCodeEnum_SUCCESS && transaction.topicMessage != nil {
+		emulator.mirror.AddTopicMessages(transaction.topicID, transaction.topicMessage)
+	}
+
+	return _Precheck(services.ResponseCodeEnum_OK)
+}
+
+func (ledger *_Ledger) _Handler(body *services.TransactionBody) func(*_LedgerTransaction) services.ResponseCodeEnum {
+	switch body.Data.(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		return ledger._CryptoCreate
+	case *services.TransactionBody_CryptoDelete:
+		return ledger._CryptoDelete
+	case *services.TransactionBody_CryptoTransfer:
+		return ledger._CryptoTransfer
+	case *services.TransactionBody_TokenCreation:
+		return ledger._TokenCreate
+	case *services.TransactionBody_TokenDeletion:
+		return ledger._TokenDelete
+	case *services.TransactionBody_TokenAssociate:
+		return ledger._TokenAssociate
+	case *services.TransactionBody_TokenDissociate:
+		return ledger._TokenDissociate
+	case *services.TransactionBody_TokenMint:
+		return ledger._TokenMint
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return ledger._ConsensusCreateTopic
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return ledger._ConsensusDeleteTopic
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return ledger._ConsensusSubmitMessage
+	case *services.TransactionBody_FileCreate:
+		return ledger._FileCreate
+	case *services.TransactionBody_FileAppend:
+		return ledger._FileAppend
+	case *services.TransactionBody_FileDelete:
+		return ledger._FileDelete
+	default:
+		return nil
+	}
+}
+
+func (ledger *_Ledger) _CryptoCreate(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetCryptoCreateAccount()
+	payer := ledger.accounts[transaction.payer]
+
+	if body.Key == nil {
+		return services.ResponseCodeEnum_KEY_REQUIRED
+	}
+
+	if body.InitialBalance > math.MaxInt64 {
+		return services.ResponseCodeEnum_INVALID_INITIAL_BALANCE
+	}
+
+	initialBalance := int64(body.InitialBalance)
+	if payer.balance < initialBalance {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+
+	if body.ReceiverSigRequired && !transaction._Signed(body.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	autoRenewPeriod := body.GetAutoRenewPeriod().GetSeconds()
+	if autoRenewPeriod == 0 {
+		autoRenewPeriod = _LedgerDefaultAutoRenewPeriod
+	}
+
+	num := ledger._NextEntity()
+	account := ledger._AddAccount(num, body.Key, initialBalance)
+	account.memo = body.Memo
+	account.receiverSigRequired = body.ReceiverSigRequired
+	account.autoRenewPeriod = autoRenewPeriod
+	account.expiry = transaction.consensusTime.Add(time.Duration(autoRenewPeriod) * time.Second)
+	account.maxAutomaticTokenAssociations = body.MaxAutomaticTokenAssociations
+	payer.balance -= initialBalance
+
+	transaction.record.Receipt.AccountID = _AccountIDToProtobuf(num)
+	transaction.record.TransferList.AccountAmounts = _AccountAmounts(map[int64]int64{
+		transaction.payer: -initialBalance,
+		num:               initialBalance,
+	})
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoDelete(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetCryptoDelete()
+
+	num, account, ok := ledger._Account(body.DeleteAccountID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+	}
+
+	transferNum, transferAccount, ok := ledger._Account(body.TransferAccountID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TRANSFER_ACCOUNT_ID
+	}
+
+	if num == transferNum {
+		return services.ResponseCodeEnum_TRANSFER_ACCOUNT_SAME_AS_DELETE_ACCOUNT
+	}
+
+	if !transaction._Signed(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	for _, token := range ledger.tokens {
+		if token.treasury == num && !token.deleted {
+			return services.ResponseCodeEnum_ACCOUNT_IS_TREASURY
+		}
+	}
+
+	for tokenNum, balance := range account.tokens {
+		if balance != 0 && !ledger.tokens[tokenNum].deleted {
+			return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+		}
+	}
+
+	transaction.record.TransferList.AccountAmounts = _AccountAmounts(map[int64]int64{
+		num:         -account.balance,
+		transferNum: account.balance,
+	})
+	transferAccount.balance += account.balance
+	delete(ledger.accounts, num)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoTransfer(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetCryptoTransfer()
+
+	hbars := make(map[int64]int64)
+	sum := int64(0)
+	for _, amount := range body.GetTransfers().GetAccountAmounts() {
+		num, _, ok := ledger._Account(amount.AccountID)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+		}
+
+		if amount.IsApproval {
+			return services.ResponseCodeEnum_SPENDER_DOES_NOT_HAVE_ALLOWANCE
+		}
+
+		hbars[num] += amount.Amount
+		sum += amount.Amount
+	}
+
+	if sum != 0 {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_AMOUNTS
+	}
+
+	for _, num := range _SortedNums(hbars) {
+		account := ledger.accounts[num]
+		if status := transaction._CheckTransfer(account, hbars[num]); status != services.ResponseCodeEnum_OK {
+			return status
+		}
+
+		if account.balance+hbars[num] < 0 {
+			return services.ResponseCodeEnum_INSUFFICIENT_ACCOUNT_BALANCE
+		}
+	}
+
+	tokenOrder := make([]int64, 0)
+	tokenLists := make(map[int64]*services.TokenTransferList)
+	tokenDeltas := make(map[int64]map[int64]int64)
+	nftsMoved := make(map[int64]map[int64]bool)
+	for _, list := range body.GetTokenTransfers() {
+		tokenNum, token, ok := ledger._Token(list.Token)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+
+		if token.deleted {
+			return services.ResponseCodeEnum_TOKEN_WAS_DELETED
+		}
+
+		if list.ExpectedDecimals != nil && list.ExpectedDecimals.Value != token.decimals {
+			return services.ResponseCodeEnum_UNEXPECTED_TOKEN_DECIMALS
+		}
+
+		if len(list.Transfers) > 0 && token.tokenType != services.TokenType_FUNGIBLE_COMMON {
+			return services.ResponseCodeEnum_ACCOUNT_AMOUNT_TRANSFERS_ONLY_ALLOWED_FOR_FUNGIBLE_COMMON
+		}
+
+		if len(list.NftTransfers) > 0 && token.tokenType != services.TokenType_NON_FUNGIBLE_UNIQUE {
+			return services.ResponseCodeEnum_INVALID_NFT_ID
+		}
+
+		if _, ok := tokenLists[tokenNum]; !ok {
+			tokenOrder = append(tokenOrder, tokenNum)
+			tokenLists[tokenNum] = &services.TokenTransferList{Token: list.Token, ExpectedDecimals: list.ExpectedDecimals}
+			tokenDeltas[tokenNum] = make(map[int64]int64)
+			nftsMoved[tokenNum] = make(map[int64]bool)
+		}
+
+		tokenSum := int64(0)
+		for _, amount := range list.Transfers {
+			num, account, ok := ledger._Account(amount.AccountID)
+			if !ok {
+				return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+			}
+
+			if amount.IsApproval {
+				return services.ResponseCodeEnum_SPENDER_DOES_NOT_HAVE_ALLOWANCE
+			}
+
+			if _, ok := account.tokens[tokenNum]; !ok {
+				return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+			}
+
+			tokenDeltas[tokenNum][num] += amount.Amount
+			tokenSum += amount.Amount
+		}
+
+		if tokenSum != 0 {
+			return services.ResponseCodeEnum_TRANSFERS_NOT_ZERO_SUM_FOR_TOKEN
+		}
+
+		for _, transfer := range list.NftTransfers {
+			senderNum, sender, ok := ledger._Account(transfer.SenderAccountID)
+			if !ok {
+				return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+			}
+
+			receiverNum, receiver, ok := ledger._Account(transfer.ReceiverAccountID)
+			if !ok {
+				return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+			}
+
+			if transfer.IsApproval {
+				return services.ResponseCodeEnum_SPENDER_DOES_NOT_HAVE_ALLOWANCE
+			}
+
+			nft, ok := token.nfts[transfer.SerialNumber]
+			if !ok || nftsMoved[tokenNum][transfer.SerialNumber] {
+				return services.ResponseCodeEnum_INVALID_NFT_ID
+			}
+
+			if nft.owner != senderNum {
+				return services.ResponseCodeEnum_SENDER_DOES_NOT_OWN_NFT_SERIAL_NO
+			}
+
+			if _, ok := receiver.tokens[tokenNum]; !ok {
+				return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+			}
+
+			if status := transaction._CheckTransfer(sender, -1); status != services.ResponseCodeEnum_OK {
+				return status
+			}
+
+			if status := transaction._CheckTransfer(receiver, 1); status != services.ResponseCodeEnum_OK {
+				return status
+			}
+
+			nftsMoved[tokenNum][transfer.SerialNumber] = true
+			tokenLists[tokenNum].NftTransfers = append(tokenLists[tokenNum].NftTransfers, &services.NftTransfer{
+				SenderAccountID:   _AccountIDToProtobuf(senderNum),
+				ReceiverAccountID: _AccountIDToProtobuf(receiverNum),
+				SerialNumber:      transfer.SerialNumber,
+			})
+		}
+	}
+
+	for _, tokenNum := range tokenOrder {
+		for _, num := range _SortedNums(tokenDeltas[tokenNum]) {
+			account := ledger.accounts[num]
+			if status := transaction._CheckTransfer(account, tokenDeltas[tokenNum][num]); status != services.ResponseCodeEnum_OK {
+				return status
+			}
+
+			if account.tokens[tokenNum]+tokenDeltas[tokenNum][num] < 0 {
+				return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+			}
+		}
+	}
+
+	for num, delta := range hbars {
+		ledger.accounts[num].balance += delta
+	}
+
+	for _, tokenNum := range tokenOrder {
+		for num, delta := range tokenDeltas[tokenNum] {
+			ledger.accounts[num].tokens[tokenNum] += delta
+		}
+
+		for _, transfer := range tokenLists[tokenNum].NftTransfers {
+			senderNum := transfer.SenderAccountID.GetAccountNum()
+			receiverNum := transfer.ReceiverAccountID.GetAccountNum()
+			ledger.tokens[tokenNum].nfts[transfer.SerialNumber].owner = receiverNum
+			ledger.accounts[senderNum].tokens[tokenNum]--
+			ledger.accounts[receiverNum].tokens[tokenNum]++
+		}
+
+		tokenLists[tokenNum].Transfers = _AccountAmounts(tokenDeltas[tokenNum])
+		transaction.record.TokenTransferLists = append(transaction.record.TokenTransferLists, tokenLists[tokenNum])
+	}
+
+	transaction.record.TransferList.AccountAmounts = _AccountAmounts(hbars)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenCreate(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetTokenCreation()
+
+	if body.Name == "" {
+		return services.ResponseCodeEnum_MISSING_TOKEN_NAME
+	}
+
+	if body.Symbol == "" {
+		return services.ResponseCodeEnum_MISSING_TOKEN_SYMBOL
+	}
+
+	treasuryNum, treasury, ok := ledger._Account(body.Treasury)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TREASURY_ACCOUNT_FOR_TOKEN
+	}
+
+	if !transaction._Signed(treasury.key) || !transaction._Signed(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.InitialSupply > math.MaxInt64 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_INITIAL_SUPPLY
+	}
+
+	initialSupply := int64(body.InitialSupply)
+	if body.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE {
+		if initialSupply != 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_INITIAL_SUPPLY
+		}
+
+		if body.Decimals != 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_DECIMALS
+		}
+
+		if body.SupplyKey == nil {
+			return services.ResponseCodeEnum_TOKEN_HAS_NO_SUPPLY_KEY
+		}
+	}
+
+	if body.SupplyType == services.TokenSupplyType_FINITE {
+		if body.MaxSupply <= 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_MAX_SUPPLY
+		}
+
+		if initialSupply > body.MaxSupply {
+			return services.ResponseCodeEnum_INVALID_TOKEN_INITIAL_SUPPLY
+		}
+	} else if body.MaxSupply != 0 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_MAX_SUPPLY
+	}
+
+	expiry := transaction.consensusTime.Add(time.Duration(_LedgerDefaultAutoRenewPeriod) * time.Second)
+	if body.Expiry != nil {
+		expiry = time.Unix(body.Expiry.Seconds, int64(body.Expiry.Nanos))
+	}
+
+	num := ledger._NextEntity()
+	ledger.tokens[num] = &_LedgerToken{
+		name:        body.Name,
+		symbol:      body.Symbol,
+		memo:        body.Memo,
+		decimals:    body.Decimals,
+		tokenType:   body.TokenType,
+		supplyType:  body.SupplyType,
+		totalSupply: initialSupply,
+		maxSupply:   body.MaxSupply,
+		treasury:    treasuryNum,
+		adminKey:    body.AdminKey,
+		kycKey:      body.KycKey,
+		freezeKey:   body.FreezeKey,
+		wipeKey:     body.WipeKey,
+		supplyKey:   body.SupplyKey,
+		feeKey:      body.FeeScheduleKey,
+		pauseKey:    body.PauseKey,
+		expiry:      expiry,
+		nfts:        make(map[int64]*_LedgerNft),
+	}
+	treasury.tokens[num] = initialSupply
+
+	tokenID := &services.TokenID{TokenNum: num}
+	transaction.record.Receipt.TokenID = tokenID
+	if initialSupply > 0 {
+		transaction.record.TokenTransferLists = []*services.TokenTransferList{{
+			Token:     tokenID,
+			Transfers: _AccountAmounts(map[int64]int64{treasuryNum: initialSupply}),
+		}}
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenAssociate(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetTokenAssociate()
+
+	_, account, ok := ledger._Account(body.Account)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+	}
+
+	if !transaction._Signed(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	tokens := make(map[int64]bool)
+	for _, tokenID := range body.Tokens {
+		tokenNum, token, ok := ledger._Token(tokenID)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+
+		if token.deleted {
+			return services.ResponseCodeEnum_TOKEN_WAS_DELETED
+		}
+
+		if tokens[tokenNum] {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+
+		if _, ok := account.tokens[tokenNum]; ok {
+			return services.ResponseCodeEnum_TOKEN_ALREADY_ASSOCIATED_TO_ACCOUNT
+		}
+
+		tokens[tokenNum] = true
+	}
+
+	for tokenNum := range tokens {
+		account.tokens[tokenNum] = 0
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenDelete(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetTokenDeletion()
+
+	_, token, ok := ledger._Token(body.Token)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TOKEN_ID
+	}
+
+	if token.deleted {
+		return services.ResponseCodeEnum_TOKEN_WAS_DELETED
+	}
+
+	if token.adminKey == nil {
+		return services.ResponseCodeEnum_TOKEN_IS_IMMUTABLE
+	}
+
+	if !transaction._Signed(token.adminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	token.deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenDissociate(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetTokenDissociate()
+
+	num, account, ok := ledger._Account(body.Account)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+	}
+
+	if !transaction._Signed(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	tokens := make(map[int64]bool)
+	for _, tokenID := range body.Tokens {
+		tokenNum, token, ok := ledger._Token(tokenID)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+
+		if tokens[tokenNum] {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+
+		balance, ok := account.tokens[tokenNum]
+		if !ok {
+			return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+		}
+
+		// The balances of a deleted token are dropped with the association
+		if !token.deleted {
+			if token.treasury == num {
+				return services.ResponseCodeEnum_ACCOUNT_IS_TREASURY
+			}
+
+			if balance != 0 {
+				return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+			}
+		}
+
+		tokens[tokenNum] = true
+	}
+
+	for tokenNum := range tokens {
+		delete(account.tokens, tokenNum)
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenMint(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetTokenMint()
+
+	tokenNum, token, ok := ledger._Token(body.Token)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TOKEN_ID
+	}
+
+	if token.deleted {
+		return services.ResponseCodeEnum_TOKEN_WAS_DELETED
+	}
+
+	if token.supplyKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_SUPPLY_KEY
+	}
+
+	if !transaction._Signed(token.supplyKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	var minted int64
+	if token.tokenType == services.TokenType_NON_FUNGIBLE_UNIQUE {
+		if body.Amount != 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_MINT_AMOUNT
+		}
+
+		if len(body.Metadata) == 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_MINT_METADATA
+		}
+
+		for _, metadata := range body.Metadata {
+			if len(metadata) > _LedgerMaxNftMetadata {
+				return services.ResponseCodeEnum_METADATA_TOO_LONG
+			}
+		}
+
+		minted = int64(len(body.Metadata))
+	} else {
+		if body.Amount == 0 || body.Amount > math.MaxInt64 || len(body.Metadata) > 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_MINT_AMOUNT
+		}
+
+		minted = int64(body.Amount)
+	}
+
+	if minted > math.MaxInt64-token.totalSupply ||
+		(token.supplyType == services.TokenSupplyType_FINITE && token.totalSupply+minted > token.maxSupply) {
+		return services.ResponseCodeEnum_TOKEN_MAX_SUPPLY_REACHED
+	}
+
+	token.totalSupply += minted
+	ledger.accounts[token.treasury].tokens[tokenNum] += minted
+
+	transfers := &services.TokenTransferList{Token: body.Token}
+	if token.tokenType == services.TokenType_NON_FUNGIBLE_UNIQUE {
+		for _, metadata := range body.Metadata {
+			token.lastSerial++
+			token.nfts[token.lastSerial] = &_LedgerNft{
+				owner:    token.treasury,
+				metadata: metadata,
+				created:  transaction.consensusTime,
+			}
+
+			transaction.record.Receipt.SerialNumbers = append(transaction.record.Receipt.SerialNumbers, token.lastSerial)
+			transfers.NftTransfers = append(transfers.NftTransfers, &services.NftTransfer{
+				SenderAccountID:   _AccountIDToProtobuf(0),
+				ReceiverAccountID: _AccountIDToProtobuf(token.treasury),
+				SerialNumber:      token.lastSerial,
+			})
+		}
+	} else {
+		transfers.Transfers = _AccountAmounts(map[int64]int64{token.treasury: minted})
+	}
+
+	transaction.record.Receipt.NewTotalSupply = uint64(token.totalSupply)
+	transaction.record.TokenTransferLists = []*services.TokenTransferList{transfers}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ConsensusCreateTopic(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetConsensusCreateTopic()
+
+	if !transaction._Signed(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.AutoRenewAccount != nil {
+		_, account, ok := ledger._Account(body.AutoRenewAccount)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_AUTORENEW_ACCOUNT
+		}
+
+		if !transaction._Signed(account.key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+	}
+
+	autoRenewPeriod := body.GetAutoRenewPeriod().GetSeconds()
+	if autoRenewPeriod == 0 {
+		autoRenewPeriod = _LedgerDefaultAutoRenewPeriod
+	}
+
+	num := ledger._NextEntity()
+	ledger.topics[num] = &_LedgerTopic{
+		memo:             body.Memo,
+		adminKey:         body.AdminKey,
+		submitKey:        body.SubmitKey,
+		autoRenewPeriod:  autoRenewPeriod,
+		autoRenewAccount: body.AutoRenewAccount,
+		expiry:           transaction.consensusTime.Add(time.Duration(autoRenewPeriod) * time.Second),
+		runningHash:      make([]byte, _LedgerRunningHashSize),
+	}
+
+	transaction.record.Receipt.TopicID = &services.TopicID{TopicNum: num}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ConsensusDeleteTopic(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetConsensusDeleteTopic()
+
+	num, topic, ok := ledger._Topic(body.TopicID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TOPIC_ID
+	}
+
+	if topic.adminKey == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+
+	if !transaction._Signed(topic.adminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	delete(ledger.topics, num)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ConsensusSubmitMessage(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetConsensusSubmitMessage()
+
+	topicNum, topic, ok := ledger._Topic(body.TopicID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TOPIC_ID
+	}
+
+	if !transaction._Signed(topic.submitKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if len(body.Message) == 0 {
+		return services.ResponseCodeEnum_INVALID_TOPIC_MESSAGE
+	}
+
+	if len(body.Message) > _LedgerMaxMessageSize {
+		return services.ResponseCodeEnum_MESSAGE_SIZE_TOO_LARGE
+	}
+
+	chunkInfo := body.ChunkInfo
+	if chunkInfo == nil {
+		chunkInfo = &services.ConsensusMessageChunkInfo{
+			InitialTransactionID: transaction.body.TransactionID,
+			Total:                1,
+			Number:               1,
+		}
+	}
+
+	if chunkInfo.Number < 1 || chunkInfo.Number > chunkInfo.Total {
+		return services.ResponseCodeEnum_INVALID_CHUNK_NUMBER
+	}
+
+	if chunkInfo.Number == 1 && !protobuf.Equal(chunkInfo.InitialTransactionID, transaction.body.TransactionID) {
+		return services.ResponseCodeEnum_INVALID_CHUNK_TRANSACTION_ID
+	}
+
+	consensusTimestamp := _TimestampToProtobuf(transaction.consensusTime)
+	topic.sequenceNumber++
+	topic.runningHash = _RunningHashV3(
+		topic.runningHash,
+		transaction.body.TransactionID.AccountID,
+		topicNum,
+		consensusTimestamp,
+		topic.sequenceNumber,
+		body.Message,
+	)
+
+	transaction.record.Receipt.TopicSequenceNumber = topic.sequenceNumber
+	transaction.record.Receipt.TopicRunningHash = topic.runningHash
+	transaction.record.Receipt.TopicRunningHashVersion = hedera.TopicRunningHashVersion
+
+	transaction.topicID = hedera.TopicID{Topic: uint64(topicNum)}
+	transaction.topicMessage = &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: consensusTimestamp,
+		Message:            body.Message,
+		RunningHash:        topic.runningHash,
+		SequenceNumber:     topic.sequenceNumber,
+		RunningHashVersion: hedera.TopicRunningHashVersion,
+		ChunkInfo:          chunkInfo,
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileCreate(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetFileCreate()
+
+	if !_KeyListSatisfied(body.Keys, transaction.signers) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if len(body.Contents) > _LedgerMaxFileSize {
+		return services.ResponseCodeEnum_MAX_FILE_SIZE_EXCEEDED
+	}
+
+	expiry := transaction.consensusTime.Add(time.Duration(_LedgerDefaultAutoRenewPeriod) * time.Second)
+	if body.ExpirationTime != nil {
+		expiry = time.Unix(body.ExpirationTime.Seconds, int64(body.ExpirationTime.Nanos))
+	}
+
+	num := ledger._NextEntity()
+	ledger.files[num] = &_LedgerFile{
+		keys:     body.Keys,
+		contents: append([]byte{}, body.Contents...),
+		memo:     body.Memo,
+		expiry:   expiry,
+	}
+
+	transaction.record.Receipt.FileID = &services.FileID{FileNum: num}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileAppend(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetFileAppend()
+
+	_, file, ok := ledger._File(body.FileID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_FILE_ID
+	}
+
+	if file.deleted {
+		return services.ResponseCodeEnum_FILE_DELETED
+	}
+
+	if len(file.keys.GetKeys()) == 0 {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+
+	if !_KeyListSatisfied(file.keys, transaction.signers) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if len(file.contents)+len(body.Contents) > _LedgerMaxFileSize {
+		return services.ResponseCodeEnum_MAX_FILE_SIZE_EXCEEDED
+	}
+
+	file.contents = append(file.contents, body.Contents...)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileDelete(transaction *_LedgerTransaction) services.ResponseCodeEnum {
+	body := transaction.body.GetFileDelete()
+
+	_, file, ok := ledger._File(body.FileID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_FILE_ID
+	}
+
+	if file.deleted {
+		return services.ResponseCodeEnum_FILE_DELETED
+	}
+
+	if len(file.keys.GetKeys()) == 0 {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+
+	if !_KeyListSatisfied(file.keys, transaction.signers) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	file.deleted = true
+	file.contents = nil
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _RunningHashV3 computes the version 3 running hash of topic topicNum after
+// a message, as the consensus nodes do.
+func _RunningHashV3(
+	previous []byte,
+	payer *services.AccountID,
+	topicNum int64,
+	consensusTimestamp *services.Timestamp,
+	sequenceNumber uint64,
+	message []byte,
+) []byte {
+	var buffer bytes.Buffer
+	buffer.Write(previous)
+
+	for _, value := range []uint64{
+		hedera.TopicRunningHashVersion,
+		uint64(payer.GetShardNum()),
+		uint64(payer.GetRealmNum()),
+		uint64(payer.GetAccountNum()),
+		0,
+		0,
+		uint64(topicNum),
+		uint64(consensusTimestamp.GetSeconds()),
+	} {
+		_ = binary.Write(&buffer, binary.BigEndian, value)
+	}
+	_ = binary.Write(&buffer, binary.BigEndian, consensusTimestamp.GetNanos())
+	_ = binary.Write(&buffer, binary.BigEndian, sequenceNumber)
+
+	messageHash := sha512.Sum384(message)
+	buffer.Write(messageHash[:])
+
+	runningHash := sha512.Sum384(buffer.Bytes())
+
+	return runningHash[:]
+}
+
+// _AccountAmounts returns the non-zero amounts in account number order.
+func _AccountAmounts(amounts map[int64]int64) []*services.AccountAmount {
+	accountAmounts := make([]*services.AccountAmount, 0, len(amounts))
+	for _, num := range _SortedNums(amounts) {
+		if amounts[num] != 0 {
+			accountAmounts = append(accountAmounts, &services.AccountAmount{
+				AccountID: _AccountIDToProtobuf(num),
+				Amount:    amounts[num],
+			})
+		}
+	}
+
+	return accountAmounts
+}
+
+func _SortedNums(amounts map[int64]int64) []int64 {
+	nums := make([]int64, 0, len(amounts))
+	for num := range amounts {
+		nums = append(nums, num)
+	}
+
+	sort.Slice(nums, func(i, j int) bool {
+		return nums[i] < nums[j]
+	})
+
+	return nums
+}
@@ -0,0 +1,530 @@
+//go:build all || unit
+// +build all unit
+
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewUnitEmulator(t *testing.T, nodeCount int) *Emulator {
+	emulator, err := NewEmulator(nodeCount)
+	require.NoError(t, err)
+	t.Cleanup(emulator.Close)
+
+	return emulator
+}
+
+func _NewUnitEmulatorAccount(t *testing.T, emulator *Emulator, initialBalance hedera.Hbar) (hedera.AccountID, hedera.PrivateKey) {
+	key, err := hedera.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	response, err := hedera.NewAccountCreateTransaction().
+		SetKey(key.PublicKey()).
+		SetInitialBalance(initialBalance).
+		Execute(emulator.Client())
+	require.NoError(t, err)
+
+	receipt, err := response.GetReceipt(emulator.Client())
+	require.NoError(t, err)
+	require.NotNil(t, receipt.AccountID)
+
+	return *receipt.AccountID, key
+}
+
+func TestUnitEmulatorInvalidNodeCount(t *testing.T) {
+	_, err := NewEmulator(0)
+	require.Error(t, err)
+}
+
+func TestUnitEmulatorAccountCreateAndTransfer(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 2)
+	client := emulator.Client()
+
+	accountID, _ := _NewUnitEmulatorAccount(t, emulator, hedera.NewHbar(10))
+	require.Equal(t, "0.0.1001", accountID.String())
+
+	response, err := hedera.NewTransferTransaction().
+		AddHbarTransfer(EmulatorOperatorAccountID, hedera.NewHbar(-5)).
+		AddHbarTransfer(accountID, hedera.NewHbar(5)).
+		Execute(client)
+	require.NoError(t, err)
+
+	record, err := response.GetRecord(client)
+	require.NoError(t, err)
+	require.Equal(t, hedera.StatusSuccess, record.Receipt.Status)
+	require.Equal(t, response.Hash, record.TransactionHash)
+	require.Len(t, record.Transfers, 2)
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, hedera.NewHbar(15).AsTinybar(), balance.Hbars.AsTinybar())
+
+	info, err := hedera.NewAccountInfoQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, accountID.String(), info.AccountID.String())
+	require.Equal(t, hedera.NewHbar(15).AsTinybar(), info.Balance.AsTinybar())
+
+	// Every node shares the same ledger.
+	for _, nodeAccountID := range []hedera.AccountID{{Account: 3}, {Account: 4}} {
+		balance, err := hedera.NewAccountBalanceQuery().
+			SetNodeAccountIDs([]hedera.AccountID{nodeAccountID}).
+			SetAccountID(accountID).
+			Execute(client)
+		require.NoError(t, err)
+		require.Equal(t, hedera.NewHbar(15).AsTinybar(), balance.Hbars.AsTinybar())
+	}
+}
+
+func TestUnitEmulatorAccountDelete(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 1)
+	client := emulator.Client()
+
+	accountID, accountKey := _NewUnitEmulatorAccount(t, emulator, hedera.NewHbar(10))
+
+	unsigned, err := hedera.NewAccountDeleteTransaction().
+		SetAccountID(accountID).
+		SetTransferAccountID(EmulatorOperatorAccountID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = unsigned.GetReceipt(client)
+	require.ErrorContains(t, err, "INVALID_SIGNATURE")
+
+	transaction, err := hedera.NewAccountDeleteTransaction().
+		SetAccountID(accountID).
+		SetTransferAccountID(EmulatorOperatorAccountID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err := transaction.Sign(accountKey).Execute(client)
+	require.NoError(t, err)
+
+	record, err := response.GetRecord(client)
+	require.NoError(t, err)
+	require.Equal(t, hedera.StatusSuccess, record.Receipt.Status)
+	require.Len(t, record.Transfers, 2)
+
+	_, err = hedera.NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.ErrorContains(t, err, "INVALID_ACCOUNT_ID")
+}
+
+// A client built from ClientConfig, as another process would, keeps using the
+// emulator's nodes after updating its network from the mirror node.
+func TestUnitEmulatorClientConfig(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 2)
+
+	config, err := emulator.ClientConfig()
+	require.NoError(t, err)
+
+	client, err := hedera.ClientFromConfig(config)
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.Equal(t, emulator.Client().GetNetwork(), client.GetNetwork())
+	require.Equal(t, EmulatorOperatorAccountID.String(), client.GetOperatorAccountID().String())
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(EmulatorOperatorAccountID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Positive(t, balance.Hbars.AsTinybar())
+}
+
+func TestUnitEmulatorTransferMissingSignature(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 1)
+	client := emulator.Client()
+
+	accountID, _ := _NewUnitEmulatorAccount(t, emulator, hedera.NewHbar(10))
+
+	response, err := hedera.NewTransferTransaction().
+		AddHbarTransfer(accountID, hedera.NewHbar(-5)).
+		AddHbarTransfer(EmulatorOperatorAccountID, hedera.NewHbar(5)).
+		Execute(client)
+	require.NoError(t, err)
+
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, "INVALID_SIGNATURE")
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, hedera.NewHbar(10).AsTinybar(), balance.Hbars.AsTinybar())
+}
+
+func TestUnitEmulatorPayerSignatureRequired(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 1)
+	client := emulator.Client()
+
+	accountID, _ := _NewUnitEmulatorAccount(t, emulator, hedera.NewHbar(10))
+
+	transaction, err := hedera.NewTransferTransaction().
+		SetTransactionID(hedera.TransactionIDGenerate(accountID)).
+		AddHbarTransfer(EmulatorOperatorAccountID, hedera.NewHbar(-1)).
+		AddHbarTransfer(accountID, hedera.NewHbar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	_, err = transaction.Execute(client)
+	require.ErrorContains(t, err, "INVALID_SIGNATURE")
+}
+
+func TestUnitEmulatorFungibleToken(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 1)
+	client := emulator.Client()
+
+	accountID, accountKey := _NewUnitEmulatorAccount(t, emulator, hedera.NewHbar(10))
+
+	response, err := hedera.NewTokenCreateTransaction().
+		SetTokenName("emulated").
+		SetTokenSymbol("EMU").
+		SetDecimals(2).
+		SetInitialSupply(1000).
+		SetTreasuryAccountID(EmulatorOperatorAccountID).
+		SetAdminKey(emulator.OperatorKey().PublicKey()).
+		SetSupplyKey(emulator.OperatorKey().PublicKey()).
+		Execute(client)
+	require.NoError(t, err)
+
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	tokenID := *receipt.TokenID
+
+	// Transfers to an account which is not associated fail.
+	response, err = hedera.NewTransferTransaction().
+		AddTokenTransfer(tokenID, EmulatorOperatorAccountID, -100).
+		AddTokenTransfer(tokenID, accountID, 100).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, "TOKEN_NOT_ASSOCIATED_TO_ACCOUNT")
+
+	associate, err := hedera.NewTokenAssociateTransaction().
+		SetAccountID(accountID).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = associate.Sign(accountKey).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hedera.NewTransferTransaction().
+		AddTokenTransferWithDecimals(tokenID, EmulatorOperatorAccountID, -100, 2).
+		AddTokenTransferWithDecimals(tokenID, accountID, 100, 2).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hedera.NewTokenMintTransaction().
+		SetTokenID(tokenID).
+		SetAmount(500).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500), receipt.TotalSupply)
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), balance.Tokens.Get(tokenID))
+
+	info, err := hedera.NewTokenInfoQuery().
+		SetTokenID(tokenID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, "EMU", info.Symbol)
+	require.Equal(t, uint32(2), info.Decimals)
+	require.Equal(t, uint64(1500), info.TotalSupply)
+	require.Equal(t, EmulatorOperatorAccountID.String(), info.Treasury.String())
+
+	// A live token can only be dissociated without a balance, a deleted one always
+	dissociate, err := hedera.NewTokenDissociateTransaction().
+		SetAccountID(accountID).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = dissociate.Sign(accountKey).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, "TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES")
+
+	response, err = hedera.NewTokenDeleteTransaction().
+		SetTokenID(tokenID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hedera.NewTokenMintTransaction().
+		SetTokenID(tokenID).
+		SetAmount(500).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, "TOKEN_WAS_DELETED")
+
+	dissociate, err = hedera.NewTokenDissociateTransaction().
+		SetAccountID(accountID).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = dissociate.Sign(accountKey).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	info, err = hedera.NewTokenInfoQuery().
+		SetTokenID(tokenID).
+		Execute(client)
+	require.NoError(t, err)
+	require.True(t, info.Deleted)
+
+	balance, err = hedera.NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Empty(t, balance.Tokens.Get(tokenID))
+}
+
+func TestUnitEmulatorNft(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 1)
+	client := emulator.Client()
+
+	accountID, accountKey := _NewUnitEmulatorAccount(t, emulator, hedera.NewHbar(10))
+
+	response, err := hedera.NewTokenCreateTransaction().
+		SetTokenName("emulated").
+		SetTokenSymbol("NFT").
+		SetTokenType(hedera.TokenTypeNonFungibleUnique).
+		SetSupplyType(hedera.TokenSupplyTypeFinite).
+		SetMaxSupply(2).
+		SetTreasuryAccountID(EmulatorOperatorAccountID).
+		SetSupplyKey(emulator.OperatorKey().PublicKey()).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	tokenID := *receipt.TokenID
+
+	response, err = hedera.NewTokenMintTransaction().
+		SetTokenID(tokenID).
+		SetMetadatas([][]byte{{1}, {2}}).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, receipt.SerialNumbers)
+
+	response, err = hedera.NewTokenMintTransaction().
+		SetTokenID(tokenID).
+		SetMetadata([]byte{3}).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, "TOKEN_MAX_SUPPLY_REACHED")
+
+	associate, err := hedera.NewTokenAssociateTransaction().
+		SetAccountID(accountID).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = associate.Sign(accountKey).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	nftID := tokenID.Nft(2)
+	response, err = hedera.NewTransferTransaction().
+		AddNftTransfer(nftID, EmulatorOperatorAccountID, accountID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	// The operator no longer owns the serial.
+	response, err = hedera.NewTransferTransaction().
+		AddNftTransfer(nftID, EmulatorOperatorAccountID, accountID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, "SENDER_DOES_NOT_OWN_NFT_SERIAL_NO")
+
+	nfts, err := hedera.NewTokenNftInfoQuery().
+		SetNftID(nftID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, nfts, 1)
+	require.Equal(t, accountID.String(), nfts[0].AccountID.String())
+	require.Equal(t, []byte{2}, nfts[0].Metadata)
+}
+
+func TestUnitEmulatorTopic(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 1)
+	client := emulator.Client()
+
+	response, err := hedera.NewTopicCreateTransaction().
+		SetTopicMemo("emulated").
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	topicID := *receipt.TopicID
+
+	messages := make(chan hedera.TopicMessage, 2)
+	handle, err := hedera.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		Subscribe(client, func(message hedera.TopicMessage) {
+			messages <- message
+		})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	response, err = hedera.NewTopicMessageSubmitTransaction().
+		SetTopicID(topicID).
+		SetMessage([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), receipt.TopicSequenceNumber)
+
+	large := bytes.Repeat([]byte{'a'}, 2500)
+	responses, err := hedera.NewTopicMessageSubmitTransaction().
+		SetTopicID(topicID).
+		SetMessage(large).
+		ExecuteAll(client)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+	receipt, err = responses[2].GetReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), receipt.TopicSequenceNumber)
+
+	for _, expected := range [][]byte{[]byte("hello"), large} {
+		select {
+		case message := <-messages:
+			require.Equal(t, expected, message.Contents)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for a topic message")
+		}
+	}
+
+	info, err := hedera.NewTopicInfoQuery().
+		SetTopicID(topicID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, "emulated", info.TopicMemo)
+	require.Equal(t, uint64(4), info.SequenceNumber)
+	require.Equal(t, receipt.TopicRunningHash, info.RunningHash)
+
+	// A topic without an admin key cannot be deleted
+	response, err = hedera.NewTopicDeleteTransaction().
+		SetTopicID(topicID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.ErrorContains(t, err, "UNAUTHORIZED")
+
+	response, err = hedera.NewTopicCreateTransaction().
+		SetAdminKey(emulator.OperatorKey().PublicKey()).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hedera.NewTopicDeleteTransaction().
+		SetTopicID(*receipt.TopicID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	_, err = hedera.NewTopicInfoQuery().
+		SetTopicID(*receipt.TopicID).
+		Execute(client)
+	require.ErrorContains(t, err, "INVALID_TOPIC_ID")
+}
+
+func TestUnitEmulatorFile(t *testing.T) {
+	emulator := _NewUnitEmulator(t, 1)
+	client := emulator.Client()
+
+	response, err := hedera.NewFileCreateTransaction().
+		SetKeys(emulator.OperatorKey().PublicKey()).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	fileID := *receipt.FileID
+
+	response, err = hedera.NewFileAppendTransaction().
+		SetFileID(fileID).
+		SetContents([]byte(" world")).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	contents, err := hedera.NewFileContentsQuery().
+		SetFileID(fileID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello world"), contents)
+
+	_, err = hedera.NewFileContentsQuery().
+		SetFileID(hedera.FileID{File: 999}).
+		Execute(client)
+	require.ErrorContains(t, err, "INVALID_FILE_ID")
+
+	response, err = hedera.NewFileDeleteTransaction().
+		SetFileID(fileID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	info, err := hedera.NewFileInfoQuery().
+		SetFileID(fileID).
+		Execute(client)
+	require.NoError(t, err)
+	require.True(t, info.IsDeleted)
+
+	_, err = hedera.NewFileContentsQuery().
+		SetFileID(fileID).
+		Execute(client)
+	require.ErrorContains(t, err, "FILE_DELETED")
+}
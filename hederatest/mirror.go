@@ -0,0 +1,221 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"net"
+	"sync"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Mirror is a mock mirror node. Topic subscriptions stream the messages added
+// for the topic within the requested time range and then end; address book
+// queries stream the address book. The mirror node of an Emulator instead
+// keeps subscriptions open and streams new messages as they are submitted.
+type Mirror struct {
+	mu            sync.Mutex
+	live          bool
+	changed       chan struct{}
+	topicMessages map[hedera.TopicID][]*mirror.ConsensusTopicResponse
+	addressBook   []*services.NodeAddress
+	subscriptions []*mirror.ConsensusTopicQuery
+	listener      net.Listener
+	server        *grpc.Server
+}
+
+func _NewMirror(live bool) (*Mirror, error) {
+	mirrorNode := &Mirror{
+		live:          live,
+		changed:       make(chan struct{}),
+		topicMessages: make(map[hedera.TopicID][]*mirror.ConsensusTopicResponse),
+		server:        grpc.NewServer(),
+	}
+
+	mirrorNode.server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: mirror.ConsensusService_ServiceDesc.ServiceName,
+		HandlerType: mirror.ConsensusService_ServiceDesc.HandlerType,
+		Methods:     []grpc.MethodDesc{},
+		Streams: []grpc.StreamDesc{{
+			StreamName:    "subscribeTopic",
+			Handler:       mirrorNode._SubscribeTopic,
+			ServerStreams: true,
+		}},
+		Metadata: mirror.ConsensusService_ServiceDesc.Metadata,
+	}, nil)
+	mirrorNode.server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: mirror.NetworkService_ServiceDesc.ServiceName,
+		HandlerType: mirror.NetworkService_ServiceDesc.HandlerType,
+		Methods:     []grpc.MethodDesc{},
+		Streams: []grpc.StreamDesc{{
+			StreamName:    "getNodes",
+			Handler:       mirrorNode._GetNodes,
+			ServerStreams: true,
+		}},
+		Metadata: mirror.NetworkService_ServiceDesc.Metadata,
+	}, nil)
+
+	listener, err := _Serve(mirrorNode.server)
+	if err != nil {
+		return nil, err
+	}
+
+	mirrorNode.listener = listener
+	return mirrorNode, nil
+}
+
+// Address returns the host:port the mirror node is listening on.
+func (mirrorNode *Mirror) Address() string {
+	return mirrorNode.listener.Addr().String()
+}
+
+// AddTopicMessages appends messages to the ones streamed to subscribers of
+// topicID.
+func (mirrorNode *Mirror) AddTopicMessages(topicID hedera.TopicID, messages ...*mirror.ConsensusTopicResponse) *Mirror {
+	mirrorNode.mu.Lock()
+	defer mirrorNode.mu.Unlock()
+
+	topicID = hedera.TopicID{Shard: topicID.Shard, Realm: topicID.Realm, Topic: topicID.Topic}
+	mirrorNode.topicMessages[topicID] = append(mirrorNode.topicMessages[topicID], messages...)
+
+	close(mirrorNode.changed)
+	mirrorNode.changed = make(chan struct{})
+
+	return mirrorNode
+}
+
+// SetAddressBook sets the address book streamed to address book queries.
+func (mirrorNode *Mirror) SetAddressBook(addressBook hedera.NodeAddressBook) *Mirror {
+	pb := services.NodeAddressBook{}
+	_ = protobuf.Unmarshal(addressBook.ToBytes(), &pb)
+
+	mirrorNode.mu.Lock()
+	defer mirrorNode.mu.Unlock()
+
+	mirrorNode.addressBook = pb.NodeAddress
+	return mirrorNode
+}
+
+// Subscriptions returns the topic subscriptions received, in the order they
+// arrived.
+func (mirrorNode *Mirror) Subscriptions() []*mirror.ConsensusTopicQuery {
+	mirrorNode.mu.Lock()
+	defer mirrorNode.mu.Unlock()
+
+	return append([]*mirror.ConsensusTopicQuery{}, mirrorNode.subscriptions...)
+}
+
+func (mirrorNode *Mirror) _SubscribeTopic(_ interface{}, stream grpc.ServerStream) error {
+	query := new(mirror.ConsensusTopicQuery)
+	if err := stream.RecvMsg(query); err != nil {
+		return err
+	}
+
+	topicID := hedera.TopicID{
+		Shard: uint64(query.GetTopicID().GetShardNum()),
+		Realm: uint64(query.GetTopicID().GetRealmNum()),
+		Topic: uint64(query.GetTopicID().GetTopicNum()),
+	}
+
+	mirrorNode.mu.Lock()
+	mirrorNode.subscriptions = append(mirrorNode.subscriptions, query)
+	mirrorNode.mu.Unlock()
+
+	sent := uint64(0)
+	next := 0
+	for {
+		mirrorNode.mu.Lock()
+		messages := mirrorNode.topicMessages[topicID][next:]
+		next += len(messages)
+		changed := mirrorNode.changed
+		mirrorNode.mu.Unlock()
+
+		for _, message := range messages {
+			if query.ConsensusEndTime != nil && !_TimestampBefore(message.ConsensusTimestamp, query.ConsensusEndTime) {
+				return nil
+			}
+
+			if _TimestampBefore(message.ConsensusTimestamp, query.ConsensusStartTime) {
+				continue
+			}
+
+			if err := stream.SendMsg(message); err != nil {
+				return err
+			}
+
+			sent++
+			if query.Limit > 0 && sent >= query.Limit {
+				return nil
+			}
+		}
+
+		if !mirrorNode.live {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (mirrorNode *Mirror) _GetNodes(_ interface{}, stream grpc.ServerStream) error {
+	query := new(mirror.AddressBookQuery)
+	if err := stream.RecvMsg(query); err != nil {
+		return err
+	}
+
+	mirrorNode.mu.Lock()
+	addresses := append([]*services.NodeAddress{}, mirrorNode.addressBook...)
+	mirrorNode.mu.Unlock()
+
+	for i, address := range addresses {
+		if query.Limit > 0 && int32(i) >= query.Limit {
+			break
+		}
+
+		if err := stream.SendMsg(address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func _TimestampBefore(timestamp *services.Timestamp, other *services.Timestamp) bool {
+	if timestamp.GetSeconds() != other.GetSeconds() {
+		return timestamp.GetSeconds() < other.GetSeconds()
+	}
+
+	return timestamp.GetNanos() < other.GetNanos()
+}
+
+func (mirrorNode *Mirror) _Close() {
+	if mirrorNode.server != nil {
+		mirrorNode.server.Stop()
+	}
+}
@@ -0,0 +1,197 @@
+// Package hederatest provides an in-process mock Hedera network for testing
+// code built on top of the SDK.
+//
+// A Network starts one gRPC server per consensus node and one mirror node
+// server on the loopback interface, and returns a *hedera.Client that is
+// configured to talk to them. Every node replies to requests from its own
+// script of Responses, in order, and records every request it receives so
+// tests can assert on what was sent.
+//
+//	network, err := hederatest.NewNetwork(1)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer network.Close()
+//
+//	network.Node(hedera.AccountID{Account: 3}).Enqueue(
+//		hederatest.Precheck(hedera.StatusBusy),
+//		hederatest.Precheck(hedera.StatusOk),
+//		hederatest.Receipt(hedera.TransactionReceipt{Status: hedera.StatusSuccess}),
+//	)
+//
+// Receipt and record queries are sent to the node which accepted the
+// transaction, so their responses must be enqueued on that node.
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"sync"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/pkg/errors"
+)
+
+// OperatorAccountID is the account the Network's client pays for
+// transactions and queries with.
+var OperatorAccountID = hedera.AccountID{Account: 1800}
+
+// Network is a set of mock consensus nodes and a mock mirror node running
+// in-process, together with a client connected to them.
+type Network struct {
+	mu          sync.Mutex
+	nodes       []*Node
+	mirror      *Mirror
+	client      *hedera.Client
+	operatorKey hedera.PrivateKey
+	requests    []Request
+}
+
+// NewNetwork starts nodeCount mock consensus nodes with account IDs 0.0.3,
+// 0.0.4, ... and a mock mirror node, all listening on loopback.
+func NewNetwork(nodeCount int) (*Network, error) {
+	if nodeCount < 1 {
+		return nil, errors.New("hederatest: a network needs at least one node")
+	}
+
+	operatorKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		return nil, err
+	}
+
+	network := &Network{
+		operatorKey: operatorKey,
+	}
+
+	addresses := make(map[string]hedera.AccountID, nodeCount)
+	for i := 0; i < nodeCount; i++ {
+		node, err := _NewNode(network, hedera.AccountID{Account: uint64(3 + i)})
+		if err != nil {
+			network.Close()
+			return nil, err
+		}
+
+		network.nodes = append(network.nodes, node)
+		addresses[node.Address()] = node.AccountID()
+	}
+
+	network.mirror, err = _NewMirror(false)
+	if err != nil {
+		network.Close()
+		return nil, err
+	}
+
+	network.client = _NewClient(addresses, network.mirror.Address(), OperatorAccountID, operatorKey)
+
+	return network, nil
+}
+
+// _NewClient returns a client for nodes on loopback which retries without
+// waiting.
+func _NewClient(addresses map[string]hedera.AccountID, mirrorAddress string, operatorID hedera.AccountID, operatorKey hedera.PrivateKey) *hedera.Client {
+	client := hedera.ClientForNetwork(addresses)
+	client.SetMirrorNetwork([]string{mirrorAddress})
+	client.SetOperator(operatorID, operatorKey)
+	client.SetMinBackoff(0)
+	client.SetMaxBackoff(0)
+	client.SetMinNodeReadmitTime(0)
+	client.SetMaxNodeReadmitTime(0)
+	client.SetNodeMinBackoff(0)
+	client.SetNodeMaxBackoff(0)
+
+	return client
+}
+
+// Client returns the client connected to the network. It is closed by Close.
+func (network *Network) Client() *hedera.Client {
+	return network.client
+}
+
+// OperatorKey returns the private key of the client's operator.
+func (network *Network) OperatorKey() hedera.PrivateKey {
+	return network.operatorKey
+}
+
+// Nodes returns the consensus nodes in account ID order.
+func (network *Network) Nodes() []*Node {
+	return append([]*Node{}, network.nodes...)
+}
+
+// Node returns the consensus node with the given account ID, or nil if the
+// network has no such node.
+func (network *Network) Node(accountID hedera.AccountID) *Node {
+	for _, node := range network.nodes {
+		if node.accountID.Equals(accountID) {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// Mirror returns the mirror node.
+func (network *Network) Mirror() *Mirror {
+	return network.mirror
+}
+
+// Requests returns every request received by any consensus node, in the
+// order they arrived.
+func (network *Network) Requests() []Request {
+	network.mu.Lock()
+	defer network.mu.Unlock()
+
+	return append([]Request{}, network.requests...)
+}
+
+// Transactions returns the transaction requests received by any consensus
+// node, in the order they arrived. Query payments are not included.
+func (network *Network) Transactions() []Request {
+	transactions := make([]Request, 0)
+	for _, request := range network.Requests() {
+		if request.Transaction != nil {
+			transactions = append(transactions, request)
+		}
+	}
+
+	return transactions
+}
+
+// Close closes the client and stops every server.
+func (network *Network) Close() {
+	if network.client != nil {
+		_ = network.client.Close()
+	}
+
+	for _, node := range network.nodes {
+		node._Close()
+	}
+
+	if network.mirror != nil {
+		network.mirror._Close()
+	}
+}
+
+func (network *Network) _Record(request Request) {
+	network.mu.Lock()
+	defer network.mu.Unlock()
+
+	network.requests = append(network.requests, request)
+}
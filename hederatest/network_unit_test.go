@@ -0,0 +1,354 @@
+//go:build all || unit
+// +build all unit
+
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func _NewUnitNetwork(t *testing.T, nodeCount int) *Network {
+	network, err := NewNetwork(nodeCount)
+	require.NoError(t, err)
+	t.Cleanup(network.Close)
+
+	return network
+}
+
+func _NewUnitTransfer(nodeAccountIDs ...hedera.AccountID) *hedera.TransferTransaction {
+	return hedera.NewTransferTransaction().
+		SetNodeAccountIDs(nodeAccountIDs).
+		AddHbarTransfer(OperatorAccountID, hedera.NewHbar(-1)).
+		AddHbarTransfer(hedera.AccountID{Account: 98}, hedera.NewHbar(1))
+}
+
+func TestUnitNetworkInvalidNodeCount(t *testing.T) {
+	_, err := NewNetwork(0)
+	require.Error(t, err)
+}
+
+func TestUnitNetworkNodes(t *testing.T) {
+	network := _NewUnitNetwork(t, 3)
+
+	require.Len(t, network.Nodes(), 3)
+	require.Equal(t, "0.0.5", network.Nodes()[2].AccountID().String())
+	require.Nil(t, network.Node(hedera.AccountID{Account: 6}))
+	require.Len(t, network.Client().GetNetwork(), 3)
+	require.Equal(t, []string{network.Mirror().Address()}, network.Client().GetMirrorNetwork())
+	require.Equal(t, OperatorAccountID.String(), network.Client().GetOperatorAccountID().String())
+	require.Equal(t, network.OperatorKey().PublicKey().String(), network.Client().GetOperatorPublicKey().String())
+}
+
+func TestUnitNetworkPrecheckBusy(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+	node := network.Node(hedera.AccountID{Account: 3})
+	node.Enqueue(
+		Precheck(hedera.StatusBusy),
+		Precheck(hedera.StatusOk),
+		Receipt(hedera.TransactionReceipt{Status: hedera.StatusSuccess}),
+	)
+
+	response, err := _NewUnitTransfer(node.AccountID()).Execute(network.Client())
+	require.NoError(t, err)
+	require.Equal(t, "0.0.3", response.NodeID.String())
+
+	receipt, err := response.GetReceipt(network.Client())
+	require.NoError(t, err)
+	require.Equal(t, hedera.StatusSuccess, receipt.Status)
+	require.Equal(t, 0, node.Pending())
+
+	transactions := network.Transactions()
+	require.Len(t, transactions, 2)
+	for _, request := range transactions {
+		require.Equal(t, "/proto.CryptoService/cryptoTransfer", request.Method)
+
+		body, err := request.TransactionBody()
+		require.NoError(t, err)
+		require.NotNil(t, body.GetCryptoTransfer())
+		require.Equal(t, int64(1800), body.GetTransactionID().GetAccountID().GetAccountNum())
+		require.Equal(t, response.TransactionID.ValidStart.Unix(), body.GetTransactionID().GetTransactionValidStart().GetSeconds())
+	}
+
+	requests := node.Requests()
+	require.Len(t, requests, 3)
+	require.NotNil(t, requests[2].Query.GetTransactionGetReceipt())
+	require.False(t, requests[2].IsCostQuery())
+}
+
+func TestUnitNetworkPlatformTransactionNotCreated(t *testing.T) {
+	network := _NewUnitNetwork(t, 3)
+	network.Node(hedera.AccountID{Account: 3}).Enqueue(Precheck(hedera.StatusPlatformTransactionNotCreated))
+	network.Node(hedera.AccountID{Account: 4}).Enqueue(Precheck(hedera.StatusOk))
+	network.Node(hedera.AccountID{Account: 5}).Enqueue(Precheck(hedera.StatusOk))
+
+	response, err := _NewUnitTransfer(hedera.AccountID{Account: 3}, hedera.AccountID{Account: 4}, hedera.AccountID{Account: 5}).
+		Execute(network.Client())
+	require.NoError(t, err)
+
+	transactions := network.Transactions()
+	require.Len(t, transactions, 2)
+	require.Equal(t, "0.0.3", transactions[0].NodeAccountID.String())
+	require.Equal(t, transactions[1].NodeAccountID.String(), response.NodeID.String())
+	require.NotEqual(t, "0.0.3", response.NodeID.String())
+}
+
+func TestUnitNetworkGRPCError(t *testing.T) {
+	network := _NewUnitNetwork(t, 3)
+	network.Node(hedera.AccountID{Account: 3}).Enqueue(GRPCError(codes.Unavailable, "node is down"))
+	network.Node(hedera.AccountID{Account: 4}).Enqueue(Precheck(hedera.StatusOk))
+	network.Node(hedera.AccountID{Account: 5}).Enqueue(Precheck(hedera.StatusOk))
+
+	response, err := _NewUnitTransfer(hedera.AccountID{Account: 3}, hedera.AccountID{Account: 4}, hedera.AccountID{Account: 5}).
+		Execute(network.Client())
+	require.NoError(t, err)
+	require.NotEqual(t, "0.0.3", response.NodeID.String())
+
+	transactions := network.Transactions()
+	require.Len(t, transactions, 2)
+	require.Equal(t, "0.0.3", transactions[0].NodeAccountID.String())
+}
+
+func TestUnitNetworkPrecheckError(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+	network.Node(hedera.AccountID{Account: 3}).Enqueue(Precheck(hedera.StatusInsufficientPayerBalance))
+
+	_, err := _NewUnitTransfer(hedera.AccountID{Account: 3}).Execute(network.Client())
+	require.Error(t, err)
+	precheckErr, ok := err.(hedera.ErrHederaPreCheckStatus)
+	require.True(t, ok)
+	require.Equal(t, hedera.StatusInsufficientPayerBalance, precheckErr.Status)
+}
+
+func TestUnitNetworkNoResponse(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+
+	_, err := _NewUnitTransfer(hedera.AccountID{Account: 3}).Execute(network.Client())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no response scripted")
+	require.Len(t, network.Requests(), 1)
+}
+
+func TestUnitNetworkQuery(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+	network.Node(hedera.AccountID{Account: 3}).Enqueue(
+		Precheck(hedera.StatusBusy),
+		func(request Request) (protobuf.Message, error) {
+			return &services.Response{
+				Response: &services.Response_CryptogetAccountBalance{
+					CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+						Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+						AccountID: request.Query.GetCryptogetAccountBalance().GetAccountID(),
+						Balance:   2000,
+					},
+				},
+			}, nil
+		},
+	)
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetNodeAccountIDs([]hedera.AccountID{{Account: 3}}).
+		SetAccountID(hedera.AccountID{Account: 1001}).
+		Execute(network.Client())
+	require.NoError(t, err)
+	require.Equal(t, int64(2000), balance.Hbars.AsTinybar())
+
+	requests := network.Requests()
+	require.Len(t, requests, 2)
+	require.Equal(t, "/proto.CryptoService/cryptoGetBalance", requests[0].Method)
+	require.Nil(t, requests[0].Transaction)
+}
+
+func TestUnitNetworkRecord(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+	network.Node(hedera.AccountID{Account: 3}).Enqueue(
+		Precheck(hedera.StatusOk),
+		Receipt(hedera.TransactionReceipt{Status: hedera.StatusSuccess}),
+		Cost(hedera.HbarFromTinybar(25)),
+		Record(hedera.TransactionRecord{
+			Receipt:         hedera.TransactionReceipt{Status: hedera.StatusSuccess},
+			TransactionMemo: "recorded",
+			TransactionFee:  hedera.HbarFromTinybar(10),
+		}),
+	)
+
+	response, err := _NewUnitTransfer(hedera.AccountID{Account: 3}).Execute(network.Client())
+	require.NoError(t, err)
+
+	record, err := response.GetRecord(network.Client())
+	require.NoError(t, err)
+	require.Equal(t, "recorded", record.TransactionMemo)
+	require.Equal(t, int64(10), record.TransactionFee.AsTinybar())
+
+	requests := network.Requests()
+	require.Len(t, requests, 4)
+	require.True(t, requests[2].IsCostQuery())
+	require.False(t, requests[3].IsCostQuery())
+
+	payment, err := requests[3].TransactionBody()
+	require.NoError(t, err)
+	for _, amount := range payment.GetCryptoTransfer().GetTransfers().GetAccountAmounts() {
+		if amount.GetAccountID().GetAccountNum() == 3 {
+			require.Equal(t, int64(25), amount.GetAmount())
+		}
+	}
+}
+
+func TestUnitNetworkResponseTypeMismatch(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+	network.Node(hedera.AccountID{Account: 3}).Enqueue(Receipt(hedera.TransactionReceipt{Status: hedera.StatusSuccess}))
+
+	_, err := _NewUnitTransfer(hedera.AccountID{Account: 3}).Execute(network.Client())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not a query")
+}
+
+func TestUnitNetworkMirrorTopicMessages(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+	topicID := hedera.TopicID{Topic: 1234}
+	network.Mirror().
+		AddTopicMessages(topicID, &mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: &services.Timestamp{Seconds: 100},
+			Message:            []byte("hello"),
+			SequenceNumber:     1,
+		}).
+		AddTopicMessages(hedera.TopicID{Topic: 5678}, &mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: &services.Timestamp{Seconds: 100},
+			Message:            []byte("other"),
+			SequenceNumber:     1,
+		})
+
+	messages := make(chan hedera.TopicMessage, 2)
+	done := make(chan struct{})
+	_, err := hedera.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		SetCompletionHandler(func() { close(done) }).
+		Subscribe(network.Client(), func(message hedera.TopicMessage) {
+			messages <- message
+		})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	require.Len(t, messages, 1)
+	message := <-messages
+	require.Equal(t, []byte("hello"), message.Contents)
+	require.Equal(t, uint64(1), message.SequenceNumber)
+
+	subscriptions := network.Mirror().Subscriptions()
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, int64(1234), subscriptions[0].GetTopicID().GetTopicNum())
+}
+
+func TestUnitNetworkMirrorAddressBook(t *testing.T) {
+	network := _NewUnitNetwork(t, 1)
+	network.Mirror().SetAddressBook(hedera.NodeAddressBook{
+		NodeAddresses: []hedera.NodeAddress{
+			{AccountID: &hedera.AccountID{Account: 3}, NodeID: 0},
+			{AccountID: &hedera.AccountID{Account: 4}, NodeID: 1},
+		},
+	})
+
+	addressBook, err := hedera.NewAddressBookQuery().
+		SetFileID(hedera.FileID{File: 102}).
+		Execute(network.Client())
+	require.NoError(t, err)
+	require.Len(t, addressBook.NodeAddresses, 2)
+	require.Equal(t, "0.0.4", addressBook.NodeAddresses[1].AccountID.String())
+}
+
+func TestUnitNetworkContractRevert(t *testing.T) {
+	// Error("not the owner")
+	revertData := "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000d6e6f7420746865206f776e657200000000000000000000000000000000000000"
+	contractID := hedera.ContractID{Contract: 1234}
+
+	network := _NewUnitNetwork(t, 1)
+	network.Node(hedera.AccountID{Account: 3}).Enqueue(
+		Precheck(hedera.StatusOk),
+		Receipt(hedera.TransactionReceipt{Status: hedera.StatusContractRevertExecuted, ContractID: &contractID}),
+		Receipt(hedera.TransactionReceipt{Status: hedera.StatusContractRevertExecuted, ContractID: &contractID}),
+		Cost(hedera.HbarFromTinybar(25)),
+		Record(hedera.TransactionRecord{
+			Receipt:    hedera.TransactionReceipt{Status: hedera.StatusContractRevertExecuted, ContractID: &contractID},
+			CallResult: &hedera.ContractFunctionResult{ContractID: &contractID, ErrorMessage: revertData},
+		}),
+		func(request Request) (protobuf.Message, error) {
+			return &services.Response{
+				Response: &services.Response_ContractCallLocal{
+					ContractCallLocal: &services.ContractCallLocalResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_CONTRACT_REVERT_EXECUTED},
+						FunctionResult: &services.ContractFunctionResult{
+							ContractID:   request.Query.GetContractCallLocal().GetContractID(),
+							ErrorMessage: revertData,
+						},
+					},
+				},
+			}, nil
+		},
+	)
+
+	response, err := hedera.NewContractExecuteTransaction().
+		SetNodeAccountIDs([]hedera.AccountID{{Account: 3}}).
+		SetContractID(contractID).
+		SetGas(100_000).
+		Execute(network.Client())
+	require.NoError(t, err)
+
+	// receipts do not carry the revert data
+	_, err = response.SetValidateStatus(true).GetReceipt(network.Client())
+	var revert hedera.ErrContractRevert
+	require.ErrorAs(t, err, &revert)
+	require.ErrorAs(t, err, &hedera.ErrHederaReceiptStatus{})
+	require.Equal(t, contractID, *revert.ContractID)
+	require.Empty(t, revert.Reason)
+
+	_, err = response.GetRecord(network.Client())
+	require.ErrorAs(t, err, &revert)
+	require.ErrorAs(t, err, &hedera.ErrHederaReceiptStatus{})
+	require.Equal(t, "not the owner", revert.Reason)
+	require.Equal(t, contractID, *revert.ContractID)
+	require.NotNil(t, revert.Result)
+
+	_, err = hedera.NewContractCallQuery().
+		SetNodeAccountIDs([]hedera.AccountID{{Account: 3}}).
+		SetContractID(contractID).
+		SetGas(100_000).
+		SetQueryPayment(hedera.HbarFromTinybar(25)).
+		Execute(network.Client())
+	require.ErrorAs(t, err, &revert)
+	require.ErrorAs(t, err, &hedera.ErrHederaPreCheckStatus{})
+	require.Equal(t, "not the owner", revert.Reason)
+	require.Equal(t, "exceptional precheck status CONTRACT_REVERT_EXECUTED: contract reverted: not the owner", err.Error())
+}
@@ -0,0 +1,241 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Node is a mock consensus node. It answers each request with the next
+// Response in its script.
+type Node struct {
+	mu        sync.Mutex
+	network   *Network
+	accountID hedera.AccountID
+	responses []Response
+	listener  net.Listener
+	server    *grpc.Server
+}
+
+var _NodeServices = []*grpc.ServiceDesc{
+	&services.CryptoService_ServiceDesc,
+	&services.FileService_ServiceDesc,
+	&services.SmartContractService_ServiceDesc,
+	&services.ConsensusService_ServiceDesc,
+	&services.TokenService_ServiceDesc,
+	&services.ScheduleService_ServiceDesc,
+	&services.FreezeService_ServiceDesc,
+	&services.NetworkService_ServiceDesc,
+	&services.UtilService_ServiceDesc,
+}
+
+func _NewNode(network *Network, accountID hedera.AccountID) (*Node, error) {
+	node := &Node{
+		network:   network,
+		accountID: accountID,
+		server:    grpc.NewServer(),
+	}
+
+	if err := _RegisterNodeServices(node.server, accountID, node._Handle); err != nil {
+		return nil, err
+	}
+
+	listener, err := _Serve(node.server)
+	if err != nil {
+		return nil, err
+	}
+
+	node.listener = listener
+	return node, nil
+}
+
+// _Serve starts server on a free loopback port.
+func _Serve(server *grpc.Server) (net.Listener, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	return listener, nil
+}
+
+// AccountID returns the node's account ID.
+func (node *Node) AccountID() hedera.AccountID {
+	return node.accountID
+}
+
+// Address returns the host:port the node is listening on.
+func (node *Node) Address() string {
+	return node.listener.Addr().String()
+}
+
+// Enqueue appends responses to the node's script. Once the script is
+// exhausted the node answers every request with an ABORTED gRPC error.
+func (node *Node) Enqueue(responses ...Response) *Node {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.responses = append(node.responses, responses...)
+	return node
+}
+
+// Pending returns the number of responses which have not been used yet.
+func (node *Node) Pending() int {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	return len(node.responses)
+}
+
+// Requests returns the requests received by this node, in the order they
+// arrived.
+func (node *Node) Requests() []Request {
+	requests := make([]Request, 0)
+	for _, request := range node.network.Requests() {
+		if request.NodeAccountID.Equals(node.accountID) {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+func (node *Node) _Next() (Response, bool) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	if len(node.responses) == 0 {
+		return nil, false
+	}
+
+	response := node.responses[0]
+	node.responses = node.responses[1:]
+	return response, true
+}
+
+func (node *Node) _Handle(request Request) (interface{}, error) {
+	node.network._Record(request)
+
+	response, ok := node._Next()
+	if !ok {
+		return nil, status.Errorf(codes.Aborted, "hederatest: node %s has no response scripted for %s", node.accountID, request.Method)
+	}
+
+	return response(request)
+}
+
+// _RegisterNodeServices registers every consensus node service on server,
+// routing each call to handle. Whether a method takes a transaction or a
+// query is read from the generated server interface.
+func _RegisterNodeServices(server *grpc.Server, accountID hedera.AccountID, handle func(Request) (interface{}, error)) error {
+	transactionType := reflect.TypeOf(&services.Transaction{})
+	queryType := reflect.TypeOf(&services.Query{})
+
+	for _, service := range _NodeServices {
+		serverType := reflect.TypeOf(service.HandlerType).Elem()
+
+		methods := make([]grpc.MethodDesc, 0, len(service.Methods))
+		for _, desc := range service.Methods {
+			method, ok := serverType.MethodByName(strings.ToUpper(desc.MethodName[:1]) + desc.MethodName[1:])
+			if !ok || method.Type.NumIn() != 2 {
+				return fmt.Errorf("hederatest: cannot find %s.%s", service.ServiceName, desc.MethodName)
+			}
+
+			var handler func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error)
+			switch method.Type.In(1) {
+			case transactionType:
+				handler = _TransactionHandler(accountID, handle)
+			case queryType:
+				handler = _QueryHandler(accountID, handle)
+			default:
+				return fmt.Errorf("hederatest: unsupported request type for %s.%s", service.ServiceName, desc.MethodName)
+			}
+
+			methods = append(methods, grpc.MethodDesc{
+				MethodName: desc.MethodName,
+				Handler:    handler,
+			})
+		}
+
+		server.RegisterService(&grpc.ServiceDesc{
+			ServiceName: service.ServiceName,
+			HandlerType: service.HandlerType,
+			Methods:     methods,
+			Streams:     []grpc.StreamDesc{},
+			Metadata:    service.Metadata,
+		}, nil)
+	}
+
+	return nil
+}
+
+func _TransactionHandler(accountID hedera.AccountID, handle func(Request) (interface{}, error)) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		transaction := new(services.Transaction)
+		if err := dec(transaction); err != nil {
+			return nil, err
+		}
+
+		method, _ := grpc.Method(ctx)
+		return handle(Request{
+			NodeAccountID: accountID,
+			Method:        method,
+			Transaction:   transaction,
+		})
+	}
+}
+
+func _QueryHandler(accountID hedera.AccountID, handle func(Request) (interface{}, error)) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		query := new(services.Query)
+		if err := dec(query); err != nil {
+			return nil, err
+		}
+
+		method, _ := grpc.Method(ctx)
+		return handle(Request{
+			NodeAccountID: accountID,
+			Method:        method,
+			Query:         query,
+		})
+	}
+}
+
+func (node *Node) _Close() {
+	if node.server != nil {
+		node.server.Stop()
+	}
+}
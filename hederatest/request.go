@@ -0,0 +1,126 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Request is a request received by a mock consensus node. Exactly one of
+// Transaction and Query is set.
+type Request struct {
+	// NodeAccountID is the account ID of the node which received the request.
+	NodeAccountID hedera.AccountID
+	// Method is the full gRPC method name, e.g. "/proto.CryptoService/cryptoTransfer".
+	Method      string
+	Transaction *services.Transaction
+	Query       *services.Query
+}
+
+// IsCostQuery returns true if the request is a query asking only for its cost.
+func (request Request) IsCostQuery() bool {
+	header := _QueryHeader(request.Query)
+	if header == nil {
+		return false
+	}
+
+	return header.ResponseType == services.ResponseType_COST_ANSWER ||
+		header.ResponseType == services.ResponseType_COST_ANSWER_STATE_PROOF
+}
+
+// SignedTransaction decodes the signed transaction of a transaction request,
+// or the payment of a query request.
+func (request Request) SignedTransaction() (*services.SignedTransaction, error) {
+	transaction := request.Transaction
+	if request.Query != nil {
+		if header := _QueryHeader(request.Query); header != nil {
+			transaction = header.Payment
+		}
+	}
+
+	if transaction == nil {
+		return nil, errors.New("hederatest: request has no transaction")
+	}
+
+	if len(transaction.SignedTransactionBytes) == 0 {
+		return &services.SignedTransaction{
+			BodyBytes: transaction.BodyBytes,
+			SigMap:    transaction.SigMap,
+		}, nil
+	}
+
+	signedTransaction := &services.SignedTransaction{}
+	if err := protobuf.Unmarshal(transaction.SignedTransactionBytes, signedTransaction); err != nil {
+		return nil, err
+	}
+
+	return signedTransaction, nil
+}
+
+// TransactionBody decodes the body of a transaction request, or of the
+// payment of a query request.
+func (request Request) TransactionBody() (*services.TransactionBody, error) {
+	signedTransaction, err := request.SignedTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	body := &services.TransactionBody{}
+	if err := protobuf.Unmarshal(signedTransaction.BodyBytes, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// _QueryField returns the message set in the query's oneof, or nil.
+func _QueryField(query *services.Query) (protoreflect.FieldDescriptor, protoreflect.Message) {
+	if query == nil {
+		return nil, nil
+	}
+
+	message := query.ProtoReflect()
+	field := message.WhichOneof(message.Descriptor().Oneofs().ByName("query"))
+	if field == nil {
+		return nil, nil
+	}
+
+	return field, message.Get(field).Message()
+}
+
+func _QueryHeader(query *services.Query) *services.QueryHeader {
+	_, message := _QueryField(query)
+	if message == nil {
+		return nil
+	}
+
+	field := message.Descriptor().Fields().ByName("header")
+	if field == nil || !message.Has(field) {
+		return nil
+	}
+
+	header, _ := message.Get(field).Message().Interface().(*services.QueryHeader)
+	return header
+}
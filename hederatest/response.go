@@ -0,0 +1,175 @@
+package hederatest
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Response produces a node's reply to a single request. A non-nil error is
+// returned to the client as the gRPC status of the call.
+//
+// Each request consumes one Response, including the cost query the SDK sends
+// before a paid query such as a record query.
+type Response func(request Request) (protobuf.Message, error)
+
+// _ResponseFieldNames maps the query oneof fields whose response field has a
+// different name.
+var _ResponseFieldNames = map[protoreflect.Name]protoreflect.Name{
+	"contractGetBytecode": "contractGetBytecodeResponse",
+	"ContractGetRecords":  "contractGetRecordsResponse",
+}
+
+// Reply answers with message as is.
+func Reply(message protobuf.Message) Response {
+	return func(Request) (protobuf.Message, error) {
+		return message, nil
+	}
+}
+
+// GRPCError fails the call with the given gRPC status, e.g. codes.Unavailable
+// to make the client try another node.
+func GRPCError(code codes.Code, message string) Response {
+	return func(Request) (protobuf.Message, error) {
+		return nil, status.Error(code, message)
+	}
+}
+
+// Precheck answers a transaction or a query of any type with the given
+// precheck status and no other content.
+func Precheck(precheck hedera.Status) Response {
+	return func(request Request) (protobuf.Message, error) {
+		if request.Transaction != nil {
+			return &services.TransactionResponse{
+				NodeTransactionPrecheckCode: services.ResponseCodeEnum(precheck),
+			}, nil
+		}
+
+		response, _, err := _QueryResponse(request, precheck, 0)
+		return response, err
+	}
+}
+
+// Cost answers a query of any type with an OK precheck status and the given
+// cost.
+func Cost(cost hedera.Hbar) Response {
+	return func(request Request) (protobuf.Message, error) {
+		response, _, err := _QueryResponse(request, hedera.StatusOk, uint64(cost.AsTinybar()))
+		return response, err
+	}
+}
+
+// Receipt answers a receipt query with an OK precheck status and receipt.
+func Receipt(receipt hedera.TransactionReceipt) Response {
+	return func(request Request) (protobuf.Message, error) {
+		response, message, err := _QueryResponse(request, hedera.StatusOk, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		pb := services.TransactionGetReceiptResponse{}
+		if err := protobuf.Unmarshal(receipt.ToBytes(), &pb); err != nil {
+			return nil, status.Errorf(codes.Internal, "hederatest: cannot encode receipt: %v", err)
+		}
+
+		if err := _SetQueryResponseField(message, "receipt", pb.Receipt); err != nil {
+			return nil, err
+		}
+
+		return response, nil
+	}
+}
+
+// Record answers a record query with an OK precheck status and record.
+func Record(record hedera.TransactionRecord) Response {
+	return func(request Request) (protobuf.Message, error) {
+		response, message, err := _QueryResponse(request, hedera.StatusOk, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		data := record.ToBytes()
+		pb := services.TransactionGetRecordResponse{}
+		if err := protobuf.Unmarshal(data, &pb); err != nil || len(data) == 0 {
+			return nil, status.Errorf(codes.Internal, "hederatest: cannot encode record: %v", err)
+		}
+
+		if err := _SetQueryResponseField(message, "transactionRecord", pb.TransactionRecord); err != nil {
+			return nil, err
+		}
+
+		return response, nil
+	}
+}
+
+// _QueryResponse builds the response matching the request's query type with
+// only the header set, and returns the inner response message as well.
+func _QueryResponse(request Request, precheck hedera.Status, cost uint64) (*services.Response, protoreflect.Message, error) {
+	field, _ := _QueryField(request.Query)
+	if field == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "hederatest: %s is not a query", request.Method)
+	}
+
+	name := field.Name()
+	if alias, ok := _ResponseFieldNames[name]; ok {
+		name = alias
+	}
+
+	response := &services.Response{}
+	responseField := response.ProtoReflect().Descriptor().Fields().ByName(name)
+	if responseField == nil {
+		return nil, nil, status.Errorf(codes.Unimplemented, "hederatest: no response type for query %s", field.Name())
+	}
+
+	responseType := services.ResponseType_ANSWER_ONLY
+	if header := _QueryHeader(request.Query); header != nil {
+		responseType = header.ResponseType
+	}
+
+	message := response.ProtoReflect().Mutable(responseField).Message()
+	if err := _SetQueryResponseField(message, "header", &services.ResponseHeader{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum(precheck),
+		ResponseType:                responseType,
+		Cost:                        cost,
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return response, message, nil
+}
+
+func _SetQueryResponseField(message protoreflect.Message, name protoreflect.Name, value protobuf.Message) error {
+	field := message.Descriptor().Fields().ByName(name)
+	if field == nil || field.Message() == nil || field.Message().FullName() != value.ProtoReflect().Descriptor().FullName() {
+		return status.Errorf(codes.InvalidArgument, "hederatest: %s has no %s field", message.Descriptor().FullName(), name)
+	}
+
+	if value.ProtoReflect().IsValid() {
+		message.Set(field, protoreflect.ValueOfMessage(value.ProtoReflect()))
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"time"
+)
+
+// RequestAttempt describes a single attempt of a transaction or query against one node.
+// The request fields are set before the interceptor chain runs, the response fields are
+// set once the request has been invoked.
+type RequestAttempt struct {
+	// The log ID of the transaction or query, the same for every attempt of one execution
+	RequestID string
+	// The node the attempt is sent to
+	NodeAccountID AccountID
+	// The address of the node the attempt is sent to
+	NodeAddress string
+	// Zero based attempt number
+	Attempt int64
+	// The marshaled services.Transaction or services.Query
+	Request []byte
+	// The marshaled services.TransactionResponse or services.Response, nil if the gRPC call failed
+	Response []byte
+	// Duration of the gRPC call
+	Latency time.Duration
+	// Either the gRPC error, or the precheck error when the node returned a non successful status.
+	// Nil when the attempt finished the execution successfully.
+	Err error
+	// Free form values interceptors can use to pass information down the chain
+	Annotations map[string]string
+}
+
+// RequestInvoker sends the attempt to the node and fills in the response fields of the attempt.
+type RequestInvoker func(ctx context.Context, attempt *RequestAttempt) error
+
+// Interceptor is called for every attempt made by a transaction or query. It must call invoke to send
+// the request, and can inspect the attempt before and after doing so. Outgoing gRPC metadata added
+// to the context passed to invoke is sent along with the request.
+// Returning an error aborts the execution and the error is returned to the caller of Execute.
+// Returning nil without calling invoke also aborts the execution, with an error saying the request was skipped.
+type Interceptor func(ctx context.Context, attempt *RequestAttempt, invoke RequestInvoker) error
+
+// AddInterceptor adds an interceptor to the client. Interceptors run in the order they were added,
+// the first one added being the outermost.
+func (client *Client) AddInterceptor(interceptor Interceptor) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
+	client.interceptors = append(client.interceptors, interceptor)
+	return client
+}
+
+// GetInterceptors returns the interceptors added to the client
+func (client *Client) GetInterceptors() []Interceptor {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	return append([]Interceptor{}, client.interceptors...)
+}
+
+func (client *Client) _InterceptorChain(invoker RequestInvoker) RequestInvoker {
+	interceptors := client.GetInterceptors()
+
+	chain := invoker
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor := interceptors[i]
+		next := chain
+		chain = func(ctx context.Context, attempt *RequestAttempt) error {
+			return interceptor(ctx, attempt, next)
+		}
+	}
+
+	return chain
+}
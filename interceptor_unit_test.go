@@ -0,0 +1,145 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestUnitInterceptorSeesEveryAttempt(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	order := make([]string, 0)
+	attempts := make([]RequestAttempt, 0)
+	client.AddInterceptor(func(ctx context.Context, attempt *RequestAttempt, invoke RequestInvoker) error {
+		order = append(order, "outer")
+		attempt.Annotations["outer"] = "seen"
+		err := invoke(ctx, attempt)
+		attempts = append(attempts, *attempt)
+		return err
+	})
+	client.AddInterceptor(func(ctx context.Context, attempt *RequestAttempt, invoke RequestInvoker) error {
+		order = append(order, "inner")
+		require.Equal(t, "seen", attempt.Annotations["outer"])
+		return invoke(ctx, attempt)
+	})
+	require.Len(t, client.GetInterceptors(), 2)
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"outer", "inner", "outer", "inner"}, order)
+	require.Len(t, attempts, 2)
+
+	require.Equal(t, int64(0), attempts[0].Attempt)
+	require.Equal(t, AccountID{Account: 3}, attempts[0].NodeAccountID)
+	require.IsType(t, ErrHederaPreCheckStatus{}, attempts[0].Err)
+	require.Equal(t, StatusBusy, attempts[0].Err.(ErrHederaPreCheckStatus).Status)
+
+	require.Equal(t, int64(1), attempts[1].Attempt)
+	require.NoError(t, attempts[1].Err)
+	require.Positive(t, attempts[1].Latency)
+
+	var request services.Transaction
+	require.NoError(t, protobuf.Unmarshal(attempts[1].Request, &request))
+	require.NotEmpty(t, request.SignedTransactionBytes)
+
+	var response services.TransactionResponse
+	require.NoError(t, protobuf.Unmarshal(attempts[1].Response, &response))
+	require.Equal(t, services.ResponseCodeEnum_OK, response.NodeTransactionPrecheckCode)
+}
+
+func TestUnitInterceptorAbort(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	errPolicy := errors.New("transfer not allowed by policy")
+	invoked := false
+	client.AddInterceptor(func(ctx context.Context, attempt *RequestAttempt, invoke RequestInvoker) error {
+		return errPolicy
+	})
+	client.AddInterceptor(func(ctx context.Context, attempt *RequestAttempt, invoke RequestInvoker) error {
+		invoked = true
+		return invoke(ctx, attempt)
+	})
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.ErrorIs(t, err, errPolicy)
+	require.False(t, invoked)
+}
+
+func TestUnitInterceptorSkip(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	calls := 0
+	client.AddInterceptor(func(ctx context.Context, attempt *RequestAttempt, invoke RequestInvoker) error {
+		calls++
+		return nil
+	})
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.ErrorIs(t, err, errInterceptorSkippedRequest)
+	require.Equal(t, 1, calls)
+}
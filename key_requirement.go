@@ -0,0 +1,358 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// _KeyRequirementMaxExactKeys bounds the number of unavailable keys for which MissingKeys is searched exhaustively
+const _KeyRequirementMaxExactKeys = 16
+
+// KeyRequirement explains whether a Key is satisfied by a set of signing keys. A key list is satisfied once its
+// threshold of children is, or every child when it has no threshold, so the requirement forms a tree mirroring the key.
+type KeyRequirement struct {
+	Key Key
+	// Satisfied is true when the signing keys satisfy Key.
+	Satisfied bool
+	// Satisfiable is false when no set of signatures can satisfy Key, as with contract keys or empty key lists.
+	Satisfiable bool
+	// Threshold is the number of Children which need to be satisfied; it is only set for key lists.
+	Threshold int
+	Children  []KeyRequirement
+	// MissingKeys is a smallest set of additional public keys which would satisfy Key. It is exact when at most 16
+	// distinct keys of Key are unavailable; beyond that it is picked greedily level by level and may hold more keys
+	// than needed. It is empty when Key is already satisfied or cannot be satisfied.
+	MissingKeys []PublicKey
+}
+
+// EvaluateKeyRequirement evaluates whether signatures of the available public keys would satisfy key.
+func EvaluateKeyRequirement(key Key, availableKeys []PublicKey) KeyRequirement {
+	return _EvaluateKeyRequirement(key, availableKeys)
+}
+
+// EvaluateKeyRequirementForTransaction evaluates whether the signatures on a frozen transaction, passed as a pointer as
+// returned by its constructor, satisfy key. Only signatures present and valid for every node and chunk of the
+// transaction count, along with the keys of signers, such as the operator, which sign when the transaction is executed.
+func EvaluateKeyRequirementForTransaction(key Key, transaction interface{}) (KeyRequirement, error) {
+	base, ok := transaction.(interface{ _GetTransaction() *Transaction })
+	if !ok {
+		return KeyRequirement{}, errKeyRequirementUnsupportedTransaction
+	}
+
+	tx := base._GetTransaction()
+	if !tx._IsFrozen() {
+		return KeyRequirement{}, errTransactionIsNotFrozen
+	}
+
+	availableKeys := make([]PublicKey, 0)
+	for i, publicKey := range tx.publicKeys {
+		if tx.transactionSigners[i] != nil {
+			availableKeys = append(availableKeys, publicKey)
+		}
+	}
+
+	first := tx.signedTransactions._Get(0).(*services.SignedTransaction)
+	for _, sigPair := range first.GetSigMap().GetSigPair() {
+		publicKey, err := PublicKeyFromBytes(sigPair.PubKeyPrefix)
+		if err != nil {
+			continue
+		}
+
+		signedAll := true
+		for _, value := range tx.signedTransactions.slice {
+			if !_KeyRequirementSigned(value.(*services.SignedTransaction), publicKey) {
+				signedAll = false
+				break
+			}
+		}
+
+		if signedAll {
+			availableKeys = append(availableKeys, publicKey)
+		}
+	}
+
+	return _EvaluateKeyRequirement(key, availableKeys), nil
+}
+
+func _EvaluateKeyRequirement(key Key, availableKeys []PublicKey) KeyRequirement {
+	switch k := key.(type) {
+	case PublicKey:
+		return _EvaluatePublicKeyRequirement(k, availableKeys)
+	case *PublicKey:
+		if k != nil {
+			requirement := _EvaluatePublicKeyRequirement(*k, availableKeys)
+			requirement.Key = key
+			return requirement
+		}
+	case *KeyList:
+		if k != nil {
+			return _EvaluateKeyListRequirement(k, availableKeys)
+		}
+	}
+
+	// Contract keys are satisfied by the contract calling, never by signatures
+	return KeyRequirement{Key: key}
+}
+
+func _EvaluatePublicKeyRequirement(publicKey PublicKey, availableKeys []PublicKey) KeyRequirement {
+	requirement := KeyRequirement{
+		Key:         publicKey,
+		Satisfiable: true,
+	}
+
+	if _KeyRequirementContains(availableKeys, publicKey) {
+		requirement.Satisfied = true
+	} else {
+		requirement.MissingKeys = []PublicKey{publicKey}
+	}
+
+	return requirement
+}
+
+func _EvaluateKeyListRequirement(keyList *KeyList, availableKeys []PublicKey) KeyRequirement {
+	requirement := KeyRequirement{
+		Key:       keyList,
+		Threshold: len(keyList.keys),
+		Children:  make([]KeyRequirement, 0, len(keyList.keys)),
+	}
+	if keyList.threshold > 0 {
+		requirement.Threshold = keyList.threshold
+	}
+
+	satisfied := 0
+	pending := make([]KeyRequirement, 0, len(keyList.keys))
+	for _, key := range keyList.keys {
+		child := _EvaluateKeyRequirement(key, availableKeys)
+		requirement.Children = append(requirement.Children, child)
+
+		if child.Satisfied {
+			satisfied++
+		} else if child.Satisfiable {
+			pending = append(pending, child)
+		}
+	}
+
+	if len(keyList.keys) == 0 || satisfied+len(pending) < requirement.Threshold {
+		return requirement
+	}
+
+	requirement.Satisfiable = true
+	if satisfied >= requirement.Threshold {
+		requirement.Satisfied = true
+		return requirement
+	}
+
+	// Pick the children adding the fewest keys not already picked, which is optimal when no key is shared by
+	// several children, then look for a smaller set when there are few enough keys to try them all
+	missing := make([]PublicKey, 0)
+	for needed := requirement.Threshold - satisfied; needed > 0; needed-- {
+		best, bestAdded := 0, -1
+		for i, child := range pending {
+			added := 0
+			for _, publicKey := range child.MissingKeys {
+				if !_KeyRequirementContains(missing, publicKey) {
+					added++
+				}
+			}
+
+			if bestAdded < 0 || added < bestAdded {
+				best, bestAdded = i, added
+			}
+		}
+
+		for _, publicKey := range pending[best].MissingKeys {
+			if !_KeyRequirementContains(missing, publicKey) {
+				missing = append(missing, publicKey)
+			}
+		}
+		pending = append(pending[:best], pending[best+1:]...)
+	}
+
+	candidates := _KeyRequirementUnavailableKeys(keyList, availableKeys, nil)
+	if len(candidates) <= _KeyRequirementMaxExactKeys {
+		if smaller := _KeyRequirementSmallestMissingKeys(keyList, availableKeys, candidates, len(missing)-1); smaller != nil {
+			missing = smaller
+		}
+	}
+
+	requirement.MissingKeys = missing
+
+	return requirement
+}
+
+// _KeyRequirementSmallestMissingKeys returns the smallest subset of candidates, of at most maxSize keys, which
+// satisfies key together with the available keys, nil if there is none. Subsets are tried by increasing size.
+func _KeyRequirementSmallestMissingKeys(key Key, availableKeys []PublicKey, candidates []PublicKey, maxSize int) []PublicKey {
+	for size := 1; size <= maxSize && size <= len(candidates); size++ {
+		indexes := make([]int, size)
+		for i := range indexes {
+			indexes[i] = i
+		}
+
+		for {
+			keys := append(make([]PublicKey, 0, len(availableKeys)+size), availableKeys...)
+			for _, index := range indexes {
+				keys = append(keys, candidates[index])
+			}
+			if _KeyRequirementSatisfied(key, keys) {
+				return keys[len(availableKeys):]
+			}
+
+			// advance to the next combination in lexicographic order
+			i := size - 1
+			for i >= 0 && indexes[i] == len(candidates)-size+i {
+				i--
+			}
+			if i < 0 {
+				break
+			}
+			indexes[i]++
+			for j := i + 1; j < size; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+
+	return nil
+}
+
+// _KeyRequirementUnavailableKeys appends the distinct public keys of key which are not available to keys.
+func _KeyRequirementUnavailableKeys(key Key, availableKeys []PublicKey, keys []PublicKey) []PublicKey {
+	switch k := key.(type) {
+	case PublicKey:
+		if !_KeyRequirementContains(availableKeys, k) && !_KeyRequirementContains(keys, k) {
+			keys = append(keys, k)
+		}
+	case *PublicKey:
+		if k != nil {
+			return _KeyRequirementUnavailableKeys(*k, availableKeys, keys)
+		}
+	case *KeyList:
+		if k != nil {
+			for _, child := range k.keys {
+				keys = _KeyRequirementUnavailableKeys(child, availableKeys, keys)
+			}
+		}
+	}
+
+	return keys
+}
+
+func _KeyRequirementSatisfied(key Key, availableKeys []PublicKey) bool {
+	switch k := key.(type) {
+	case PublicKey:
+		return _KeyRequirementContains(availableKeys, k)
+	case *PublicKey:
+		return k != nil && _KeyRequirementContains(availableKeys, *k)
+	case *KeyList:
+		if k == nil || len(k.keys) == 0 {
+			return false
+		}
+
+		threshold := len(k.keys)
+		if k.threshold > 0 {
+			threshold = k.threshold
+		}
+
+		satisfied := 0
+		for _, child := range k.keys {
+			if _KeyRequirementSatisfied(child, availableKeys) {
+				satisfied++
+			}
+		}
+
+		return satisfied >= threshold
+	}
+
+	return false
+}
+
+// String explains the requirement as an indented tree, one line per key.
+func (requirement KeyRequirement) String() string {
+	var builder strings.Builder
+	requirement._Write(&builder, 0)
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func (requirement KeyRequirement) _Write(builder *strings.Builder, depth int) {
+	mark := "[ ]"
+	if requirement.Satisfied {
+		mark = "[x]"
+	}
+
+	builder.WriteString(strings.Repeat("    ", depth))
+	builder.WriteString(mark)
+	builder.WriteString(" ")
+
+	if _, ok := requirement.Key.(*KeyList); ok {
+		satisfied := 0
+		for _, child := range requirement.Children {
+			if child.Satisfied {
+				satisfied++
+			}
+		}
+
+		builder.WriteString(fmt.Sprintf("%d of %d keys, %d satisfied", requirement.Threshold, len(requirement.Children), satisfied))
+	} else if requirement.Key != nil {
+		builder.WriteString(requirement.Key.String())
+	}
+
+	switch {
+	case requirement.Satisfied:
+	case !requirement.Satisfiable:
+		builder.WriteString(": cannot be satisfied by signatures")
+	case len(requirement.MissingKeys) == 1:
+		builder.WriteString(": needs 1 more key")
+	default:
+		builder.WriteString(fmt.Sprintf(": needs %d more keys", len(requirement.MissingKeys)))
+	}
+	builder.WriteString("\n")
+
+	for _, child := range requirement.Children {
+		child._Write(builder, depth+1)
+	}
+}
+
+func _KeyRequirementContains(keys []PublicKey, publicKey PublicKey) bool {
+	for _, key := range keys {
+		if bytes.Equal(key.BytesRaw(), publicKey.BytesRaw()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _KeyRequirementSigned(signedTransaction *services.SignedTransaction, publicKey PublicKey) bool {
+	keyBytes := publicKey.BytesRaw()
+	for _, sigPair := range signedTransaction.GetSigMap().GetSigPair() {
+		if bytes.Equal(sigPair.PubKeyPrefix, keyBytes) {
+			return _PublicKeyVerifyBody(publicKey, signedTransaction.BodyBytes, _SignaturePairSignature(sigPair))
+		}
+	}
+
+	return false
+}
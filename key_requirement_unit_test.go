@@ -0,0 +1,160 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _GenerateUnitKeyRequirementKeys(t *testing.T, count int) []PublicKey {
+	keys := make([]PublicKey, 0, count)
+	for i := 0; i < count; i++ {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys = append(keys, key.PublicKey())
+	}
+
+	return keys
+}
+
+func TestUnitKeyRequirementPublicKey(t *testing.T) {
+	keys := _GenerateUnitKeyRequirementKeys(t, 2)
+
+	requirement := EvaluateKeyRequirement(keys[0], []PublicKey{keys[1], keys[0]})
+	assert.True(t, requirement.Satisfied)
+	assert.Empty(t, requirement.MissingKeys)
+
+	requirement = EvaluateKeyRequirement(keys[0], []PublicKey{keys[1]})
+	assert.False(t, requirement.Satisfied)
+	assert.True(t, requirement.Satisfiable)
+	assert.Equal(t, []PublicKey{keys[0]}, requirement.MissingKeys)
+}
+
+func TestUnitKeyRequirementNested(t *testing.T) {
+	keys := _GenerateUnitKeyRequirementKeys(t, 6)
+
+	// 2 of [k0, [k1 and k2], 1 of [k3, k4], contract]
+	all := NewKeyList().Add(keys[1]).Add(keys[2])
+	either := KeyListWithThreshold(1).Add(keys[3]).Add(keys[4])
+	key := KeyListWithThreshold(2).Add(keys[0]).Add(all).Add(either).Add(ContractID{Contract: 5})
+
+	requirement := EvaluateKeyRequirement(key, []PublicKey{keys[1], keys[5]})
+	assert.False(t, requirement.Satisfied)
+	assert.True(t, requirement.Satisfiable)
+	assert.Equal(t, 2, requirement.Threshold)
+	require.Len(t, requirement.Children, 4)
+	assert.Equal(t, []PublicKey{keys[2]}, requirement.Children[1].MissingKeys)
+	assert.False(t, requirement.Children[3].Satisfiable)
+	assert.Len(t, requirement.MissingKeys, 2)
+	assert.Contains(t, requirement.MissingKeys, keys[0])
+
+	requirement = EvaluateKeyRequirement(key, []PublicKey{keys[0], keys[4]})
+	assert.True(t, requirement.Satisfied)
+	assert.Empty(t, requirement.MissingKeys)
+	assert.True(t, requirement.Children[2].Satisfied)
+	assert.False(t, requirement.Children[1].Satisfied)
+
+	tree := requirement.String()
+	assert.Contains(t, tree, "[x] 2 of 4 keys, 2 satisfied")
+	assert.Contains(t, tree, "    [ ] 2 of 2 keys, 0 satisfied: needs 2 more keys")
+	assert.Contains(t, tree, "    [ ] 0.0.5: cannot be satisfied by signatures")
+	assert.Contains(t, tree, "        [x] "+keys[4].String())
+}
+
+func TestUnitKeyRequirementSharedKeys(t *testing.T) {
+	keys := _GenerateUnitKeyRequirementKeys(t, 4)
+
+	// Either k0 and k1, or k2 and k3; with k1 available the first pair only needs k0
+	key := KeyListWithThreshold(1).
+		Add(NewKeyList().Add(keys[2]).Add(keys[3])).
+		Add(NewKeyList().Add(keys[0]).Add(keys[1]))
+
+	requirement := EvaluateKeyRequirement(key, []PublicKey{keys[1]})
+	assert.Equal(t, []PublicKey{keys[0]}, requirement.MissingKeys)
+
+	// 2 of [s0 and s1, s2 and s3 and s4, s2 and s3 and s5]: picking the cheapest child first needs 5 keys, while
+	// the last two children share s2 and s3 and need only 4
+	shared := _GenerateUnitKeyRequirementKeys(t, 6)
+	key = KeyListWithThreshold(2).
+		Add(NewKeyList().Add(shared[0]).Add(shared[1])).
+		Add(NewKeyList().Add(shared[2]).Add(shared[3]).Add(shared[4])).
+		Add(NewKeyList().Add(shared[2]).Add(shared[3]).Add(shared[5]))
+
+	requirement = EvaluateKeyRequirement(key, nil)
+	assert.ElementsMatch(t, shared[2:], requirement.MissingKeys)
+	assert.True(t, EvaluateKeyRequirement(key, requirement.MissingKeys).Satisfied)
+
+	unsatisfiable := EvaluateKeyRequirement(KeyListWithThreshold(2).Add(keys[0]).Add(ContractID{Contract: 1}), keys)
+	assert.False(t, unsatisfiable.Satisfiable)
+	assert.False(t, unsatisfiable.Satisfied)
+	assert.Empty(t, unsatisfiable.MissingKeys)
+
+	empty := EvaluateKeyRequirement(NewKeyList(), keys)
+	assert.False(t, empty.Satisfiable)
+}
+
+func TestUnitKeyRequirementForTransaction(t *testing.T) {
+	first, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	second, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	third, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	key := KeyListWithThreshold(2).Add(first.PublicKey()).Add(second.PublicKey()).Add(third.PublicKey())
+
+	transfer := _NewUnitSigningSessionTransfer(t, []AccountID{{Account: 3}, {Account: 4}})
+
+	requirement, err := EvaluateKeyRequirementForTransaction(key, transfer)
+	require.NoError(t, err)
+	assert.False(t, requirement.Satisfied)
+	assert.Len(t, requirement.MissingKeys, 2)
+
+	// Signers count before the signatures are built
+	transfer.Sign(first)
+	requirement, err = EvaluateKeyRequirementForTransaction(key, transfer)
+	require.NoError(t, err)
+	assert.False(t, requirement.Satisfied)
+	assert.Len(t, requirement.MissingKeys, 1)
+
+	session, err := NewSigningSession(transfer, first.PublicKey(), second.PublicKey())
+	require.NoError(t, err)
+	require.NoError(t, session.Sign(second))
+	signed, err := session.GetTransaction()
+	require.NoError(t, err)
+	signedTransfer := signed.(TransferTransaction)
+
+	requirement, err = EvaluateKeyRequirementForTransaction(key, &signedTransfer)
+	require.NoError(t, err)
+	assert.True(t, requirement.Satisfied)
+	assert.True(t, requirement.Children[1].Satisfied)
+	assert.False(t, requirement.Children[2].Satisfied)
+
+	_, err = EvaluateKeyRequirementForTransaction(key, NewTransferTransaction())
+	assert.ErrorIs(t, err, errTransactionIsNotFrozen)
+	_, err = EvaluateKeyRequirementForTransaction(key, signedTransfer)
+	assert.ErrorIs(t, err, errKeyRequirementUnsupportedTransaction)
+}
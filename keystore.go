@@ -30,14 +30,17 @@ import (
 	"crypto/subtle"
 	"encoding/hex"
 	"encoding/json"
-
+	"errors"
 	"io"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"golang.org/x/crypto/pbkdf2"
 )
 
 type _Keystore struct {
-	Version uint8       `json:"version"`
+	Version uint8 `json:"version"`
+	// KeyType is only present from version 2 on, version 1 keystores always hold an Ed25519 key
+	KeyType string      `json:"keyType,omitempty"`
 	Crypto  _CryptoData `json:"crypto"`
 }
 
@@ -74,6 +77,9 @@ type _CryptoData struct {
 	Mac string `json:"mac"`
 }
 
+const _KeystoreKeyTypeEd25519 = "ed25519"
+const _KeystoreKeyTypeECDSA = "ecdsa-secp256k1"
+
 const Aes128Ctr = "aes-128-ctr"
 const HmacSha256 = "hmac-sha256"
 
@@ -94,6 +100,12 @@ func _RandomBytes(n uint) ([]byte, error) {
 }
 
 func _NewKeystore(privateKey []byte, passphrase string) ([]byte, error) {
+	return _NewKeystoreForKeyType(privateKey, _KeystoreKeyTypeEd25519, passphrase)
+}
+
+// _NewKeystoreForKeyType writes Ed25519 keys as version 1 keystores, so older SDKs can still read
+// them, and other key types as version 2 keystores which older SDKs reject instead of misreading.
+func _NewKeystoreForKeyType(privateKey []byte, keyType string, passphrase string) ([]byte, error) {
 	salt, err := _RandomBytes(saltLen)
 	if err != nil {
 		return nil, err
@@ -143,6 +155,11 @@ func _NewKeystore(privateKey []byte, passphrase string) ([]byte, error) {
 		},
 	}
 
+	if keyType != _KeystoreKeyTypeEd25519 {
+		keystore.Version = 2
+		keystore.KeyType = keyType
+	}
+
 	return json.Marshal(keystore)
 }
 
@@ -155,8 +172,14 @@ func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error)
 		return PrivateKey{}, err
 	}
 
-	if keyStore.Version != 1 {
-		// todo: change to a switch and handle differently if future _Keystore versions are added
+	keyType := _KeystoreKeyTypeEd25519
+	switch keyStore.Version {
+	case 1:
+	case 2:
+		keyType = keyStore.KeyType
+	case 3:
+		return _ParseEthereumKeystore(keystoreBytes, passphrase)
+	default:
 		return PrivateKey{}, _NewErrBadKeyf("unsupported _Keystore version: %v", keyStore.Version)
 	}
 
@@ -192,6 +215,10 @@ func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error)
 		return PrivateKey{}, err
 	}
 
+	if err := _CheckPbkdf2Parameters(keyStore.Crypto.KDFParams.Count, dkLen); err != nil {
+		return PrivateKey{}, err
+	}
+
 	key := pbkdf2.Key([]byte(passphrase), salt, keyStore.Crypto.KDFParams.Count, dkLen, sha256.New)
 
 	mac, err := hex.DecodeString(keyStore.Crypto.Mac)
@@ -224,5 +251,76 @@ func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error)
 
 	decipher.XORKeyStream(pkBytes, cipherBytes)
 
-	return PrivateKeyFromBytesEd25519(pkBytes)
+	switch keyType {
+	case _KeystoreKeyTypeEd25519:
+		return PrivateKeyFromBytesEd25519(pkBytes)
+	case _KeystoreKeyTypeECDSA:
+		return PrivateKeyFromBytesECDSA(pkBytes)
+	}
+
+	return PrivateKey{}, _NewErrBadKeyf("unsupported _Keystore key type: %v", keyType)
+}
+
+// _ParseEthereumKeystore reads an Ethereum V3 keystore, as written by geth and most wallets, which
+// always holds an ECDSA secp256k1 key and uses scrypt or pbkdf2 for key derivation.
+func _ParseEthereumKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error) {
+	if err := _CheckEthereumKeystoreKdf(keystoreBytes); err != nil {
+		return PrivateKey{}, err
+	}
+
+	key, err := keystore.DecryptKey(keystoreBytes, passphrase)
+	if errors.Is(err, keystore.ErrDecrypt) {
+		return PrivateKey{}, _NewErrBadKeyf("mac mismatch; passphrase is incorrect")
+	}
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return PrivateKey{
+		ecdsaPrivateKey: &_ECDSAPrivateKey{
+			keyData: key.PrivateKey,
+		},
+	}, nil
+}
+
+// _CheckEthereumKeystoreKdf validates the kdfparams of an Ethereum keystore before it is handed to geth,
+// which reads them unchecked and panics when one is missing or not a number.
+func _CheckEthereumKeystoreKdf(keystoreBytes []byte) error {
+	var ks struct {
+		Crypto struct {
+			KDF       string `json:"kdf"`
+			KDFParams struct {
+				N     *int    `json:"n"`
+				R     *int    `json:"r"`
+				P     *int    `json:"p"`
+				C     *int    `json:"c"`
+				DKLen *int    `json:"dklen"`
+				Salt  *string `json:"salt"`
+				PRF   *string `json:"prf"`
+			} `json:"kdfparams"`
+		} `json:"crypto"`
+	}
+	if err := json.Unmarshal(keystoreBytes, &ks); err != nil {
+		return _NewErrBadKeyf("invalid Ethereum keystore kdfparams: %v", err)
+	}
+
+	params := ks.Crypto.KDFParams
+	if params.DKLen == nil || params.Salt == nil {
+		return _NewErrBadKeyf("Ethereum keystore kdfparams are missing dklen or salt")
+	}
+
+	switch ks.Crypto.KDF {
+	case "scrypt":
+		if params.N == nil || params.R == nil || params.P == nil {
+			return _NewErrBadKeyf("Ethereum keystore scrypt kdfparams are missing n, r or p")
+		}
+		return _CheckScryptParameters(*params.N, *params.R, *params.P, *params.DKLen)
+	case "pbkdf2":
+		if params.C == nil || params.PRF == nil {
+			return _NewErrBadKeyf("Ethereum keystore pbkdf2 kdfparams are missing c or prf")
+		}
+		return _CheckPbkdf2Parameters(*params.C, *params.DKLen)
+	}
+
+	return _NewErrBadKeyf("unsupported KDF: %v", ks.Crypto.KDF)
 }
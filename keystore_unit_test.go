@@ -24,6 +24,7 @@ package hedera
  */
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -60,3 +61,64 @@ func TestUnitEncryptAndDecryptKeyStore(t *testing.T) {
 
 	assert.Equal(t, privateKey.ed25519PrivateKey.keyData, ksPrivateKey.ed25519PrivateKey.keyData)
 }
+
+// Ethereum V3 keystores from the Web3 Secret Storage definition
+const testEthereumScryptKeystore string = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"p":8,"r":1,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+const testEthereumPbkdf2Keystore string = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+const testEthereumKeystorePassphrase string = "testpassword"
+const testEthereumKeystoreKeyString string = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+
+func TestUnitEncryptAndDecryptKeyStoreECDSA(t *testing.T) {
+	privateKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	keyStore, err := privateKey.Keystore(passphrase)
+	require.NoError(t, err)
+	assert.Contains(t, string(keyStore), `"version":2`)
+	assert.Contains(t, string(keyStore), `"keyType":"ecdsa-secp256k1"`)
+
+	ksPrivateKey, err := PrivateKeyFromKeystore(keyStore, passphrase)
+	require.NoError(t, err)
+	require.NotNil(t, ksPrivateKey.ecdsaPrivateKey)
+	assert.Equal(t, privateKey.StringRaw(), ksPrivateKey.StringRaw())
+
+	_, err = PrivateKeyFromKeystore(keyStore, "wrong passphrase")
+	assert.Error(t, err)
+}
+
+func TestUnitEd25519KeyStoreStaysVersion1(t *testing.T) {
+	privateKey, err := PrivateKeyFromString(testPrivateKeyStr)
+	require.NoError(t, err)
+
+	keyStore, err := privateKey.Keystore(passphrase)
+	require.NoError(t, err)
+	assert.Contains(t, string(keyStore), `"version":1`)
+	assert.NotContains(t, string(keyStore), "keyType")
+}
+
+func TestUnitDecryptEthereumKeyStore(t *testing.T) {
+	for name, keystore := range map[string]string{"scrypt": testEthereumScryptKeystore, "pbkdf2": testEthereumPbkdf2Keystore} {
+		privateKey, err := PrivateKeyFromKeystore([]byte(keystore), testEthereumKeystorePassphrase)
+		require.NoError(t, err, name)
+		require.NotNil(t, privateKey.ecdsaPrivateKey, name)
+		assert.Equal(t, testEthereumKeystoreKeyString, privateKey.StringRaw(), name)
+
+		_, err = PrivateKeyFromKeystore([]byte(keystore), passphrase)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestUnitKeyStoreKdfBounds(t *testing.T) {
+	for name, keystore := range map[string]string{
+		"sdk pbkdf2":        strings.Replace(testKeystore, `"c":262144`, `"c":2147483647`, 1),
+		"ethereum scrypt":   strings.Replace(testEthereumScryptKeystore, `"n":262144`, `"n":1073741824`, 1),
+		"ethereum pbkdf2":   strings.Replace(testEthereumPbkdf2Keystore, `"c":262144`, `"c":2147483647`, 1),
+		"ethereum parallel": strings.Replace(testEthereumScryptKeystore, `"p":8`, `"p":1048576`, 1),
+		"ethereum dklen":    strings.Replace(testEthereumScryptKeystore, `"dklen":32`, `"dklen":1073741824`, 1),
+		"ethereum missing":  strings.Replace(testEthereumScryptKeystore, `"n":262144,`, ``, 1),
+	} {
+		_, err := PrivateKeyFromKeystore([]byte(keystore), testEthereumKeystorePassphrase)
+		require.Error(t, err, name)
+		assert.IsType(t, ErrBadKey{}, err, name)
+	}
+}
@@ -140,3 +140,17 @@ func (id *LedgerID) ToNetworkName() (NetworkName, error) {
 		return NetworkNameOther, nil
 	}
 }
+
+// ToChainID returns the EVM chain ID of the ledger, as used by Ethereum transactions and EIP-155 signatures
+func (id *LedgerID) ToChainID() (uint64, error) {
+	switch hex.EncodeToString(id._LedgerIDBytes) {
+	case "00":
+		return 295, nil
+	case "01":
+		return 296, nil
+	case "02":
+		return 297, nil
+	default:
+		return 0, errors.New("no chain ID is known for ledger " + id.String())
+	}
+}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -72,7 +73,7 @@ func (query *LiveHashQuery) GetGetHash() []byte {
 }
 
 func (query *LiveHashQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -103,7 +104,13 @@ func (query *LiveHashQuery) _Build() *services.Query_CryptoGetLiveHash {
 }
 
 func (query *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *LiveHashQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -115,7 +122,7 @@ func (query *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -130,6 +137,7 @@ func (query *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_LiveHashQueryShouldRetry,
@@ -154,8 +162,8 @@ func (query *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _LiveHashQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetLiveHash().Header.NodeTransactionPrecheckCode))
+func _LiveHashQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetCryptoGetLiveHash().Header.NodeTransactionPrecheckCode))
 }
 
 func _LiveHashQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -171,7 +179,13 @@ func _LiveHashQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the LiveHashQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *LiveHashQuery) ExecuteWithContext(ctx context.Context, client *Client) (LiveHash, error) {
+	if client == nil || client._GetOperator() == nil {
 		return LiveHash{}, errNoClientProvided
 	}
 
@@ -183,7 +197,7 @@ func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -196,7 +210,7 @@ func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return LiveHash{}, err
 		}
@@ -215,12 +229,12 @@ func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return LiveHash{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return LiveHash{}, err
 		}
@@ -234,6 +248,7 @@ func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_LiveHashQueryShouldRetry,
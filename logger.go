@@ -0,0 +1,217 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+type LogLevel string
+
+const (
+	LoggerLevelTrace    LogLevel = "TRACE"
+	LoggerLevelDebug    LogLevel = "DEBUG"
+	LoggerLevelInfo     LogLevel = "INFO"
+	LoggerLevelWarn     LogLevel = "WARN"
+	LoggerLevelError    LogLevel = "ERROR"
+	LoggerLevelDisabled LogLevel = "DISABLED"
+)
+
+// Logger is what the SDK writes all of its output through. Fields are given as alternating
+// keys and values, With returns a logger which adds the given fields to every entry.
+// Entries written on behalf of a transaction or query carry its log ID under the "requestId" key.
+type Logger interface {
+	SetLevel(level LogLevel)
+	GetLevel() LogLevel
+	With(keysAndValues ...interface{}) Logger
+	Trace(message string, keysAndValues ...interface{})
+	Debug(message string, keysAndValues ...interface{})
+	Info(message string, keysAndValues ...interface{})
+	Warn(message string, keysAndValues ...interface{})
+	Error(message string, keysAndValues ...interface{})
+}
+
+// DefaultLogger is the zerolog backed Logger used by clients which have not been given one.
+// It is safe for concurrent use.
+type DefaultLogger struct {
+	mu     sync.RWMutex
+	logger zerolog.Logger
+	level  LogLevel
+}
+
+// NewLogger returns a DefaultLogger writing JSON entries to stderr
+func NewLogger(component string, level LogLevel) *DefaultLogger {
+	return NewLoggerWithWriter(component, level, os.Stderr)
+}
+
+// NewLoggerWithWriter returns a DefaultLogger writing JSON entries to the given writer
+func NewLoggerWithWriter(component string, level LogLevel, writer io.Writer) *DefaultLogger {
+	logger := &DefaultLogger{
+		logger: zerolog.New(writer).With().Timestamp().Str("module", component).Logger(),
+	}
+	logger.SetLevel(level)
+
+	return logger
+}
+
+// LogLevelFromString parses a level name such as "DEBUG", falling back to LoggerLevelDisabled
+func LogLevelFromString(level string) LogLevel {
+	switch LogLevel(strings.ToUpper(level)) {
+	case LoggerLevelTrace:
+		return LoggerLevelTrace
+	case LoggerLevelDebug:
+		return LoggerLevelDebug
+	case LoggerLevelInfo:
+		return LoggerLevelInfo
+	case LoggerLevelWarn:
+		return LoggerLevelWarn
+	case LoggerLevelError:
+		return LoggerLevelError
+	default:
+		return LoggerLevelDisabled
+	}
+}
+
+// _NewDefaultLogger honours the HEDERA_SDK_GO_LOG_LEVEL and HEDERA_SDK_GO_LOG_PRETTY environment
+// variables, so output stays the same for users who configured logging that way.
+func _NewDefaultLogger() *DefaultLogger {
+	var writer io.Writer = os.Stderr
+	if os.Getenv("HEDERA_SDK_GO_LOG_PRETTY") != "" {
+		writer = zerolog.ConsoleWriter{Out: os.Stderr}
+	}
+
+	return NewLoggerWithWriter("hedera-sdk-go", LogLevelFromString(os.Getenv("HEDERA_SDK_GO_LOG_LEVEL")), writer)
+}
+
+func (logger *DefaultLogger) SetLevel(level LogLevel) {
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+
+	logger.level = level
+
+	switch level {
+	case LoggerLevelTrace:
+		logger.logger = logger.logger.Level(zerolog.TraceLevel)
+	case LoggerLevelDebug:
+		logger.logger = logger.logger.Level(zerolog.DebugLevel)
+	case LoggerLevelInfo:
+		logger.logger = logger.logger.Level(zerolog.InfoLevel)
+	case LoggerLevelWarn:
+		logger.logger = logger.logger.Level(zerolog.WarnLevel)
+	case LoggerLevelError:
+		logger.logger = logger.logger.Level(zerolog.ErrorLevel)
+	default:
+		logger.level = LoggerLevelDisabled
+		logger.logger = logger.logger.Level(zerolog.Disabled)
+	}
+}
+
+func (logger *DefaultLogger) GetLevel() LogLevel {
+	logger.mu.RLock()
+	defer logger.mu.RUnlock()
+
+	return logger.level
+}
+
+func (logger *DefaultLogger) With(keysAndValues ...interface{}) Logger {
+	logger.mu.RLock()
+	defer logger.mu.RUnlock()
+
+	return &DefaultLogger{
+		logger: logger.logger.With().Fields(keysAndValues).Logger(),
+		level:  logger.level,
+	}
+}
+
+func (logger *DefaultLogger) _Logger() *zerolog.Logger {
+	logger.mu.RLock()
+	defer logger.mu.RUnlock()
+
+	zerologLogger := logger.logger
+	return &zerologLogger
+}
+
+func (logger *DefaultLogger) Trace(message string, keysAndValues ...interface{}) {
+	logger._Logger().Trace().Fields(keysAndValues).Msg(message)
+}
+
+func (logger *DefaultLogger) Debug(message string, keysAndValues ...interface{}) {
+	logger._Logger().Debug().Fields(keysAndValues).Msg(message)
+}
+
+func (logger *DefaultLogger) Info(message string, keysAndValues ...interface{}) {
+	logger._Logger().Info().Fields(keysAndValues).Msg(message)
+}
+
+func (logger *DefaultLogger) Warn(message string, keysAndValues ...interface{}) {
+	logger._Logger().Warn().Fields(keysAndValues).Msg(message)
+}
+
+func (logger *DefaultLogger) Error(message string, keysAndValues ...interface{}) {
+	logger._Logger().Error().Fields(keysAndValues).Msg(message)
+}
+
+// SetLogger sets the logger all output of this client is written through
+func (client *Client) SetLogger(logger Logger) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
+	client.logger = logger
+	return client
+}
+
+// GetLogger returns the logger used by this client
+func (client *Client) GetLogger() Logger {
+	return client._GetLogger()
+}
+
+// SetLogLevel sets the level of the logger used by this client
+func (client *Client) SetLogLevel(level LogLevel) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
+	if client.logger == nil {
+		client.logger = _NewDefaultLogger()
+	}
+
+	client.logger.SetLevel(level)
+	return client
+}
+
+func (client *Client) _GetLogger() Logger {
+	if client == nil {
+		return _NewDefaultLogger()
+	}
+
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	if client.logger == nil {
+		return _NewDefaultLogger()
+	}
+
+	return client.logger
+}
@@ -0,0 +1,157 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+type testLogEntry struct {
+	level   LogLevel
+	message string
+	fields  map[string]interface{}
+}
+
+type testLogger struct {
+	mu      *sync.Mutex
+	entries *[]testLogEntry
+	fields  []interface{}
+	level   LogLevel
+}
+
+func newTestLogger() *testLogger {
+	return &testLogger{mu: &sync.Mutex{}, entries: &[]testLogEntry{}, level: LoggerLevelTrace}
+}
+
+func (logger *testLogger) SetLevel(level LogLevel) { logger.level = level }
+func (logger *testLogger) GetLevel() LogLevel      { return logger.level }
+func (logger *testLogger) With(keysAndValues ...interface{}) Logger {
+	return &testLogger{
+		mu:      logger.mu,
+		entries: logger.entries,
+		fields:  append(append([]interface{}{}, logger.fields...), keysAndValues...),
+		level:   logger.level,
+	}
+}
+
+func (logger *testLogger) log(level LogLevel, message string, keysAndValues []interface{}) {
+	fields := make(map[string]interface{})
+	all := append(append([]interface{}{}, logger.fields...), keysAndValues...)
+	for i := 0; i+1 < len(all); i += 2 {
+		fields[all[i].(string)] = all[i+1]
+	}
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	*logger.entries = append(*logger.entries, testLogEntry{level, message, fields})
+}
+
+func (logger *testLogger) Trace(message string, keysAndValues ...interface{}) {
+	logger.log(LoggerLevelTrace, message, keysAndValues)
+}
+func (logger *testLogger) Debug(message string, keysAndValues ...interface{}) {
+	logger.log(LoggerLevelDebug, message, keysAndValues)
+}
+func (logger *testLogger) Info(message string, keysAndValues ...interface{}) {
+	logger.log(LoggerLevelInfo, message, keysAndValues)
+}
+func (logger *testLogger) Warn(message string, keysAndValues ...interface{}) {
+	logger.log(LoggerLevelWarn, message, keysAndValues)
+}
+func (logger *testLogger) Error(message string, keysAndValues ...interface{}) {
+	logger.log(LoggerLevelError, message, keysAndValues)
+}
+
+func TestUnitDefaultLoggerLevelAndFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLoggerWithWriter("test", LoggerLevelInfo, &buf)
+	require.Equal(t, LoggerLevelInfo, logger.GetLevel())
+
+	logger.Debug("hidden")
+	logger.With("requestId", "TransferTransaction:1").Info("shown", "status", "OK")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, "shown", entry["message"])
+	require.Equal(t, "info", entry["level"])
+	require.Equal(t, "test", entry["module"])
+	require.Equal(t, "TransferTransaction:1", entry["requestId"])
+	require.Equal(t, "OK", entry["status"])
+
+	buf.Reset()
+	logger.SetLevel(LoggerLevelDisabled)
+	logger.Error("hidden")
+	require.Empty(t, buf.String())
+
+	require.Equal(t, LoggerLevelDebug, LogLevelFromString("debug"))
+	require.Equal(t, LoggerLevelDisabled, LogLevelFromString("nonsense"))
+}
+
+func TestUnitClientLoggerReceivesRequestLogs(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	logger := newTestLogger()
+	client.SetLogger(logger)
+	require.Equal(t, logger, client.GetLogger())
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	_, err = transaction.Execute(client)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, *logger.entries)
+	statuses := make([]interface{}, 0)
+	for _, entry := range *logger.entries {
+		require.Equal(t, transaction._GetLogID(), entry.fields["requestId"])
+		if entry.message == "transaction precheck status received" {
+			statuses = append(statuses, entry.fields["status"])
+		}
+	}
+	require.Equal(t, []interface{}{StatusBusy.String(), StatusOk.String()}, statuses)
+}
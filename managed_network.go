@@ -21,13 +21,19 @@ package hedera
  */
 
 import (
+	"context"
 	"crypto/rand"
 	"math"
 	"math/big"
+	"sync"
 	"time"
 )
 
+// _ManagedNetwork is safe for concurrent use. Every method locks mu, except the ones documented
+// as expecting the caller to hold it. The mutex is a pointer so copies of the network share it; use
+// _Mu, which also covers a zero value network.
 type _ManagedNetwork struct {
+	mu                     *sync.RWMutex
 	network                map[string][]_IManagedNode
 	nodes                  []_IManagedNode
 	healthyNodes           []_IManagedNode
@@ -43,8 +49,22 @@ type _ManagedNetwork struct {
 	earliestReadmitTime    time.Time
 }
 
+// _ZeroManagedNetworkMu guards the networks that were not built by a constructor, such as the one in
+// a zero value Client.
+var _ZeroManagedNetworkMu sync.RWMutex
+
+// _Mu returns the lock guarding the network.
+func (this *_ManagedNetwork) _Mu() *sync.RWMutex {
+	if this.mu == nil {
+		return &_ZeroManagedNetworkMu
+	}
+
+	return this.mu
+}
+
 func _NewManagedNetwork() _ManagedNetwork {
 	return _ManagedNetwork{
+		mu:                     &sync.RWMutex{},
 		network:                map[string][]_IManagedNode{},
 		nodes:                  []_IManagedNode{},
 		healthyNodes:           []_IManagedNode{},
@@ -61,6 +81,14 @@ func _NewManagedNetwork() _ManagedNetwork {
 }
 
 func (this *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
+	return this._SetNetworkLocked(network)
+}
+
+// _SetNetworkLocked expects the caller to hold mu for writing
+func (this *_ManagedNetwork) _SetNetworkLocked(network map[string]_IManagedNode) error {
 	newNodes := make([]_IManagedNode, len(this.nodes))
 	newNodeKeys := map[string]bool{}
 	newNodeValues := map[string]bool{}
@@ -108,6 +136,7 @@ func (this *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error
 	return nil
 }
 
+// _ReadmitNodes expects the caller to hold mu for writing
 func (this *_ManagedNetwork) _ReadmitNodes() {
 	now := time.Now()
 
@@ -115,8 +144,8 @@ func (this *_ManagedNetwork) _ReadmitNodes() {
 		nextEarliestReadmitTime := now.Add(this.maxNodeReadmitPeriod)
 
 		for _, node := range this.nodes {
-			if node._GetReadmitTime() != nil && node._GetReadmitTime().After(now) && node._GetReadmitTime().Before(nextEarliestReadmitTime) {
-				nextEarliestReadmitTime = *node._GetReadmitTime()
+			if readmitTime := node._GetReadmitTime(); readmitTime != nil && readmitTime.After(now) && readmitTime.Before(nextEarliestReadmitTime) {
+				nextEarliestReadmitTime = *readmitTime
 			}
 		}
 
@@ -133,7 +162,7 @@ func (this *_ManagedNetwork) _ReadmitNodes() {
 				}
 			}
 
-			if node._GetReadmitTime().Before(now) {
+			if readmitTime := node._GetReadmitTime(); readmitTime == nil || readmitTime.Before(now) {
 				this.healthyNodes = append(this.healthyNodes, node)
 			}
 		}
@@ -141,7 +170,15 @@ func (this *_ManagedNetwork) _ReadmitNodes() {
 }
 
 func (this *_ManagedNetwork) _GetNumberOfNodesForTransaction() int { // nolint
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this._ReadmitNodes()
+	return this._GetNumberOfNodesForTransactionLocked()
+}
+
+// _GetNumberOfNodesForTransactionLocked expects the caller to hold mu
+func (this *_ManagedNetwork) _GetNumberOfNodesForTransactionLocked() int {
 	if this.maxNodesPerTransaction != nil {
 		return int(math.Min(float64(*this.maxNodesPerTransaction), float64(len(this.network))))
 	}
@@ -150,35 +187,59 @@ func (this *_ManagedNetwork) _GetNumberOfNodesForTransaction() int { // nolint
 }
 
 func (this *_ManagedNetwork) _SetMaxNodesPerTransaction(max int) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this.maxNodesPerTransaction = &max
 }
 
 func (this *_ManagedNetwork) _SetMaxNodeAttempts(max int) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this.maxNodeAttempts = max
 }
 
 func (this *_ManagedNetwork) _GetMaxNodeAttempts() int {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
 	return this.maxNodeAttempts
 }
 
 func (this *_ManagedNetwork) _SetMinNodeReadmitPeriod(min time.Duration) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this.minNodeReadmitPeriod = min
 	this.earliestReadmitTime = time.Now().Add(this.minNodeReadmitPeriod)
 }
 
 func (this *_ManagedNetwork) _GetMinNodeReadmitPeriod() time.Duration {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
 	return this.minNodeReadmitPeriod
 }
 
 func (this *_ManagedNetwork) _SetMaxNodeReadmitPeriod(max time.Duration) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this.maxNodeReadmitPeriod = max
 }
 
 func (this *_ManagedNetwork) _GetMaxNodeReadmitPeriod() time.Duration {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
 	return this.maxNodeReadmitPeriod
 }
 
 func (this *_ManagedNetwork) _SetMinBackoff(minBackoff time.Duration) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this.minBackoff = minBackoff
 	for _, nod := range this.healthyNodes {
 		if nod != nil {
@@ -188,6 +249,9 @@ func (this *_ManagedNetwork) _SetMinBackoff(minBackoff time.Duration) {
 }
 
 func (this *_ManagedNetwork) _GetNode() _IManagedNode {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this._ReadmitNodes()
 
 	if len(this.healthyNodes) == 0 {
@@ -199,11 +263,61 @@ func (this *_ManagedNetwork) _GetNode() _IManagedNode {
 	return this.healthyNodes[index.Int64()]
 }
 
+// _GetNodeWithContext is _GetNode waiting for a node to be readmitted while none is healthy,
+// until ctx is done.
+func (this *_ManagedNetwork) _GetNodeWithContext(ctx context.Context) (_IManagedNode, error) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
+	if err := this._WaitForHealthyNodesLocked(ctx); err != nil {
+		return nil, err
+	}
+
+	bg := big.NewInt(int64(len(this.healthyNodes)))
+	index, _ := rand.Int(rand.Reader, bg)
+	return this.healthyNodes[index.Int64()], nil
+}
+
+// _WaitForHealthyNodesLocked readmits the nodes whose backoff is over and, while none is healthy,
+// releases the lock until the earliest readmit time. It returns the error of ctx if ctx is done first.
+// The caller must hold the lock.
+func (this *_ManagedNetwork) _WaitForHealthyNodesLocked(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		this._ReadmitNodes()
+		if len(this.healthyNodes) > 0 {
+			return nil
+		}
+
+		if len(this.nodes) == 0 {
+			return errNetworkHasNoNodes
+		}
+
+		timer := time.NewTimer(time.Until(this.earliestReadmitTime))
+		this._Mu().Unlock()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		timer.Stop()
+		this._Mu().Lock()
+	}
+}
+
 func (this *_ManagedNetwork) _GetMinBackoff() time.Duration {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
 	return this.minBackoff
 }
 
 func (this *_ManagedNetwork) _SetMaxBackoff(maxBackoff time.Duration) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this.maxBackoff = maxBackoff
 	for _, node := range this.healthyNodes {
 		node._SetMaxBackoff(maxBackoff)
@@ -211,19 +325,36 @@ func (this *_ManagedNetwork) _SetMaxBackoff(maxBackoff time.Duration) {
 }
 
 func (this *_ManagedNetwork) _GetMaxBackoff() time.Duration {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
 	return this.maxBackoff
 }
 
 func (this *_ManagedNetwork) _GetLedgerID() *LedgerID {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
 	return this.ledgerID
 }
 
 func (this *_ManagedNetwork) _SetLedgerID(id LedgerID) *_ManagedNetwork {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	this.ledgerID = &id
 	return this
 }
 
 func (this *_ManagedNetwork) _Close() error {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
+	return this._CloseLocked()
+}
+
+// _CloseLocked expects the caller to hold mu
+func (this *_ManagedNetwork) _CloseLocked() error {
 	for _, conn := range this.healthyNodes {
 		if err := conn._Close(); err != nil {
 			return err
@@ -254,8 +385,11 @@ func _CreateNetworkFromNodes(nodes []_IManagedNode) (network map[string][]_IMana
 }
 
 func (this *_ManagedNetwork) _SetTransportSecurity(transportSecurity bool) (err error) {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	if this.transportSecurity != transportSecurity {
-		if err := this._Close(); err != nil {
+		if err := this._CloseLocked(); err != nil {
 			return err
 		}
 
@@ -282,11 +416,14 @@ func (this *_ManagedNetwork) _SetTransportSecurity(transportSecurity bool) (err
 	return nil
 }
 
+// _GetNodesToRemove returns the indices of the nodes whose address is no longer part of the network,
+// or now belongs to a different key. The network is keyed by address, so nodes which are kept keep
+// their open channel across updates.
 func _GetNodesToRemove(network map[string]_IManagedNode, nodes []_IManagedNode) []int {
 	nodeIndices := []int{}
 
 	for i := len(nodes) - 1; i >= 0; i-- {
-		if _, ok := network[nodes[i]._GetKey()]; !ok {
+		if node, ok := network[nodes[i]._GetAddress()]; !ok || node._GetKey() != nodes[i]._GetKey() {
 			nodeIndices = append(nodeIndices, i)
 		}
 	}
@@ -295,6 +432,9 @@ func _GetNodesToRemove(network map[string]_IManagedNode, nodes []_IManagedNode)
 }
 
 func (this *_ManagedNetwork) _SetVerifyCertificate(verify bool) *_ManagedNetwork {
+	this._Mu().Lock()
+	defer this._Mu().Unlock()
+
 	for _, node := range this.nodes {
 		node._SetVerifyCertificate(verify)
 	}
@@ -304,5 +444,8 @@ func (this *_ManagedNetwork) _SetVerifyCertificate(verify bool) *_ManagedNetwork
 }
 
 func (this *_ManagedNetwork) _GetVerifyCertificate() bool {
+	this._Mu().RLock()
+	defer this._Mu().RUnlock()
+
 	return this.verifyCertificate
 }
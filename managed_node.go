@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"sync"
 	"time"
 )
 
@@ -48,7 +49,9 @@ type _IManagedNode interface {
 	_Close() error
 }
 
+// _ManagedNode is safe for concurrent use, mu guards every field except the immutable address
 type _ManagedNode struct {
+	mu                 sync.RWMutex
 	address            *_ManagedNodeAddress
 	currentBackoff     time.Duration
 	lastUsed           time.Time
@@ -60,6 +63,9 @@ type _ManagedNode struct {
 }
 
 func (node *_ManagedNode) _GetAttempts() int64 {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.badGrpcStatusCount
 }
 
@@ -72,6 +78,9 @@ func (node *_ManagedNode) _GetAddress() string {
 }
 
 func (node *_ManagedNode) _GetReadmitTime() *time.Time {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.readmitTime
 }
 
@@ -89,6 +98,9 @@ func _NewManagedNode(address string, minBackoff time.Duration) (node *_ManagedNo
 }
 
 func (node *_ManagedNode) _SetMinBackoff(minBackoff time.Duration) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	if node.currentBackoff == node.minBackoff {
 		node.currentBackoff = node.minBackoff
 	}
@@ -97,23 +109,38 @@ func (node *_ManagedNode) _SetMinBackoff(minBackoff time.Duration) {
 }
 
 func (node *_ManagedNode) _GetMinBackoff() time.Duration {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.minBackoff
 }
 
 func (node *_ManagedNode) _SetMaxBackoff(waitTime time.Duration) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	node.maxBackoff = waitTime
 }
 
 func (node *_ManagedNode) _GetMaxBackoff() time.Duration {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.maxBackoff
 }
 
 func (node *_ManagedNode) _InUse() {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	node.useCount++
 	node.lastUsed = time.Now()
 }
 
 func (node *_ManagedNode) _IsHealthy() bool {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	if node.readmitTime == nil {
 		return true
 	}
@@ -122,6 +149,9 @@ func (node *_ManagedNode) _IsHealthy() bool {
 }
 
 func (node *_ManagedNode) _IncreaseBackoff() {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	node.badGrpcStatusCount++
 	node.currentBackoff *= 2
 	if node.currentBackoff > node.maxBackoff {
@@ -132,6 +162,9 @@ func (node *_ManagedNode) _IncreaseBackoff() {
 }
 
 func (node *_ManagedNode) _DecreaseBackoff() {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	node.currentBackoff /= 2
 	if node.currentBackoff < node.minBackoff {
 		node.currentBackoff = node.minBackoff
@@ -139,13 +172,38 @@ func (node *_ManagedNode) _DecreaseBackoff() {
 }
 
 func (node *_ManagedNode) _Wait() time.Duration {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.readmitTime.Sub(node.lastUsed)
 }
 
 func (node *_ManagedNode) _GetUseCount() int64 {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.useCount
 }
 
 func (node *_ManagedNode) _GetLastUsed() time.Time {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.lastUsed
 }
+
+// _WithAddress returns a copy of the node state using the given address
+func (node *_ManagedNode) _WithAddress(address *_ManagedNodeAddress) *_ManagedNode {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
+	return &_ManagedNode{
+		address:            address,
+		currentBackoff:     node.currentBackoff,
+		lastUsed:           node.lastUsed,
+		readmitTime:        node.readmitTime,
+		useCount:           node.useCount,
+		minBackoff:         node.minBackoff,
+		badGrpcStatusCount: node.badGrpcStatusCount,
+	}
+}
@@ -44,6 +44,9 @@ func (network *_MirrorNetwork) _SetNetwork(newNetwork []string) (err error) {
 }
 
 func (network *_MirrorNetwork) _GetNetwork() []string {
+	network._Mu().RLock()
+	defer network._Mu().RUnlock()
+
 	temp := make([]string, 0)
 	for url := range network._ManagedNetwork.network { //nolint
 		temp = append(temp, url)
@@ -59,6 +62,9 @@ func (network *_MirrorNetwork) _SetTransportSecurity(transportSecurity bool) *_M
 }
 
 func (network *_MirrorNetwork) _GetNextMirrorNode() *_MirrorNode {
+	network._Mu().RLock()
+	defer network._Mu().RUnlock()
+
 	node := network._ManagedNetwork.healthyNodes[rand.Intn(len(network.healthyNodes))] // nolint
 	if node, ok := node.(*_MirrorNode); ok {
 		return node
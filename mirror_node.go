@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"crypto/tls"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/credentials/insecure"
@@ -36,6 +37,8 @@ import (
 
 type _MirrorNode struct {
 	*_ManagedNode
+	// mu guards the lazily created gRPC clients
+	mu                     sync.Mutex
 	consensusServiceClient *mirror.ConsensusServiceClient
 	networkServiceClient   *mirror.NetworkServiceClient
 	client                 *grpc.ClientConn
@@ -119,6 +122,9 @@ func (node *_MirrorNode) _GetReadmitTime() *time.Time {
 }
 
 func (node *_MirrorNode) _GetConsensusServiceClient() (*mirror.ConsensusServiceClient, error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	if node.consensusServiceClient != nil {
 		return node.consensusServiceClient, nil
 	} else if node.client != nil {
@@ -154,6 +160,9 @@ func (node *_MirrorNode) _GetConsensusServiceClient() (*mirror.ConsensusServiceC
 }
 
 func (node *_MirrorNode) _GetNetworkServiceClient() (*mirror.NetworkServiceClient, error) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	if node.networkServiceClient != nil {
 		return node.networkServiceClient, nil
 	} else if node.client != nil {
@@ -189,42 +198,35 @@ func (node *_MirrorNode) _GetNetworkServiceClient() (*mirror.NetworkServiceClien
 }
 
 func (node *_MirrorNode) _ToSecure() _IManagedNode {
-	managed := _ManagedNode{
-		address:            node.address._ToSecure(),
-		currentBackoff:     node.currentBackoff,
-		lastUsed:           node.lastUsed,
-		readmitTime:        node.readmitTime,
-		useCount:           node.useCount,
-		minBackoff:         node.minBackoff,
-		badGrpcStatusCount: node.badGrpcStatusCount,
-	}
+	managed := node._ManagedNode._WithAddress(node.address._ToSecure())
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
 
 	return &_MirrorNode{
-		_ManagedNode:           &managed,
+		_ManagedNode:           managed,
 		consensusServiceClient: node.consensusServiceClient,
 		client:                 node.client,
 	}
 }
 
 func (node *_MirrorNode) _ToInsecure() _IManagedNode {
-	managed := _ManagedNode{
-		address:            node.address._ToInsecure(),
-		currentBackoff:     node.currentBackoff,
-		lastUsed:           node.lastUsed,
-		readmitTime:        node.readmitTime,
-		useCount:           node.useCount,
-		minBackoff:         node.minBackoff,
-		badGrpcStatusCount: node.badGrpcStatusCount,
-	}
+	managed := node._ManagedNode._WithAddress(node.address._ToInsecure())
+
+	node.mu.Lock()
+	defer node.mu.Unlock()
 
 	return &_MirrorNode{
-		_ManagedNode:           &managed,
+		_ManagedNode:           managed,
 		consensusServiceClient: node.consensusServiceClient,
 		client:                 node.client,
 	}
 }
 
 func (node *_MirrorNode) _Close() error {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	if node.consensusServiceClient != nil {
 		return node.client.Close()
 	}
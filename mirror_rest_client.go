@@ -0,0 +1,856 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const mirrorRestAPIPath = "/api/v1"
+
+// MirrorRestClient queries the mirror node REST API. List queries return iterators which follow
+// the `links.next` pagination of the API until every page has been read.
+type MirrorRestClient struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	logger     Logger
+	pageLimit  uint32
+}
+
+// NewMirrorRestClient returns a client for the mirror node REST API at the given base URL,
+// for example "https://testnet.mirrornode.hedera.com". The "/api/v1" path is added when missing.
+func NewMirrorRestClient(baseURL string) (*MirrorRestClient, error) {
+	parsed, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("mirror node REST url %q must contain a scheme and a host", baseURL)
+	}
+
+	if !strings.HasSuffix(parsed.Path, mirrorRestAPIPath) {
+		parsed.Path += mirrorRestAPIPath
+	}
+
+	return &MirrorRestClient{
+		baseURL:    parsed,
+		httpClient: http.DefaultClient,
+		logger:     _NewDefaultLogger(),
+	}, nil
+}
+
+// GetMirrorRestClient returns a MirrorRestClient for the first mirror node of the client's mirror network.
+// Mirror nodes on port 443 are reached over https on the same host, the local node mirror on port 5600
+// is reached over http on port 5551, and any other port is kept as is.
+func (client *Client) GetMirrorRestClient() (*MirrorRestClient, error) {
+	mirrorNetwork := client.GetMirrorNetwork()
+	if len(mirrorNetwork) == 0 {
+		return nil, errors.New("client has no mirror network set")
+	}
+
+	sort.Strings(mirrorNetwork)
+
+	baseURL, err := _MirrorRestURLFromAddress(mirrorNetwork[0])
+	if err != nil {
+		return nil, err
+	}
+
+	restClient, err := NewMirrorRestClient(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	restClient.logger = client._GetLogger()
+
+	return restClient, nil
+}
+
+func _MirrorRestURLFromAddress(address string) (string, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", err
+	}
+
+	switch port {
+	case "443":
+		return "https://" + host, nil
+	case "5600":
+		return "http://" + net.JoinHostPort(host, "5551"), nil
+	default:
+		return "https://" + net.JoinHostPort(host, port), nil
+	}
+}
+
+// SetHTTPClient sets the HTTP client used for requests, http.DefaultClient by default
+func (restClient *MirrorRestClient) SetHTTPClient(httpClient *http.Client) *MirrorRestClient {
+	restClient.httpClient = httpClient
+	return restClient
+}
+
+// GetHTTPClient returns the HTTP client used for requests
+func (restClient *MirrorRestClient) GetHTTPClient() *http.Client {
+	return restClient.httpClient
+}
+
+// SetLogger sets the logger requests are logged through
+func (restClient *MirrorRestClient) SetLogger(logger Logger) *MirrorRestClient {
+	restClient.logger = logger
+	return restClient
+}
+
+// GetLogger returns the logger requests are logged through
+func (restClient *MirrorRestClient) GetLogger() Logger {
+	return restClient.logger
+}
+
+// SetPageLimit sets the number of items requested per page, the mirror node default is used when 0
+func (restClient *MirrorRestClient) SetPageLimit(limit uint32) *MirrorRestClient {
+	restClient.pageLimit = limit
+	return restClient
+}
+
+// GetPageLimit returns the number of items requested per page
+func (restClient *MirrorRestClient) GetPageLimit() uint32 {
+	return restClient.pageLimit
+}
+
+// GetBaseURL returns the base URL requests are sent to, including the "/api/v1" path
+func (restClient *MirrorRestClient) GetBaseURL() string {
+	return restClient.baseURL.String()
+}
+
+// GetAccountTransactions returns the transactions the account took part in, newest first
+func (restClient *MirrorRestClient) GetAccountTransactions(ctx context.Context, accountID AccountID) *MirrorTransactionIterator {
+	query := url.Values{}
+	query.Set("account.id", accountID.String())
+
+	return &MirrorTransactionIterator{pager: restClient._NewPager(ctx, "/transactions", query)}
+}
+
+// GetTokenBalances returns the balance of every account holding the token
+func (restClient *MirrorRestClient) GetTokenBalances(ctx context.Context, tokenID TokenID) *MirrorTokenBalanceIterator {
+	return &MirrorTokenBalanceIterator{pager: restClient._NewPager(ctx, "/tokens/"+tokenID.String()+"/balances", url.Values{})}
+}
+
+// GetTokenNfts returns every NFT of the token
+func (restClient *MirrorRestClient) GetTokenNfts(ctx context.Context, tokenID TokenID) *MirrorNftIterator {
+	return &MirrorNftIterator{pager: restClient._NewPager(ctx, "/tokens/"+tokenID.String()+"/nfts", url.Values{})}
+}
+
+// GetAccountNfts returns every NFT owned by the account
+func (restClient *MirrorRestClient) GetAccountNfts(ctx context.Context, accountID AccountID) *MirrorNftIterator {
+	return &MirrorNftIterator{pager: restClient._NewPager(ctx, "/accounts/"+accountID.String()+"/nfts", url.Values{})}
+}
+
+// GetContractResults returns the results of calls made to the contract
+func (restClient *MirrorRestClient) GetContractResults(ctx context.Context, contractID ContractID) *MirrorContractResultIterator {
+	return &MirrorContractResultIterator{pager: restClient._NewPager(ctx, "/contracts/"+contractID.String()+"/results", url.Values{})}
+}
+
+// GetContractLogs returns the logs emitted by the contract
+func (restClient *MirrorRestClient) GetContractLogs(ctx context.Context, contractID ContractID) *MirrorContractLogIterator {
+	return &MirrorContractLogIterator{pager: restClient._NewPager(ctx, "/contracts/"+contractID.String()+"/results/logs", url.Values{})}
+}
+
+func (restClient *MirrorRestClient) _NewPager(ctx context.Context, path string, query url.Values) *_MirrorRestPager {
+	if restClient.pageLimit > 0 {
+		query.Set("limit", strconv.FormatUint(uint64(restClient.pageLimit), 10))
+	}
+
+	next := *restClient.baseURL
+	next.Path += path
+	next.RawQuery = query.Encode()
+
+	return &_MirrorRestPager{
+		ctx:    ctx,
+		client: restClient,
+		next:   &next,
+	}
+}
+
+func (restClient *MirrorRestClient) _Get(ctx context.Context, requestURL *url.URL, out interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	restClient.logger.Trace("mirror node REST request", "url", requestURL.String())
+
+	response, err := restClient.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		restErr := ErrMirrorRest{StatusCode: response.StatusCode, URL: requestURL.String()}
+
+		var status _MirrorRestStatus
+		if json.Unmarshal(body, &status) == nil {
+			for _, message := range status.Status.Messages {
+				restErr.Messages = append(restErr.Messages, message.Message)
+			}
+		}
+
+		return restErr
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+type _MirrorRestStatus struct {
+	Status struct {
+		Messages []struct {
+			Message string `json:"message"`
+		} `json:"messages"`
+	} `json:"_status"`
+}
+
+type _MirrorRestLinks struct {
+	Next *string `json:"next"`
+}
+
+type _MirrorRestPager struct {
+	ctx    context.Context
+	client *MirrorRestClient
+	next   *url.URL
+	err    error
+}
+
+// _NextPage fetches the next page into page and returns the value of its `links.next`.
+// Returns false once there are no pages left or a request failed.
+func (pager *_MirrorRestPager) _NextPage(page interface{}, links func() _MirrorRestLinks) bool {
+	if pager.err != nil || pager.next == nil {
+		return false
+	}
+
+	if pager.err = pager.client._Get(pager.ctx, pager.next, page); pager.err != nil {
+		return false
+	}
+
+	pager.next = nil
+	if next := links().Next; next != nil && *next != "" {
+		nextURL, err := url.Parse(*next)
+		if err != nil {
+			pager.err = err
+			return false
+		}
+		pager.next = pager.client.baseURL.ResolveReference(nextURL)
+	}
+
+	return true
+}
+
+// MirrorTransfer is an hbar transfer of a MirrorTransaction
+type MirrorTransfer struct {
+	AccountID  AccountID
+	Amount     Hbar
+	IsApproval bool
+}
+
+// MirrorTokenTransfer is a fungible token transfer of a MirrorTransaction
+type MirrorTokenTransfer struct {
+	TokenID    TokenID
+	AccountID  AccountID
+	Amount     int64
+	IsApproval bool
+}
+
+// MirrorTransaction is a transaction as returned by the mirror node REST API
+type MirrorTransaction struct {
+	TransactionID      TransactionID
+	ConsensusTimestamp time.Time
+	// The transaction type, for example CRYPTOTRANSFER
+	Name           string
+	Result         string
+	ChargedTxFee   Hbar
+	Memo           []byte
+	NodeAccountID  AccountID
+	Hash           []byte
+	Scheduled      bool
+	Transfers      []MirrorTransfer
+	TokenTransfers []MirrorTokenTransfer
+}
+
+type _MirrorRestTransaction struct {
+	TransactionID      string `json:"transaction_id"`
+	ConsensusTimestamp string `json:"consensus_timestamp"`
+	Name               string `json:"name"`
+	Result             string `json:"result"`
+	ChargedTxFee       int64  `json:"charged_tx_fee"`
+	MemoBase64         string `json:"memo_base64"`
+	Node               string `json:"node"`
+	Nonce              int32  `json:"nonce"`
+	Scheduled          bool   `json:"scheduled"`
+	TransactionHash    string `json:"transaction_hash"`
+	Transfers          []struct {
+		Account    string `json:"account"`
+		Amount     int64  `json:"amount"`
+		IsApproval bool   `json:"is_approval"`
+	} `json:"transfers"`
+	TokenTransfers []struct {
+		TokenID    string `json:"token_id"`
+		Account    string `json:"account"`
+		Amount     int64  `json:"amount"`
+		IsApproval bool   `json:"is_approval"`
+	} `json:"token_transfers"`
+}
+
+func (transaction _MirrorRestTransaction) _ToMirrorTransaction() (MirrorTransaction, error) {
+	result := MirrorTransaction{
+		Name:         transaction.Name,
+		Result:       transaction.Result,
+		ChargedTxFee: HbarFromTinybar(transaction.ChargedTxFee),
+		Scheduled:    transaction.Scheduled,
+	}
+
+	var err error
+	if result.TransactionID, err = _MirrorRestTransactionID(transaction.TransactionID); err != nil {
+		return MirrorTransaction{}, err
+	}
+	result.TransactionID.scheduled = transaction.Scheduled
+	if transaction.Nonce != 0 {
+		nonce := transaction.Nonce
+		result.TransactionID.Nonce = &nonce
+	}
+
+	if result.ConsensusTimestamp, err = _MirrorRestTimestamp(transaction.ConsensusTimestamp); err != nil {
+		return MirrorTransaction{}, err
+	}
+
+	if result.Memo, err = base64.StdEncoding.DecodeString(transaction.MemoBase64); err != nil {
+		return MirrorTransaction{}, err
+	}
+
+	if result.Hash, err = base64.StdEncoding.DecodeString(transaction.TransactionHash); err != nil {
+		return MirrorTransaction{}, err
+	}
+
+	if transaction.Node != "" {
+		if result.NodeAccountID, err = AccountIDFromString(transaction.Node); err != nil {
+			return MirrorTransaction{}, err
+		}
+	}
+
+	for _, transfer := range transaction.Transfers {
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+
+		result.Transfers = append(result.Transfers, MirrorTransfer{
+			AccountID:  accountID,
+			Amount:     HbarFromTinybar(transfer.Amount),
+			IsApproval: transfer.IsApproval,
+		})
+	}
+
+	for _, transfer := range transaction.TokenTransfers {
+		tokenID, err := TokenIDFromString(transfer.TokenID)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return MirrorTransaction{}, err
+		}
+
+		result.TokenTransfers = append(result.TokenTransfers, MirrorTokenTransfer{
+			TokenID:    tokenID,
+			AccountID:  accountID,
+			Amount:     transfer.Amount,
+			IsApproval: transfer.IsApproval,
+		})
+	}
+
+	return result, nil
+}
+
+// MirrorTransactionIterator iterates over the transactions of a MirrorRestClient query
+type MirrorTransactionIterator struct {
+	pager   *_MirrorRestPager
+	items   []MirrorTransaction
+	current MirrorTransaction
+}
+
+// Next advances to the next transaction, fetching the next page when needed.
+// Returns false once every page has been read or an error occurred, see Err.
+func (iterator *MirrorTransactionIterator) Next() bool {
+	for len(iterator.items) == 0 {
+		var page struct {
+			Transactions []_MirrorRestTransaction `json:"transactions"`
+			Links        _MirrorRestLinks         `json:"links"`
+		}
+		if !iterator.pager._NextPage(&page, func() _MirrorRestLinks { return page.Links }) {
+			return false
+		}
+
+		for _, transaction := range page.Transactions {
+			item, err := transaction._ToMirrorTransaction()
+			if err != nil {
+				iterator.pager.err = err
+				return false
+			}
+			iterator.items = append(iterator.items, item)
+		}
+	}
+
+	iterator.current, iterator.items = iterator.items[0], iterator.items[1:]
+	return true
+}
+
+// Transaction returns the transaction Next advanced to
+func (iterator *MirrorTransactionIterator) Transaction() MirrorTransaction {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (iterator *MirrorTransactionIterator) Err() error {
+	return iterator.pager.err
+}
+
+// All reads every remaining transaction
+func (iterator *MirrorTransactionIterator) All() ([]MirrorTransaction, error) {
+	items := make([]MirrorTransaction, 0)
+	for iterator.Next() {
+		items = append(items, iterator.Transaction())
+	}
+
+	return items, iterator.Err()
+}
+
+// MirrorTokenBalance is the balance of one account holding a token
+type MirrorTokenBalance struct {
+	AccountID AccountID
+	Balance   uint64
+	Decimals  uint64
+}
+
+// MirrorTokenBalanceIterator iterates over the balances of a MirrorRestClient query
+type MirrorTokenBalanceIterator struct {
+	pager   *_MirrorRestPager
+	items   []MirrorTokenBalance
+	current MirrorTokenBalance
+}
+
+// Next advances to the next balance, fetching the next page when needed.
+// Returns false once every page has been read or an error occurred, see Err.
+func (iterator *MirrorTokenBalanceIterator) Next() bool {
+	for len(iterator.items) == 0 {
+		var page struct {
+			Balances []struct {
+				Account  string `json:"account"`
+				Balance  uint64 `json:"balance"`
+				Decimals uint64 `json:"decimals"`
+			} `json:"balances"`
+			Links _MirrorRestLinks `json:"links"`
+		}
+		if !iterator.pager._NextPage(&page, func() _MirrorRestLinks { return page.Links }) {
+			return false
+		}
+
+		for _, balance := range page.Balances {
+			accountID, err := AccountIDFromString(balance.Account)
+			if err != nil {
+				iterator.pager.err = err
+				return false
+			}
+			iterator.items = append(iterator.items, MirrorTokenBalance{
+				AccountID: accountID,
+				Balance:   balance.Balance,
+				Decimals:  balance.Decimals,
+			})
+		}
+	}
+
+	iterator.current, iterator.items = iterator.items[0], iterator.items[1:]
+	return true
+}
+
+// Balance returns the balance Next advanced to
+func (iterator *MirrorTokenBalanceIterator) Balance() MirrorTokenBalance {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (iterator *MirrorTokenBalanceIterator) Err() error {
+	return iterator.pager.err
+}
+
+// All reads every remaining balance
+func (iterator *MirrorTokenBalanceIterator) All() ([]MirrorTokenBalance, error) {
+	items := make([]MirrorTokenBalance, 0)
+	for iterator.Next() {
+		items = append(items, iterator.Balance())
+	}
+
+	return items, iterator.Err()
+}
+
+// MirrorNft is an NFT as returned by the mirror node REST API
+type MirrorNft struct {
+	NftID            NftID
+	AccountID        AccountID
+	Metadata         []byte
+	Deleted          bool
+	CreatedTimestamp time.Time
+}
+
+// MirrorNftIterator iterates over the NFTs of a MirrorRestClient query
+type MirrorNftIterator struct {
+	pager   *_MirrorRestPager
+	items   []MirrorNft
+	current MirrorNft
+}
+
+// Next advances to the next NFT, fetching the next page when needed.
+// Returns false once every page has been read or an error occurred, see Err.
+func (iterator *MirrorNftIterator) Next() bool {
+	for len(iterator.items) == 0 {
+		var page struct {
+			Nfts []struct {
+				AccountID        string `json:"account_id"`
+				TokenID          string `json:"token_id"`
+				SerialNumber     int64  `json:"serial_number"`
+				Metadata         string `json:"metadata"`
+				Deleted          bool   `json:"deleted"`
+				CreatedTimestamp string `json:"created_timestamp"`
+			} `json:"nfts"`
+			Links _MirrorRestLinks `json:"links"`
+		}
+		if !iterator.pager._NextPage(&page, func() _MirrorRestLinks { return page.Links }) {
+			return false
+		}
+
+		for _, nft := range page.Nfts {
+			item, err := func() (item MirrorNft, err error) {
+				if item.NftID.TokenID, err = TokenIDFromString(nft.TokenID); err != nil {
+					return item, err
+				}
+				item.NftID.SerialNumber = nft.SerialNumber
+				if nft.AccountID != "" {
+					if item.AccountID, err = AccountIDFromString(nft.AccountID); err != nil {
+						return item, err
+					}
+				}
+				if item.Metadata, err = base64.StdEncoding.DecodeString(nft.Metadata); err != nil {
+					return item, err
+				}
+				item.Deleted = nft.Deleted
+				item.CreatedTimestamp, err = _MirrorRestTimestamp(nft.CreatedTimestamp)
+				return item, err
+			}()
+			if err != nil {
+				iterator.pager.err = err
+				return false
+			}
+			iterator.items = append(iterator.items, item)
+		}
+	}
+
+	iterator.current, iterator.items = iterator.items[0], iterator.items[1:]
+	return true
+}
+
+// Nft returns the NFT Next advanced to
+func (iterator *MirrorNftIterator) Nft() MirrorNft {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (iterator *MirrorNftIterator) Err() error {
+	return iterator.pager.err
+}
+
+// All reads every remaining NFT
+func (iterator *MirrorNftIterator) All() ([]MirrorNft, error) {
+	items := make([]MirrorNft, 0)
+	for iterator.Next() {
+		items = append(items, iterator.Nft())
+	}
+
+	return items, iterator.Err()
+}
+
+// MirrorContractResult is the result of a contract call as returned by the mirror node REST API
+type MirrorContractResult struct {
+	ContractID ContractID
+	// EVM addresses of the caller and the callee, hex encoded with a 0x prefix
+	From         string
+	To           string
+	Amount       Hbar
+	GasLimit     uint64
+	GasUsed      uint64
+	CallResult   []byte
+	ErrorMessage string
+	Result       string
+	// Ethereum hash of the transaction
+	Hash      []byte
+	Timestamp time.Time
+}
+
+// MirrorContractResultIterator iterates over the contract results of a MirrorRestClient query
+type MirrorContractResultIterator struct {
+	pager   *_MirrorRestPager
+	items   []MirrorContractResult
+	current MirrorContractResult
+}
+
+// Next advances to the next contract result, fetching the next page when needed.
+// Returns false once every page has been read or an error occurred, see Err.
+func (iterator *MirrorContractResultIterator) Next() bool {
+	for len(iterator.items) == 0 {
+		var page struct {
+			Results []struct {
+				ContractID   string `json:"contract_id"`
+				From         string `json:"from"`
+				To           string `json:"to"`
+				Amount       int64  `json:"amount"`
+				GasLimit     uint64 `json:"gas_limit"`
+				GasUsed      uint64 `json:"gas_used"`
+				CallResult   string `json:"call_result"`
+				ErrorMessage string `json:"error_message"`
+				Result       string `json:"result"`
+				Hash         string `json:"hash"`
+				Timestamp    string `json:"timestamp"`
+			} `json:"results"`
+			Links _MirrorRestLinks `json:"links"`
+		}
+		if !iterator.pager._NextPage(&page, func() _MirrorRestLinks { return page.Links }) {
+			return false
+		}
+
+		for _, contractResult := range page.Results {
+			item, err := func() (item MirrorContractResult, err error) {
+				if item.ContractID, err = ContractIDFromString(contractResult.ContractID); err != nil {
+					return item, err
+				}
+				if item.CallResult, err = _MirrorRestHex(contractResult.CallResult); err != nil {
+					return item, err
+				}
+				if item.Hash, err = _MirrorRestHex(contractResult.Hash); err != nil {
+					return item, err
+				}
+				item.From = contractResult.From
+				item.To = contractResult.To
+				item.Amount = HbarFromTinybar(contractResult.Amount)
+				item.GasLimit = contractResult.GasLimit
+				item.GasUsed = contractResult.GasUsed
+				item.ErrorMessage = contractResult.ErrorMessage
+				item.Result = contractResult.Result
+				item.Timestamp, err = _MirrorRestTimestamp(contractResult.Timestamp)
+				return item, err
+			}()
+			if err != nil {
+				iterator.pager.err = err
+				return false
+			}
+			iterator.items = append(iterator.items, item)
+		}
+	}
+
+	iterator.current, iterator.items = iterator.items[0], iterator.items[1:]
+	return true
+}
+
+// ContractResult returns the contract result Next advanced to
+func (iterator *MirrorContractResultIterator) ContractResult() MirrorContractResult {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (iterator *MirrorContractResultIterator) Err() error {
+	return iterator.pager.err
+}
+
+// All reads every remaining contract result
+func (iterator *MirrorContractResultIterator) All() ([]MirrorContractResult, error) {
+	items := make([]MirrorContractResult, 0)
+	for iterator.Next() {
+		items = append(items, iterator.ContractResult())
+	}
+
+	return items, iterator.Err()
+}
+
+// MirrorContractLog is a contract log as returned by the mirror node REST API
+type MirrorContractLog struct {
+	ContractLogInfo
+	// Position of the log within the transaction
+	Index           uint64
+	Timestamp       time.Time
+	TransactionHash []byte
+	BlockNumber     uint64
+}
+
+// MirrorContractLogIterator iterates over the contract logs of a MirrorRestClient query
+type MirrorContractLogIterator struct {
+	pager   *_MirrorRestPager
+	items   []MirrorContractLog
+	current MirrorContractLog
+}
+
+// Next advances to the next log, fetching the next page when needed.
+// Returns false once every page has been read or an error occurred, see Err.
+func (iterator *MirrorContractLogIterator) Next() bool {
+	for len(iterator.items) == 0 {
+		var page struct {
+			Logs []struct {
+				ContractID      string   `json:"contract_id"`
+				Bloom           string   `json:"bloom"`
+				Topics          []string `json:"topics"`
+				Data            string   `json:"data"`
+				Index           uint64   `json:"index"`
+				Timestamp       string   `json:"timestamp"`
+				TransactionHash string   `json:"transaction_hash"`
+				BlockNumber     uint64   `json:"block_number"`
+			} `json:"logs"`
+			Links _MirrorRestLinks `json:"links"`
+		}
+		if !iterator.pager._NextPage(&page, func() _MirrorRestLinks { return page.Links }) {
+			return false
+		}
+
+		for _, log := range page.Logs {
+			item, err := func() (item MirrorContractLog, err error) {
+				if item.ContractID, err = ContractIDFromString(log.ContractID); err != nil {
+					return item, err
+				}
+				if item.Bloom, err = _MirrorRestHex(log.Bloom); err != nil {
+					return item, err
+				}
+				if item.Data, err = _MirrorRestHex(log.Data); err != nil {
+					return item, err
+				}
+				for _, topic := range log.Topics {
+					decoded, err := _MirrorRestHex(topic)
+					if err != nil {
+						return item, err
+					}
+					item.Topics = append(item.Topics, decoded)
+				}
+				if item.TransactionHash, err = _MirrorRestHex(log.TransactionHash); err != nil {
+					return item, err
+				}
+				item.Index = log.Index
+				item.BlockNumber = log.BlockNumber
+				item.Timestamp, err = _MirrorRestTimestamp(log.Timestamp)
+				return item, err
+			}()
+			if err != nil {
+				iterator.pager.err = err
+				return false
+			}
+			iterator.items = append(iterator.items, item)
+		}
+	}
+
+	iterator.current, iterator.items = iterator.items[0], iterator.items[1:]
+	return true
+}
+
+// Log returns the log Next advanced to
+func (iterator *MirrorContractLogIterator) Log() MirrorContractLog {
+	return iterator.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (iterator *MirrorContractLogIterator) Err() error {
+	return iterator.pager.err
+}
+
+// All reads every remaining log
+func (iterator *MirrorContractLogIterator) All() ([]MirrorContractLog, error) {
+	items := make([]MirrorContractLog, 0)
+	for iterator.Next() {
+		items = append(items, iterator.Log())
+	}
+
+	return items, iterator.Err()
+}
+
+// _MirrorRestTimestamp parses the "seconds.nanoseconds" timestamps used by the mirror node
+func _MirrorRestTimestamp(timestamp string) (time.Time, error) {
+	if timestamp == "" {
+		return time.Time{}, nil
+	}
+
+	parts := strings.SplitN(timestamp, ".", 2)
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid mirror node timestamp %q", timestamp)
+	}
+
+	var nanos int64
+	if len(parts) == 2 {
+		if nanos, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+			return time.Time{}, errors.Wrapf(err, "invalid mirror node timestamp %q", timestamp)
+		}
+	}
+
+	return time.Unix(seconds, nanos).UTC(), nil
+}
+
+// _MirrorRestTransactionID parses the "0.0.1234-seconds-nanoseconds" transaction IDs used by the mirror node
+func _MirrorRestTransactionID(transactionID string) (TransactionID, error) {
+	parts := strings.Split(transactionID, "-")
+	if len(parts) != 3 {
+		return TransactionID{}, fmt.Errorf("invalid mirror node transaction ID %q", transactionID)
+	}
+
+	accountID, err := AccountIDFromString(parts[0])
+	if err != nil {
+		return TransactionID{}, err
+	}
+
+	validStart, err := _MirrorRestTimestamp(parts[1] + "." + parts[2])
+	if err != nil {
+		return TransactionID{}, err
+	}
+
+	return NewTransactionIDWithValidStart(accountID, validStart), nil
+}
+
+func _MirrorRestHex(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(value, "0x"))
+}
@@ -0,0 +1,237 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newMockMirrorRestServer(t *testing.T, pages map[string]string) (*MirrorRestClient, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, ok := pages[request.URL.RequestURI()]
+		if !ok {
+			writer.WriteHeader(http.StatusNotFound)
+			_, _ = writer.Write([]byte(`{"_status":{"messages":[{"message":"Not found"}]}}`))
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(body))
+	}))
+
+	client, err := NewMirrorRestClient(server.URL)
+	require.NoError(t, err)
+
+	return client, server
+}
+
+func TestUnitMirrorRestClientAccountTransactionsPagination(t *testing.T) {
+	client, server := newMockMirrorRestServer(t, map[string]string{
+		"/api/v1/transactions?account.id=0.0.1001&limit=1": `{
+			"transactions": [{
+				"transaction_id": "0.0.1001-1690000000-000000123",
+				"consensus_timestamp": "1690000002.000000456",
+				"name": "CRYPTOTRANSFER",
+				"result": "SUCCESS",
+				"charged_tx_fee": 84000,
+				"memo_base64": "aGVsbG8=",
+				"node": "0.0.3",
+				"transaction_hash": "AQID",
+				"transfers": [
+					{"account": "0.0.1001", "amount": -100, "is_approval": false},
+					{"account": "0.0.1002", "amount": 100, "is_approval": true}
+				],
+				"token_transfers": [
+					{"token_id": "0.0.5000", "account": "0.0.1002", "amount": 7, "is_approval": false}
+				]
+			}],
+			"links": {"next": "/api/v1/transactions?account.id=0.0.1001&limit=1&timestamp=lt:1690000002.000000456"}
+		}`,
+		"/api/v1/transactions?account.id=0.0.1001&limit=1&timestamp=lt:1690000002.000000456": `{
+			"transactions": [{
+				"transaction_id": "0.0.1001-1690000000-000000001",
+				"consensus_timestamp": "1690000001.000000000",
+				"name": "TOKENMINT",
+				"result": "SUCCESS",
+				"charged_tx_fee": 1,
+				"memo_base64": null,
+				"transfers": []
+			}],
+			"links": {"next": null}
+		}`,
+	})
+	defer server.Close()
+
+	client.SetPageLimit(1)
+
+	transactions, err := client.GetAccountTransactions(context.Background(), AccountID{Account: 1001}).All()
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+
+	first := transactions[0]
+	require.Equal(t, AccountID{Account: 1001}, *first.TransactionID.AccountID)
+	require.Equal(t, time.Unix(1690000000, 123).UTC(), *first.TransactionID.ValidStart)
+	require.Equal(t, time.Unix(1690000002, 456).UTC(), first.ConsensusTimestamp)
+	require.Equal(t, "CRYPTOTRANSFER", first.Name)
+	require.Equal(t, HbarFromTinybar(84000), first.ChargedTxFee)
+	require.Equal(t, []byte("hello"), first.Memo)
+	require.Equal(t, []byte{1, 2, 3}, first.Hash)
+	require.Equal(t, AccountID{Account: 3}, first.NodeAccountID)
+	require.Equal(t, []MirrorTransfer{
+		{AccountID: AccountID{Account: 1001}, Amount: HbarFromTinybar(-100)},
+		{AccountID: AccountID{Account: 1002}, Amount: HbarFromTinybar(100), IsApproval: true},
+	}, first.Transfers)
+	require.Equal(t, []MirrorTokenTransfer{
+		{TokenID: TokenID{Token: 5000}, AccountID: AccountID{Account: 1002}, Amount: 7},
+	}, first.TokenTransfers)
+
+	require.Equal(t, "TOKENMINT", transactions[1].Name)
+	require.Empty(t, transactions[1].Memo)
+}
+
+func TestUnitMirrorRestClientTokenQueries(t *testing.T) {
+	client, server := newMockMirrorRestServer(t, map[string]string{
+		"/api/v1/tokens/0.0.5000/balances": `{
+			"timestamp": "1690000000.000000000",
+			"balances": [{"account": "0.0.1001", "balance": 10, "decimals": 2}],
+			"links": {"next": null}
+		}`,
+		"/api/v1/tokens/0.0.5000/nfts": `{
+			"nfts": [{
+				"account_id": "0.0.1001",
+				"token_id": "0.0.5000",
+				"serial_number": 4,
+				"metadata": "AAE=",
+				"deleted": false,
+				"created_timestamp": "1690000000.000000001"
+			}],
+			"links": {"next": null}
+		}`,
+	})
+	defer server.Close()
+
+	balances, err := client.GetTokenBalances(context.Background(), TokenID{Token: 5000}).All()
+	require.NoError(t, err)
+	require.Equal(t, []MirrorTokenBalance{{AccountID: AccountID{Account: 1001}, Balance: 10, Decimals: 2}}, balances)
+
+	iterator := client.GetTokenNfts(context.Background(), TokenID{Token: 5000})
+	require.True(t, iterator.Next())
+	nft := iterator.Nft()
+	require.Equal(t, NftID{TokenID: TokenID{Token: 5000}, SerialNumber: 4}, nft.NftID)
+	require.Equal(t, AccountID{Account: 1001}, nft.AccountID)
+	require.Equal(t, []byte{0, 1}, nft.Metadata)
+	require.False(t, iterator.Next())
+	require.NoError(t, iterator.Err())
+}
+
+func TestUnitMirrorRestClientContractQueries(t *testing.T) {
+	client, server := newMockMirrorRestServer(t, map[string]string{
+		"/api/v1/contracts/0.0.7000/results": `{
+			"results": [{
+				"contract_id": "0.0.7000",
+				"from": "0x00000000000000000000000000000000000003e9",
+				"to": "0x0000000000000000000000000000000000001b58",
+				"amount": 5,
+				"gas_limit": 300000,
+				"gas_used": 21000,
+				"call_result": "0x0102",
+				"error_message": "",
+				"result": "SUCCESS",
+				"hash": "0xabcd",
+				"timestamp": "1690000000.000000002"
+			}],
+			"links": {"next": null}
+		}`,
+		"/api/v1/contracts/0.0.7000/results/logs": `{
+			"logs": [{
+				"contract_id": "0.0.7000",
+				"bloom": "0x00",
+				"topics": ["0x01", "0x02"],
+				"data": "0xff",
+				"index": 1,
+				"timestamp": "1690000000.000000002",
+				"transaction_hash": "0xabcd",
+				"block_number": 12
+			}],
+			"links": {"next": null}
+		}`,
+	})
+	defer server.Close()
+
+	results, err := client.GetContractResults(context.Background(), ContractID{Contract: 7000}).All()
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, ContractID{Contract: 7000}, results[0].ContractID)
+	require.Equal(t, HbarFromTinybar(5), results[0].Amount)
+	require.Equal(t, uint64(21000), results[0].GasUsed)
+	require.Equal(t, []byte{1, 2}, results[0].CallResult)
+	require.Equal(t, []byte{0xab, 0xcd}, results[0].Hash)
+
+	logs, err := client.GetContractLogs(context.Background(), ContractID{Contract: 7000}).All()
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, ContractLogInfo{
+		ContractID: ContractID{Contract: 7000},
+		Bloom:      []byte{0},
+		Topics:     [][]byte{{1}, {2}},
+		Data:       []byte{0xff},
+	}, logs[0].ContractLogInfo)
+	require.Equal(t, uint64(12), logs[0].BlockNumber)
+}
+
+func TestUnitMirrorRestClientError(t *testing.T) {
+	client, server := newMockMirrorRestServer(t, map[string]string{})
+	defer server.Close()
+
+	_, err := client.GetAccountNfts(context.Background(), AccountID{Account: 1001}).All()
+	require.Error(t, err)
+
+	var restErr ErrMirrorRest
+	require.ErrorAs(t, err, &restErr)
+	require.Equal(t, http.StatusNotFound, restErr.StatusCode)
+	require.Equal(t, []string{"Not found"}, restErr.Messages)
+}
+
+func TestUnitMirrorRestClientFromClient(t *testing.T) {
+	client := ClientForNetwork(map[string]AccountID{})
+
+	_, err := client.GetMirrorRestClient()
+	require.Error(t, err)
+
+	for address, expected := range map[string]string{
+		"testnet.mirrornode.hedera.com:443": "https://testnet.mirrornode.hedera.com/api/v1",
+		"127.0.0.1:5600":                    "http://127.0.0.1:5551/api/v1",
+		"mirror.example.com:8443":           "https://mirror.example.com:8443/api/v1",
+	} {
+		client.SetMirrorNetwork([]string{address})
+		restClient, err := client.GetMirrorRestClient()
+		require.NoError(t, err)
+		require.Equal(t, expected, restClient.GetBaseURL())
+	}
+}
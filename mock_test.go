@@ -25,7 +25,9 @@ package hedera
 
 import (
 	"context"
+	"errors"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -441,6 +443,214 @@ func TestUnitMockSingleTransactionIDForExecutionsWithTimeout(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUnitMockQueryContextDeadline(t *testing.T) {
+	busy := &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY, ResponseType: services.ResponseType_ANSWER_ONLY},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		busy, busy, busy, busy,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetMaxBackoff(10*time.Second).
+		SetMinBackoff(5*time.Second).
+		ExecuteWithContext(ctx, client)
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	var ctxErr ErrContextDone
+	require.True(t, errors.As(err, &ctxErr))
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Equal(t, int64(1), ctxErr.Attempts)
+	require.Equal(t, ErrHederaPreCheckStatus{Status: StatusBusy}, ctxErr.LastError)
+}
+
+func TestUnitMockTransactionContextCanceled(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.Canceled)
+	require.IsType(t, ErrContextDone{}, err)
+	require.Equal(t, int64(0), err.(ErrContextDone).Attempts)
+}
+
+func TestUnitMockTransactionContextDeadlineDuringBackoff(t *testing.T) {
+	busy := &services.TransactionResponse{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+	}
+	responses := [][]interface{}{{
+		busy, busy, busy, busy,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		SetMaxBackoff(10*time.Second).
+		SetMinBackoff(5*time.Second).
+		ExecuteWithContext(ctx, client)
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	var ctxErr ErrContextDone
+	require.True(t, errors.As(err, &ctxErr))
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Equal(t, int64(1), ctxErr.Attempts)
+	require.Equal(t, StatusBusy, ctxErr.LastError.(ErrHederaPreCheckStatus).Status)
+}
+
+// _BlockingSigner signs only once its context is done, and then fails with the error of the context.
+type _BlockingSigner struct {
+	publicKey PublicKey
+}
+
+func (signer _BlockingSigner) GetPublicKey() PublicKey {
+	return signer.publicKey
+}
+
+func (signer _BlockingSigner) Sign(ctx context.Context, _ []byte) ([]byte, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestUnitMockTransactionContextDeadlineDuringSigning(t *testing.T) {
+	call := func(request *services.Transaction) *services.TransactionResponse {
+		require.Fail(t, "a transaction which could not be signed was sent")
+		return &services.TransactionResponse{}
+	}
+	responses := [][]interface{}{{
+		call,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	transaction.SignWithSigner(_BlockingSigner{key.PublicKey()})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = transaction.ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.IsType(t, ErrContextDone{}, err)
+	require.Equal(t, int64(0), err.(ErrContextDone).Attempts)
+}
+
+func TestUnitMockTransactionSignedOncePerTransactionID(t *testing.T) {
+	busy := &services.TransactionResponse{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+	}
+	expired := &services.TransactionResponse{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum_TRANSACTION_EXPIRED,
+	}
+	ok := &services.TransactionResponse{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+	}
+	responses := [][]interface{}{{
+		busy, busy, expired, busy, ok,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	signer := _NewCountingSigner(t)
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		SetMinBackoff(time.Millisecond).
+		SetMaxBackoff(time.Millisecond).
+		FreezeWith(client)
+	require.NoError(t, err)
+	transaction.SignWithSigner(signer)
+
+	_, err = transaction.Execute(client)
+	require.NoError(t, err)
+
+	// once for the first transaction ID and once for the one regenerated after TRANSACTION_EXPIRED
+	require.Len(t, signer.batches, 2)
+	require.NotEqual(t, signer.batches[0], signer.batches[1])
+}
+
+func TestUnitMockQueryContextDeadlineWithoutHealthyNodes(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.Response{},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.SetMinNodeReadmitTime(time.Hour)
+	client.SetMaxNodeReadmitTime(time.Hour)
+	client.SetNodeMinBackoff(time.Hour)
+	client.SetNodeMaxBackoff(time.Hour)
+	node, ok := client.network._GetNodeForAccountID(AccountID{Account: 3})
+	require.True(t, ok)
+	client.network._IncreaseBackoff(node)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewAccountBalanceQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		ExecuteWithContext(ctx, client)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.IsType(t, ErrContextDone{}, err)
+	require.Equal(t, int64(0), err.(ErrContextDone).Attempts)
+
+	_, err = NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.IsType(t, ErrContextDone{}, err)
+}
+
 type MockServers struct {
 	servers []*MockServer
 }
@@ -459,6 +669,7 @@ func NewMockClientAndServer(allNodeResponses [][]interface{}) (*Client, *MockSer
 	servers := make([]*MockServer, len(allNodeResponses))
 	ctx, cancel := context.WithCancel(context.Background())
 	client := &Client{
+		mu:                              &sync.RWMutex{},
 		defaultMaxQueryPayment:          NewHbar(1),
 		network:                         _NewNetwork(),
 		mirrorNetwork:                   _NewMirrorNetwork(),
@@ -476,12 +687,7 @@ func NewMockClientAndServer(allNodeResponses [][]interface{}) (*Client, *MockSer
 		responses := responses
 
 		nodeAccountID := AccountID{Account: uint64(3 + i)}
-		go func() {
-			servers[i] = NewMockServer(responses)
-		}()
-
-		for servers[i] == nil {
-		}
+		servers[i] = NewMockServer(responses)
 
 		network[servers[i].listener.Addr().String()] = nodeAccountID
 		mirrorNetwork[i] = servers[i].listener.Addr().String()
@@ -700,12 +906,16 @@ func TestUnitMockAccountInfoQueryNoNodeSet(t *testing.T) {
 
 func NewMockHandler(responses []interface{}) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
 	index := 0
+	var mu sync.Mutex
 	return func(_srv interface{}, _ctx context.Context, dec func(interface{}) error, _interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		mu.Lock()
 		if index >= len(responses) {
+			mu.Unlock()
 			return nil, status.New(codes.Aborted, "No response found").Err()
 		}
 		response := responses[index]
 		index = index + 1
+		mu.Unlock()
 
 		switch response := response.(type) {
 		case error:
@@ -775,6 +985,7 @@ func NewMockServer(responses []interface{}) (server *MockServer) {
 	server.server.RegisterService(NewServiceDescription(handler, &services.FreezeService_ServiceDesc), nil)
 	server.server.RegisterService(NewServiceDescription(handler, &services.NetworkService_ServiceDesc), nil)
 	server.server.RegisterService(NewMirrorServiceDescription(streamHandler, &mirror.NetworkService_ServiceDesc), nil)
+	server.server.RegisterService(NewMirrorServiceDescription(streamHandler, &mirror.ConsensusService_ServiceDesc), nil)
 
 	server.listener, err = net.Listen("tcp", "localhost:0")
 	if err != nil {
@@ -782,7 +993,7 @@ func NewMockServer(responses []interface{}) (server *MockServer) {
 	}
 
 	go func() {
-		if err = server.server.Serve(server.listener); err != nil {
+		if err = server.server.Serve(server.listener); err != nil && err != grpc.ErrServerStopped {
 			panic(err)
 		}
 	}()
@@ -21,12 +21,17 @@ package hedera
  */
 
 import (
+	"context"
+	"sort"
 	"time"
 )
 
 type _Network struct {
 	_ManagedNetwork
-	addressBook map[AccountID]NodeAddress
+	// addressBook holds the cert hashes the nodes' certificates are pinned to, whichever ledger
+	// the book is for. Both fields are guarded by mu.
+	addressBook     map[AccountID]NodeAddress
+	requireCertHash bool
 }
 
 func _NewNetwork() _Network {
@@ -37,6 +42,14 @@ func _NewNetwork() _Network {
 }
 
 func (network *_Network) SetNetwork(net map[string]AccountID) (err error) {
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
+
+	return network._SetNetworkLocked(net)
+}
+
+// _SetNetworkLocked expects the caller to hold mu for writing
+func (network *_Network) _SetNetworkLocked(net map[string]AccountID) (err error) {
 	newNetwork := make(map[string]_IManagedNode)
 
 	for url, id := range net {
@@ -47,10 +60,66 @@ func (network *_Network) SetNetwork(net map[string]AccountID) (err error) {
 		newNetwork[url] = node
 	}
 
-	return network._ManagedNetwork._SetNetwork(newNetwork)
+	if err := network._ManagedNetwork._SetNetworkLocked(newNetwork); err != nil {
+		return err
+	}
+
+	network._ApplyAddressBookLocked()
+	return nil
+}
+
+// _ApplyAddressBookLocked gives every node its entry of the address book, or none when the book has
+// no cert hash for it. It expects the caller to hold mu for writing.
+func (network *_Network) _ApplyAddressBookLocked() {
+	for _, node := range network._ManagedNetwork.nodes {
+		if node, ok := node.(*_Node); ok {
+			var addressBook *NodeAddress
+			if entry, ok := network.addressBook[node.accountID]; ok && len(entry.CertHash) > 0 {
+				addressBook = &entry
+			}
+
+			node._SetAddressBook(addressBook)
+			node._SetRequireCertHash(network.requireCertHash)
+		}
+	}
+}
+
+// _GetAddressBook returns the address book of the network ordered by account ID.
+func (network *_Network) _GetAddressBook() NodeAddressBook {
+	network._Mu().RLock()
+	defer network._Mu().RUnlock()
+
+	book := NodeAddressBook{NodeAddresses: make([]NodeAddress, 0, len(network.addressBook))}
+	for _, address := range network.addressBook {
+		book.NodeAddresses = append(book.NodeAddresses, address)
+	}
+
+	sort.Slice(book.NodeAddresses, func(i, j int) bool {
+		return book.NodeAddresses[i].AccountID.Compare(*book.NodeAddresses[j].AccountID) < 0
+	})
+
+	return book
+}
+
+func (network *_Network) _SetRequireCertHash(require bool) {
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
+
+	network.requireCertHash = require
+	network._ApplyAddressBookLocked()
+}
+
+func (network *_Network) _GetRequireCertHash() bool {
+	network._Mu().RLock()
+	defer network._Mu().RUnlock()
+
+	return network.requireCertHash
 }
 
 func (network *_Network) _GetNetwork() map[string]AccountID {
+	network._Mu().RLock()
+	defer network._Mu().RUnlock()
+
 	temp := make(map[string]AccountID)
 	for _, node := range network._ManagedNetwork.nodes {
 		switch n := node.(type) { //nolint
@@ -65,7 +134,11 @@ func (network *_Network) _GetNetwork() map[string]AccountID {
 func (network *_Network) _IncreaseBackoff(node *_Node) {
 	node._IncreaseBackoff()
 
-	index := 0
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
+
+	// The node may already have been removed by a concurrent execution
+	index := -1
 	for i, healthyNode := range network.healthyNodes {
 		if node == healthyNode {
 			index = i
@@ -79,14 +152,31 @@ func (network *_Network) _IncreaseBackoff(node *_Node) {
 }
 
 func (network *_Network) _GetNodeForAccountID(id AccountID) (*_Node, bool) {
-	node, ok := network.network[id.String()]
-	return node[0].(*_Node), ok
+	network._Mu().RLock()
+	defer network._Mu().RUnlock()
+
+	nodes, ok := network.network[id.String()]
+	if !ok || len(nodes) == 0 {
+		return nil, false
+	}
+
+	node, ok := nodes[0].(*_Node)
+	return node, ok
 }
 
 func (network *_Network) _GetNode() *_Node {
 	return network._ManagedNetwork._GetNode().(*_Node)
 }
 
+func (network *_Network) _GetNodeWithContext(ctx context.Context) (*_Node, error) {
+	node, err := network._ManagedNetwork._GetNodeWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return node.(*_Node), nil
+}
+
 func (network *_Network) _GetNetworkName() *NetworkName {
 	if network._ManagedNetwork._GetLedgerID() != nil {
 		temp, _ := network._ManagedNetwork._GetLedgerID().ToNetworkName()
@@ -105,9 +195,13 @@ func (network *_Network) _GetLedgerID() *LedgerID {
 }
 
 func (network *_Network) _SetLedgerID(id LedgerID) {
-	network._ManagedNetwork._SetLedgerID(id)
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
+
+	network.ledgerID = &id
 
-	if network._ManagedNetwork.transportSecurity && network.ledgerID != nil {
+	// The embedded books of the public networks are used until an address book update replaces them
+	if network._ManagedNetwork.transportSecurity {
 		switch {
 		case id.IsMainnet():
 			network.addressBook = mainnetAddressBook._ToMap()
@@ -116,24 +210,9 @@ func (network *_Network) _SetLedgerID(id LedgerID) {
 		case id.IsPreviewnet():
 			network.addressBook = previewnetAddressBook._ToMap()
 		}
-
-		if network.addressBook != nil {
-			for _, node := range network._ManagedNetwork.nodes {
-				if node, ok := node.(*_Node); ok {
-					temp := network.addressBook[node.accountID]
-					node.addressBook = &temp
-				}
-			}
-			for _, nodes := range network._ManagedNetwork.network {
-				for _, node := range nodes {
-					if node, ok := node.(*_Node); ok {
-						temp := network.addressBook[node.accountID]
-						node.addressBook = &temp
-					}
-				}
-			}
-		}
 	}
+
+	network._ApplyAddressBookLocked()
 }
 
 func (network *_Network) _SetNetworkName(net NetworkName) {
@@ -146,9 +225,50 @@ func (network *_Network) _SetNetworkName(net NetworkName) {
 }
 
 func (network *_Network) _GetNodeAccountIDsForExecute() []AccountID { //nolint
-	nodes := make([]AccountID, 0)
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
+
+	network._ReadmitNodes()
+
+	return network._GetNodeAccountIDsForExecuteLocked()
+}
+
+// _GetNodeAccountIDsForExecuteWithContext is _GetNodeAccountIDsForExecute waiting for a node to be
+// readmitted while none is healthy, until ctx is done.
+func (network *_Network) _GetNodeAccountIDsForExecuteWithContext(ctx context.Context) ([]AccountID, error) {
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
 
-	for i := 0; i < network._GetNumberOfNodesForTransaction(); i++ {
+	if err := network._WaitForHealthyNodesLocked(ctx); err != nil {
+		return nil, err
+	}
+
+	return network._GetNodeAccountIDsForExecuteLocked(), nil
+}
+
+// _GetNodeAccountIDs returns the account IDs of every node of the network, healthy or not, in the
+// order the nodes were added.
+func (network *_Network) _GetNodeAccountIDs() []AccountID {
+	network._Mu().RLock()
+	defer network._Mu().RUnlock()
+
+	seen := make(map[string]bool)
+	nodeAccountIDs := make([]AccountID, 0, len(network.nodes))
+	for _, node := range network.nodes {
+		accountID := node.(*_Node).accountID
+		if !seen[accountID.String()] {
+			seen[accountID.String()] = true
+			nodeAccountIDs = append(nodeAccountIDs, accountID)
+		}
+	}
+
+	return nodeAccountIDs
+}
+
+// _GetNodeAccountIDsForExecuteLocked expects the caller to hold mu
+func (network *_Network) _GetNodeAccountIDsForExecuteLocked() []AccountID {
+	nodes := make([]AccountID, 0)
+	for i := 0; i < network._GetNumberOfNodesForTransactionLocked() && i < len(network.healthyNodes); i++ {
 		nodes = append(nodes, network.healthyNodes[i].(*_Node).accountID)
 	}
 
@@ -185,6 +305,11 @@ func (network *_Network) _GetNodeMaxBackoff() time.Duration {
 
 func (network *_Network) _SetTransportSecurity(transportSecurity bool) *_Network {
 	_ = network._ManagedNetwork._SetTransportSecurity(transportSecurity)
+
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
+
+	network._ApplyAddressBookLocked()
 	return network
 }
 
@@ -205,11 +330,11 @@ func (network *_Network) _SetNodeMaxReadmitPeriod(period time.Duration) {
 }
 
 func (network *_Network) _GetNodeMinReadmitPeriod() time.Duration {
-	return network._ManagedNetwork.minNodeReadmitPeriod
+	return network._ManagedNetwork._GetMinNodeReadmitPeriod()
 }
 
 func (network *_Network) _GetNodeMaxReadmitPeriod() time.Duration {
-	return network._ManagedNetwork.maxNodeReadmitPeriod
+	return network._ManagedNetwork._GetMaxNodeReadmitPeriod()
 }
 
 func (network *_Network) Close() error {
@@ -246,10 +371,14 @@ func _NetworkForPreviewnet(nodeAddresses map[AccountID]NodeAddress) *_Network {
 }
 
 func (network *_Network) _SetNetworkFromAddressBook(addressBook NodeAddressBook) {
+	network._Mu().Lock()
+	defer network._Mu().Unlock()
+
 	network.addressBook = addressBook._ToMap()
-	_ = network.SetNetwork(network._ToNet())
+	_ = network._SetNetworkLocked(network._ToNet())
 }
 
+// _ToNet expects the caller to hold mu, or the network not to be shared yet
 func (network *_Network) _ToNet() map[string]AccountID {
 	newNetwork := make(map[string]AccountID)
 	for accountID, node := range network.addressBook {
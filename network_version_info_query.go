@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -44,7 +45,13 @@ func (query *NetworkVersionInfoQuery) SetGrpcDeadline(deadline *time.Duration) *
 }
 
 func (query *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *NetworkVersionInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 	pb := services.Query_NetworkGetVersionInfo{
@@ -57,6 +64,7 @@ func (query *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_NetworkVersionInfoQueryShouldRetry,
@@ -81,8 +89,8 @@ func (query *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _NetworkVersionInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetNetworkGetVersionInfo().Header.NodeTransactionPrecheckCode))
+func _NetworkVersionInfoQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetNetworkGetVersionInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _NetworkVersionInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -98,14 +106,20 @@ func _NetworkVersionInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method
 }
 
 func (query *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInfo, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the NetworkVersionInfoQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *NetworkVersionInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (NetworkVersionInfo, error) {
+	if client == nil || client._GetOperator() == nil {
 		return NetworkVersionInfo{}, errNoClientProvided
 	}
 
 	var err error
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -118,7 +132,7 @@ func (query *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInf
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return NetworkVersionInfo{}, err
 		}
@@ -137,12 +151,12 @@ func (query *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInf
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return NetworkVersionInfo{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return NetworkVersionInfo{}, err
 		}
@@ -159,6 +173,7 @@ func (query *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInf
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_NetworkVersionInfoQueryShouldRetry,
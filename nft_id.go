@@ -62,7 +62,7 @@ func NftIDFromString(s string) (NftID, error) {
 }
 
 func (id *NftID) Validate(client *Client) error {
-	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
+	if !id._IsZero() && client != nil && client.network._ManagedNetwork._GetLedgerID() != nil {
 		if err := id.TokenID.ValidateChecksum(client); err != nil {
 			return err
 		}
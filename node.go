@@ -27,6 +27,8 @@ import (
 	"crypto/x509"
 	"encoding/hex"
 	"encoding/pem"
+	"strings"
+	"sync"
 	"time"
 
 	"context"
@@ -40,10 +42,15 @@ import (
 
 type _Node struct {
 	*_ManagedNode
+	// mu guards addressBook, verifyCertificate and requireCertHash, channelMu guards channel. They are
+	// separate so the TLS handshake, which reads the address book, can run while the channel is being dialed.
+	mu                sync.RWMutex
+	channelMu         sync.Mutex
 	accountID         AccountID
 	channel           *_Channel
 	addressBook       *NodeAddress
 	verifyCertificate bool
+	requireCertHash   bool
 }
 
 func _NewNode(accountID AccountID, address string, minBackoff time.Duration) (node *_Node, err error) {
@@ -119,7 +126,10 @@ func (node *_Node) _GetReadmitTime() *time.Time {
 	return node._ManagedNode._GetReadmitTime()
 }
 
-func (node *_Node) _GetChannel() (*_Channel, error) {
+func (node *_Node) _GetChannel(logger Logger) (*_Channel, error) {
+	node.channelMu.Lock()
+	defer node.channelMu.Unlock()
+
 	if node.channel != nil {
 		return node.channel, nil
 	}
@@ -133,52 +143,15 @@ func (node *_Node) _GetChannel() (*_Channel, error) {
 	var conn *grpc.ClientConn
 	var err error
 	security := grpc.WithInsecure() //nolint
-	if !node.verifyCertificate {
-		println("skipping certificate check")
+	if !node._ManagedNode.address._IsTransportSecurity() && node._GetRequireCertHash() {
+		logger.Error("refusing a plaintext connection to a node which requires a cert hash", "nodeAccountID", node.accountID.String())
+		return nil, errCertHashRequiresTLS
+	}
+	if !node._GetVerifyCertificate() && !node._GetRequireCertHash() {
+		logger.Warn("skipping certificate check", "nodeAccountID", node.accountID.String())
 	}
 	if node._ManagedNode.address._IsTransportSecurity() {
-		security = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
-			InsecureSkipVerify: true, // nolint
-			VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
-				if node.addressBook == nil {
-					logCtx.Warn().Msg("skipping certificate check since no cert hash was found")
-					return nil
-				}
-
-				if !node.verifyCertificate {
-					return nil
-				}
-
-				for _, cert := range rawCerts {
-					var certHash []byte
-
-					block := &pem.Block{
-						Type:  "CERTIFICATE",
-						Bytes: cert,
-					}
-
-					var encodedBuf bytes.Buffer
-					_ = pem.Encode(&encodedBuf, block)
-					digest := sha512.New384()
-
-					if _, err = digest.Write(encodedBuf.Bytes()); err != nil {
-						return err
-					}
-
-					certHash = digest.Sum(nil)
-
-					if string(node.addressBook.CertHash) == hex.EncodeToString(certHash) {
-						return nil
-					}
-				}
-
-				return x509.CertificateInvalidError{
-					Cert:   nil,
-					Reason: x509.Expired,
-					Detail: "",
-				}
-			},
-		}))
+		security = grpc.WithTransportCredentials(credentials.NewTLS(node._TLSConfig(logger)))
 	}
 
 	cont, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -194,7 +167,61 @@ func (node *_Node) _GetChannel() (*_Channel, error) {
 	return node.channel, nil
 }
 
+// _TLSConfig pins the certificate of the node to the cert hash in its address book entry. The chain
+// itself is not verified, nodes present self-signed certificates.
+func (node *_Node) _TLSConfig(logger Logger) *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: true, // nolint
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return node._VerifyCertificate(logger, rawCerts)
+		},
+	}
+}
+
+// _VerifyCertificate accepts rawCerts if one of them hashes to the cert hash of the node. Without a
+// cert hash the check is skipped, unless the node requires one. A node requiring a cert hash is
+// checked even when certificate verification is turned off.
+func (node *_Node) _VerifyCertificate(logger Logger, rawCerts [][]byte) error {
+	if !node._GetVerifyCertificate() && !node._GetRequireCertHash() {
+		return nil
+	}
+
+	addressBook := node._GetAddressBook()
+	if addressBook == nil || len(addressBook.CertHash) == 0 {
+		if node._GetRequireCertHash() {
+			logger.Error("rejecting certificate since no cert hash was found", "nodeAccountID", node.accountID.String())
+			return errNoCertHash
+		}
+
+		logger.Warn("skipping certificate check since no cert hash was found", "nodeAccountID", node.accountID.String())
+		return nil
+	}
+
+	for _, cert := range rawCerts {
+		var encodedBuf bytes.Buffer
+		_ = pem.Encode(&encodedBuf, &pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: cert,
+		})
+
+		certHash := sha512.Sum384(encodedBuf.Bytes())
+
+		// Address books carry the hash hex encoded, accept the raw hash as well.
+		if strings.EqualFold(string(addressBook.CertHash), hex.EncodeToString(certHash[:])) ||
+			bytes.Equal(addressBook.CertHash, certHash[:]) {
+			return nil
+		}
+	}
+
+	logger.Error("rejecting certificate which does not match the cert hash", "nodeAccountID", node.accountID.String())
+
+	return errCertHashMismatch
+}
+
 func (node *_Node) _Close() error {
+	node.channelMu.Lock()
+	defer node.channelMu.Unlock()
+
 	if node.channel != nil {
 		err := node.channel.client.Close()
 		node.channel = nil
@@ -205,49 +232,77 @@ func (node *_Node) _Close() error {
 }
 
 func (node *_Node) _ToSecure() _IManagedNode {
-	managed := _ManagedNode{
-		address:            node.address._ToSecure(),
-		currentBackoff:     node.currentBackoff,
-		lastUsed:           node.lastUsed,
-		readmitTime:        node.readmitTime,
-		useCount:           node.useCount,
-		minBackoff:         node.minBackoff,
-		badGrpcStatusCount: node.badGrpcStatusCount,
-	}
+	managed := node._ManagedNode._WithAddress(node.address._ToSecure())
+
+	node.channelMu.Lock()
+	channel := node.channel
+	node.channelMu.Unlock()
 
 	return &_Node{
-		_ManagedNode:      &managed,
+		_ManagedNode:      managed,
 		accountID:         node.accountID,
-		channel:           node.channel,
-		addressBook:       node.addressBook,
-		verifyCertificate: node.verifyCertificate,
+		channel:           channel,
+		addressBook:       node._GetAddressBook(),
+		verifyCertificate: node._GetVerifyCertificate(),
+		requireCertHash:   node._GetRequireCertHash(),
 	}
 }
 
 func (node *_Node) _ToInsecure() _IManagedNode {
-	managed := _ManagedNode{
-		address:            node.address._ToInsecure(),
-		currentBackoff:     node.currentBackoff,
-		lastUsed:           node.lastUsed,
-		readmitTime:        node.readmitTime,
-		useCount:           node.useCount,
-		minBackoff:         node.minBackoff,
-		badGrpcStatusCount: node.badGrpcStatusCount,
-	}
+	managed := node._ManagedNode._WithAddress(node.address._ToInsecure())
+
+	node.channelMu.Lock()
+	channel := node.channel
+	node.channelMu.Unlock()
 
 	return &_Node{
-		_ManagedNode:      &managed,
+		_ManagedNode:      managed,
 		accountID:         node.accountID,
-		channel:           node.channel,
-		addressBook:       node.addressBook,
-		verifyCertificate: node.verifyCertificate,
+		channel:           channel,
+		addressBook:       node._GetAddressBook(),
+		verifyCertificate: node._GetVerifyCertificate(),
+		requireCertHash:   node._GetRequireCertHash(),
 	}
 }
 
 func (node *_Node) _SetVerifyCertificate(verify bool) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
 	node.verifyCertificate = verify
 }
 
 func (node *_Node) _GetVerifyCertificate() bool {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
 	return node.verifyCertificate
 }
+
+func (node *_Node) _SetRequireCertHash(require bool) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.requireCertHash = require
+}
+
+func (node *_Node) _GetRequireCertHash() bool {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
+	return node.requireCertHash
+}
+
+func (node *_Node) _SetAddressBook(addressBook *NodeAddress) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	node.addressBook = addressBook
+}
+
+func (node *_Node) _GetAddressBook() *NodeAddress {
+	node.mu.RLock()
+	defer node.mu.RUnlock()
+
+	return node.addressBook
+}
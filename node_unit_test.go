@@ -0,0 +1,199 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func _NewUnitCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func _UnitCertHash(certificate tls.Certificate) []byte {
+	hash := sha512.Sum384(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Certificate[0]}))
+	return []byte(hex.EncodeToString(hash[:]))
+}
+
+func _NewUnitNode(t *testing.T, certHash []byte) *_Node {
+	node, err := _NewNode(AccountID{Account: 3}, "127.0.0.1:50212", 250*time.Millisecond)
+	require.NoError(t, err)
+
+	if certHash != nil {
+		node._SetAddressBook(&NodeAddress{AccountID: &AccountID{Account: 3}, CertHash: certHash})
+	}
+
+	return node
+}
+
+func _NewUnitLogger() Logger {
+	logger := _NewDefaultLogger()
+	logger.SetLevel(LoggerLevelDisabled)
+	return logger
+}
+
+func TestUnitNodeVerifyCertificate(t *testing.T) {
+	certificate := _NewUnitCertificate(t)
+	other := _NewUnitCertificate(t)
+	logger := _NewUnitLogger()
+
+	node := _NewUnitNode(t, _UnitCertHash(certificate))
+	require.NoError(t, node._VerifyCertificate(logger, certificate.Certificate))
+	require.Error(t, node._VerifyCertificate(logger, other.Certificate))
+
+	// Hashes are compared case insensitively and may be raw bytes
+	raw, err := hex.DecodeString(string(_UnitCertHash(certificate)))
+	require.NoError(t, err)
+	node._SetAddressBook(&NodeAddress{CertHash: raw})
+	require.NoError(t, node._VerifyCertificate(logger, certificate.Certificate))
+
+	node._SetVerifyCertificate(false)
+	require.NoError(t, node._VerifyCertificate(logger, other.Certificate))
+}
+
+func TestUnitNodeVerifyCertificateWithoutCertHash(t *testing.T) {
+	certificate := _NewUnitCertificate(t)
+	logger := _NewUnitLogger()
+
+	node := _NewUnitNode(t, nil)
+	require.NoError(t, node._VerifyCertificate(logger, certificate.Certificate))
+
+	node._SetAddressBook(&NodeAddress{CertHash: []byte{}})
+	require.NoError(t, node._VerifyCertificate(logger, certificate.Certificate))
+
+	node._SetRequireCertHash(true)
+	require.ErrorIs(t, node._VerifyCertificate(logger, certificate.Certificate), errNoCertHash)
+
+	// Requiring a cert hash is not undone by turning verification off
+	node._SetVerifyCertificate(false)
+	require.ErrorIs(t, node._VerifyCertificate(logger, certificate.Certificate), errNoCertHash)
+
+	node._SetRequireCertHash(false)
+	require.NoError(t, node._VerifyCertificate(logger, certificate.Certificate))
+}
+
+func TestUnitNodeCertHashMismatch(t *testing.T) {
+	certificate := _NewUnitCertificate(t)
+	other := _NewUnitCertificate(t)
+	logger := _NewUnitLogger()
+
+	node := _NewUnitNode(t, _UnitCertHash(certificate))
+	require.ErrorIs(t, node._VerifyCertificate(logger, other.Certificate), errCertHashMismatch)
+
+	node._SetVerifyCertificate(false)
+	node._SetRequireCertHash(true)
+	require.ErrorIs(t, node._VerifyCertificate(logger, other.Certificate), errCertHashMismatch)
+}
+
+func TestUnitNodeCertHashRequiresTLS(t *testing.T) {
+	node, err := _NewNode(AccountID{Account: 3}, "127.0.0.1:50211", 250*time.Millisecond)
+	require.NoError(t, err)
+	node._SetRequireCertHash(true)
+
+	_, err = node._GetChannel(_NewUnitLogger())
+	require.ErrorIs(t, err, errCertHashRequiresTLS)
+
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	defer client.Close()
+	require.False(t, client.GetCertificateVerification())
+
+	client.SetRequireCertificateHash(true)
+	require.True(t, client.GetCertificateVerification())
+	require.Equal(t, map[string]AccountID{"127.0.0.1:50212": {Account: 3}}, client.GetNetwork())
+}
+
+func TestUnitNodeTLSHandshake(t *testing.T) {
+	certificate := _NewUnitCertificate(t)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{certificate}}) // nolint
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	handshake := func(node *_Node) error {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		return tls.Client(conn, node._TLSConfig(_NewUnitLogger())).Handshake()
+	}
+
+	require.NoError(t, handshake(_NewUnitNode(t, _UnitCertHash(certificate))))
+	require.Error(t, handshake(_NewUnitNode(t, _UnitCertHash(_NewUnitCertificate(t)))))
+
+	node := _NewUnitNode(t, nil)
+	require.NoError(t, handshake(node))
+	node._SetRequireCertHash(true)
+	require.Error(t, handshake(node))
+}
+
+func TestUnitNodeToSecureKeepsCertificatePinning(t *testing.T) {
+	node := _NewUnitNode(t, []byte("abcd"))
+	node._SetRequireCertHash(true)
+
+	secure := node._ToSecure().(*_Node)
+	require.Equal(t, []byte("abcd"), secure._GetAddressBook().CertHash)
+	require.True(t, secure._GetRequireCertHash())
+
+	insecure := secure._ToInsecure().(*_Node)
+	require.True(t, insecure._GetRequireCertHash())
+}
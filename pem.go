@@ -0,0 +1,527 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/sha1" // nolint
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"hash"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// PEM encoding of private keys as (encrypted) PKCS#8 and public keys as SubjectPublicKeyInfo.
+// ECDSA keys use the standard id-ecPublicKey encoding with the secp256k1 curve, which is what
+// OpenSSL produces; the shorter encoding used by StringDer() is also accepted when parsing.
+
+const _PemPrivateKeyType = "PRIVATE KEY"
+const _PemEncryptedPrivateKeyType = "ENCRYPTED PRIVATE KEY"
+const _PemECPrivateKeyType = "EC PRIVATE KEY"
+const _PemPublicKeyType = "PUBLIC KEY"
+
+// PBES2 parameters used when encrypting, PBKDF2 with HMAC-SHA256 and AES-256-CBC
+const _PemIterationCount = 262144
+const _PemSaltLength = 16
+
+// Upper bounds on key derivation parameters read from encrypted keys, so a crafted file cannot pin the
+// CPU or allocate gigabytes. They leave ample room above what the SDK, OpenSSL and geth write.
+const _KdfMaxPbkdf2Iterations = 10_000_000
+const _KdfMaxScryptMemory = 256 << 20
+const _KdfMaxScryptParallelization = 16
+const _KdfMaxKeyLength = 64
+
+var (
+	_OidEd25519        = asn1.ObjectIdentifier{1, 3, 101, 112}
+	_OidEcPublicKey    = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	_OidSecp256k1      = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	_OidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	_OidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	_OidScrypt         = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11591, 4, 11}
+	_OidHmacWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	_OidHmacWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	_OidHmacWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	_OidHmacWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+	_OidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	_OidAES192CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	_OidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type _Pkcs8PrivateKeyInfo struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// _Sec1PrivateKey is the ECPrivateKey structure of RFC 5915
+type _Sec1PrivateKey struct {
+	Version    int
+	PrivateKey []byte
+	Curve      asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey  asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+type _Pkcs8EncryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type _Pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type _Pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+type _ScryptParams struct {
+	Salt                     []byte
+	CostParameter            int
+	BlockSize                int
+	ParallelizationParameter int
+	KeyLength                int `asn1:"optional"`
+}
+
+type _SubjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+func _PrivateKeyFromPem(data []byte, passphrase string) (PrivateKey, error) {
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return PrivateKey{}, _NewErrBadKeyf("pem file did not contain a private key")
+		}
+
+		switch block.Type {
+		case _PemPrivateKeyType:
+			return _PrivateKeyFromPkcs8(block.Bytes)
+		case _PemEncryptedPrivateKeyType:
+			if len(passphrase) == 0 {
+				return PrivateKey{}, _NewErrBadKeyf("pem private key is encrypted but no passphrase was given")
+			}
+
+			der, err := _Pkcs8Decrypt(block.Bytes, passphrase)
+			if err != nil {
+				return PrivateKey{}, err
+			}
+
+			return _PrivateKeyFromPkcs8(der)
+		case _PemECPrivateKeyType:
+			return _PrivateKeyFromSec1(block.Bytes, nil)
+		}
+
+		data = rest
+	}
+}
+
+func _PrivateKeyToPem(key PrivateKey, passphrase string) ([]byte, error) {
+	der, err := _PrivateKeyToPkcs8(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(passphrase) == 0 {
+		return pem.EncodeToMemory(&pem.Block{Type: _PemPrivateKeyType, Bytes: der}), nil
+	}
+
+	encrypted, err := _Pkcs8Encrypt(der, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: _PemEncryptedPrivateKeyType, Bytes: encrypted}), nil
+}
+
+func _PrivateKeyToPkcs8(key PrivateKey) ([]byte, error) {
+	if key.ed25519PrivateKey != nil {
+		seed, err := asn1.Marshal(key.ed25519PrivateKey._BytesRaw())
+		if err != nil {
+			return nil, err
+		}
+
+		return asn1.Marshal(_Pkcs8PrivateKeyInfo{
+			Algorithm:  pkix.AlgorithmIdentifier{Algorithm: _OidEd25519},
+			PrivateKey: seed,
+		})
+	}
+
+	if key.ecdsaPrivateKey != nil {
+		curve, err := asn1.Marshal(_OidSecp256k1)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey := crypto.FromECDSAPub(&key.ecdsaPrivateKey.keyData.PublicKey)
+		sec1, err := asn1.Marshal(_Sec1PrivateKey{
+			Version:    1,
+			PrivateKey: key.ecdsaPrivateKey._BytesRaw(),
+			PublicKey:  asn1.BitString{Bytes: publicKey, BitLength: len(publicKey) * 8},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return asn1.Marshal(_Pkcs8PrivateKeyInfo{
+			Algorithm:  pkix.AlgorithmIdentifier{Algorithm: _OidEcPublicKey, Parameters: asn1.RawValue{FullBytes: curve}},
+			PrivateKey: sec1,
+		})
+	}
+
+	return nil, errors.New("key type not supported, only ed25519 and ECDSASecp256K1 are supported right now")
+}
+
+func _PrivateKeyFromPkcs8(der []byte) (PrivateKey, error) {
+	var info _Pkcs8PrivateKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return PrivateKey{}, _NewErrBadKeyf("invalid PKCS#8 private key")
+	}
+
+	switch {
+	case info.Algorithm.Algorithm.Equal(_OidEd25519):
+		var seed []byte
+		if _, err := asn1.Unmarshal(info.PrivateKey, &seed); err != nil || len(seed) != ed25519.SeedSize {
+			return PrivateKey{}, _NewErrBadKeyf("invalid ed25519 private key")
+		}
+
+		return PrivateKeyFromBytesEd25519(seed)
+	case info.Algorithm.Algorithm.Equal(_OidSecp256k1):
+		// the short encoding produced by StringDer()
+		var raw []byte
+		if _, err := asn1.Unmarshal(info.PrivateKey, &raw); err != nil {
+			return PrivateKey{}, _NewErrBadKeyf("invalid ECDSA private key")
+		}
+
+		return PrivateKeyFromBytesECDSA(raw)
+	case info.Algorithm.Algorithm.Equal(_OidEcPublicKey):
+		var curve asn1.ObjectIdentifier
+		if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &curve); err != nil {
+			return PrivateKey{}, _NewErrBadKeyf("invalid ECDSA curve parameters")
+		}
+
+		return _PrivateKeyFromSec1(info.PrivateKey, curve)
+	}
+
+	return PrivateKey{}, _NewErrBadKeyf("unsupported private key algorithm: %v", info.Algorithm.Algorithm)
+}
+
+// _PrivateKeyFromSec1 parses an ECPrivateKey, the curve comes from the enclosing PKCS#8 structure
+// or, when that is nil, from the ECPrivateKey itself.
+func _PrivateKeyFromSec1(der []byte, curve asn1.ObjectIdentifier) (PrivateKey, error) {
+	var key _Sec1PrivateKey
+	if _, err := asn1.Unmarshal(der, &key); err != nil {
+		return PrivateKey{}, _NewErrBadKeyf("invalid EC private key")
+	}
+
+	if curve == nil {
+		curve = key.Curve
+	}
+
+	if !curve.Equal(_OidSecp256k1) {
+		return PrivateKey{}, _NewErrBadKeyf("unsupported EC curve: %v, only secp256k1 is supported", curve)
+	}
+
+	if len(key.PrivateKey) > 32 {
+		return PrivateKey{}, _NewErrBadKeyf("invalid private key length: %v bytes", len(key.PrivateKey))
+	}
+
+	return PrivateKeyFromBytesECDSA(common.LeftPadBytes(key.PrivateKey, 32))
+}
+
+func _PublicKeyFromPem(data []byte) (PublicKey, error) {
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return PublicKey{}, _NewErrBadKeyf("pem file did not contain a public key")
+		}
+
+		if block.Type == _PemPublicKeyType {
+			return _PublicKeyFromSpki(block.Bytes)
+		}
+
+		data = rest
+	}
+}
+
+func _PublicKeyToSpki(key PublicKey) []byte {
+	var info _SubjectPublicKeyInfo
+
+	switch {
+	case key.ed25519PublicKey != nil:
+		raw := key.ed25519PublicKey._BytesRaw()
+		info = _SubjectPublicKeyInfo{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: _OidEd25519},
+			PublicKey: asn1.BitString{Bytes: raw, BitLength: len(raw) * 8},
+		}
+	case key.ecdsaPublicKey != nil:
+		curve, _ := asn1.Marshal(_OidSecp256k1)
+		raw := crypto.FromECDSAPub(key.ecdsaPublicKey.PublicKey)
+		info = _SubjectPublicKeyInfo{
+			Algorithm: pkix.AlgorithmIdentifier{Algorithm: _OidEcPublicKey, Parameters: asn1.RawValue{FullBytes: curve}},
+			PublicKey: asn1.BitString{Bytes: raw, BitLength: len(raw) * 8},
+		}
+	default:
+		return []byte{}
+	}
+
+	der, _ := asn1.Marshal(info)
+	return der
+}
+
+func _PublicKeyFromSpki(der []byte) (PublicKey, error) {
+	var info _SubjectPublicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return PublicKey{}, _NewErrBadKeyf("invalid SubjectPublicKeyInfo")
+	}
+
+	raw := info.PublicKey.RightAlign()
+
+	switch {
+	case info.Algorithm.Algorithm.Equal(_OidEd25519):
+		return PublicKeyFromBytesEd25519(raw)
+	case info.Algorithm.Algorithm.Equal(_OidSecp256k1):
+		// the short encoding produced by StringDer() wraps the compressed point in an octet string
+		var point []byte
+		if _, err := asn1.Unmarshal(raw, &point); err != nil {
+			return PublicKey{}, _NewErrBadKeyf("invalid ECDSA public key")
+		}
+
+		return PublicKeyFromBytesECDSA(point)
+	case info.Algorithm.Algorithm.Equal(_OidEcPublicKey):
+		var curve asn1.ObjectIdentifier
+		if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(_OidSecp256k1) {
+			return PublicKey{}, _NewErrBadKeyf("unsupported EC curve, only secp256k1 is supported")
+		}
+
+		if len(raw) == 65 {
+			key, err := crypto.UnmarshalPubkey(raw)
+			if err != nil {
+				return PublicKey{}, err
+			}
+			raw = crypto.CompressPubkey(key)
+		}
+
+		return PublicKeyFromBytesECDSA(raw)
+	}
+
+	return PublicKey{}, _NewErrBadKeyf("unsupported public key algorithm: %v", info.Algorithm.Algorithm)
+}
+
+func _Pkcs8Encrypt(der []byte, passphrase string) ([]byte, error) {
+	salt, err := _RandomBytes(_PemSaltLength)
+	if err != nil {
+		return nil, err
+	}
+
+	iv, err := _RandomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), salt, _PemIterationCount, 32, sha256.New)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	padding := aes.BlockSize - len(der)%aes.BlockSize
+	plainText := append(append([]byte{}, der...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipherText := make([]byte, len(plainText))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(cipherText, plainText)
+
+	kdfParams, err := asn1.Marshal(_Pbkdf2Params{
+		Salt:           salt,
+		IterationCount: _PemIterationCount,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: _OidHmacWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ivParams, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+
+	pbes2Params, err := asn1.Marshal(_Pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: _OidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: _OidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParams}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(_Pkcs8EncryptedPrivateKeyInfo{
+		Algorithm:     pkix.AlgorithmIdentifier{Algorithm: _OidPBES2, Parameters: asn1.RawValue{FullBytes: pbes2Params}},
+		EncryptedData: cipherText,
+	})
+}
+
+func _Pkcs8Decrypt(der []byte, passphrase string) ([]byte, error) {
+	var info _Pkcs8EncryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, _NewErrBadKeyf("invalid encrypted PKCS#8 private key")
+	}
+
+	if !info.Algorithm.Algorithm.Equal(_OidPBES2) {
+		return nil, _NewErrBadKeyf("unsupported encryption algorithm: %v, only PBES2 is supported", info.Algorithm.Algorithm)
+	}
+
+	var params _Pbes2Params
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, _NewErrBadKeyf("invalid PBES2 parameters")
+	}
+
+	var keyLength int
+	switch {
+	case params.EncryptionScheme.Algorithm.Equal(_OidAES128CBC):
+		keyLength = 16
+	case params.EncryptionScheme.Algorithm.Equal(_OidAES192CBC):
+		keyLength = 24
+	case params.EncryptionScheme.Algorithm.Equal(_OidAES256CBC):
+		keyLength = 32
+	default:
+		return nil, _NewErrBadKeyf("unsupported cipher: %v", params.EncryptionScheme.Algorithm)
+	}
+
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil || len(iv) != aes.BlockSize {
+		return nil, _NewErrBadKeyf("invalid cipher parameters")
+	}
+
+	key, err := _Pkcs8DeriveKey(params.KeyDerivationFunc, []byte(passphrase), keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(info.EncryptedData) == 0 || len(info.EncryptedData)%aes.BlockSize != 0 {
+		return nil, _NewErrBadKeyf("invalid encrypted data length")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	plainText := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plainText, info.EncryptedData)
+
+	padding := int(plainText[len(plainText)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, _NewErrBadKeyf("decryption failed; passphrase is incorrect")
+	}
+	for _, b := range plainText[len(plainText)-padding:] {
+		if int(b) != padding {
+			return nil, _NewErrBadKeyf("decryption failed; passphrase is incorrect")
+		}
+	}
+
+	return plainText[:len(plainText)-padding], nil
+}
+
+func _Pkcs8DeriveKey(kdf pkix.AlgorithmIdentifier, passphrase []byte, keyLength int) ([]byte, error) {
+	switch {
+	case kdf.Algorithm.Equal(_OidPBKDF2):
+		var params _Pbkdf2Params
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &params); err != nil {
+			return nil, _NewErrBadKeyf("invalid PBKDF2 parameters")
+		}
+
+		var prf func() hash.Hash
+		switch {
+		case params.PRF.Algorithm == nil || params.PRF.Algorithm.Equal(_OidHmacWithSHA1):
+			prf = sha1.New
+		case params.PRF.Algorithm.Equal(_OidHmacWithSHA256):
+			prf = sha256.New
+		case params.PRF.Algorithm.Equal(_OidHmacWithSHA384):
+			prf = sha512.New384
+		case params.PRF.Algorithm.Equal(_OidHmacWithSHA512):
+			prf = sha512.New
+		default:
+			return nil, _NewErrBadKeyf("unsupported PRF: %v", params.PRF.Algorithm)
+		}
+
+		if err := _CheckPbkdf2Parameters(params.IterationCount, keyLength); err != nil {
+			return nil, err
+		}
+
+		return pbkdf2.Key(passphrase, params.Salt, params.IterationCount, keyLength, prf), nil
+	case kdf.Algorithm.Equal(_OidScrypt):
+		var params _ScryptParams
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &params); err != nil {
+			return nil, _NewErrBadKeyf("invalid scrypt parameters")
+		}
+
+		if err := _CheckScryptParameters(params.CostParameter, params.BlockSize, params.ParallelizationParameter, keyLength); err != nil {
+			return nil, err
+		}
+
+		return scrypt.Key(passphrase, params.Salt, params.CostParameter, params.BlockSize, params.ParallelizationParameter, keyLength)
+	}
+
+	return nil, _NewErrBadKeyf("unsupported KDF: %v", kdf.Algorithm)
+}
+
+func _CheckPbkdf2Parameters(iterations int, keyLength int) error {
+	if iterations < 1 || iterations > _KdfMaxPbkdf2Iterations {
+		return _NewErrBadKeyf("PBKDF2 iteration count %v out of range [1, %v]", iterations, _KdfMaxPbkdf2Iterations)
+	}
+	if keyLength < 1 || keyLength > _KdfMaxKeyLength {
+		return _NewErrBadKeyf("derived key length %v out of range [1, %v]", keyLength, _KdfMaxKeyLength)
+	}
+
+	return nil
+}
+
+// _CheckScryptParameters bounds N, r and p; scrypt needs 128*N*r bytes of memory and p times the work.
+func _CheckScryptParameters(n int, r int, p int, keyLength int) error {
+	if n < 2 || n&(n-1) != 0 {
+		return _NewErrBadKeyf("scrypt cost parameter %v is not a power of two greater than 1", n)
+	}
+	if r < 1 || p < 1 || p > _KdfMaxScryptParallelization {
+		return _NewErrBadKeyf("scrypt block size %v or parallelization %v out of range", r, p)
+	}
+	if uint64(n) > _KdfMaxScryptMemory/128/uint64(r) {
+		return _NewErrBadKeyf("scrypt parameters N=%v, r=%v need more than %v bytes of memory", n, r, _KdfMaxScryptMemory)
+	}
+	if keyLength < 1 || keyLength > _KdfMaxKeyLength {
+		return _NewErrBadKeyf("derived key length %v out of range [1, %v]", keyLength, _KdfMaxKeyLength)
+	}
+
+	return nil
+}
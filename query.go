@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -127,8 +128,8 @@ func (this *Query) SetMaxRetry(count int) *Query {
 	return this
 }
 
-func _QueryShouldRetry(logID string, status Status) _ExecutionState {
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("query precheck status received")
+func _QueryShouldRetry(logger Logger, status Status) _ExecutionState {
+	logger.Trace("query precheck status received", "status", status.String())
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusPlatformNotActive, StatusBusy:
 		return executionStateRetry
@@ -139,23 +140,23 @@ func _QueryShouldRetry(logID string, status Status) _ExecutionState {
 	return executionStateError
 }
 
-func _QueryMakeRequest(request interface{}) interface{} {
+func _QueryMakeRequest(request interface{}) (interface{}, error) {
 	query := request.(*Query)
 	if query.isPaymentRequired && len(query.paymentTransactions) > 0 {
 		query.pbHeader.Payment = query.paymentTransactions[query.paymentTransactionIDs.index]
 	}
 	query.pbHeader.ResponseType = services.ResponseType_ANSWER_ONLY
 
-	return query.pb
+	return query.pb, nil
 }
 
-func _CostQueryMakeRequest(request interface{}) interface{} {
+func _CostQueryMakeRequest(request interface{}) (interface{}, error) {
 	query := request.(*Query)
 	if query.isPaymentRequired && len(query.paymentTransactions) > 0 {
 		query.pbHeader.Payment = query.paymentTransactions[query.paymentTransactionIDs.index]
 	}
 	query.pbHeader.ResponseType = services.ResponseType_COST_ANSWER
-	return query.pb
+	return query.pb, nil
 }
 
 func _QueryAdvanceRequest(request interface{}) {
@@ -176,25 +177,51 @@ func _QueryMapResponse(request interface{}, response interface{}, _ AccountID, p
 	return response.(*services.Response), nil
 }
 
-func _QueryGeneratePayments(query *Query, client *Client, cost Hbar) error {
+func _QueryGeneratePayments(ctx context.Context, query *Query, client *Client, cost Hbar) error {
+	operator := client._GetOperator()
+	bodyBytes := make([][]byte, 0, len(query.nodeAccountIDs.slice))
 	for _, nodeID := range query.nodeAccountIDs.slice {
-		transaction, err := _QueryMakePaymentTransaction(
+		body, err := _QueryMakePaymentTransactionBody(
 			query.paymentTransactionIDs._GetCurrent().(TransactionID),
 			nodeID.(AccountID),
-			client.operator,
+			operator,
 			cost,
 		)
 		if err != nil {
 			return err
 		}
 
-		query.paymentTransactions = append(query.paymentTransactions, transaction)
+		bodyBytes = append(bodyBytes, body)
+	}
+
+	// All node specific payments are signed in one go for signers supporting batches
+	signatures, err := _SignerSignAll(ctx, operator.signer, bodyBytes)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign query payment")
+	}
+
+	for i, body := range bodyBytes {
+		query.paymentTransactions = append(query.paymentTransactions, _QueryPaymentTransaction(body, operator.publicKey, signatures[i]))
 	}
 
 	return nil
 }
 
-func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) (*services.Transaction, error) {
+func _QueryMakePaymentTransaction(ctx context.Context, transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) (*services.Transaction, error) {
+	bodyBytes, err := _QueryMakePaymentTransactionBody(transactionID, nodeAccountID, operator, cost)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := operator.signer.Sign(ctx, bodyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign query payment")
+	}
+
+	return _QueryPaymentTransaction(bodyBytes, operator.publicKey, signature), nil
+}
+
+func _QueryMakePaymentTransactionBody(transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) ([]byte, error) {
 	accountAmounts := make([]*services.AccountAmount, 0)
 	accountAmounts = append(accountAmounts, &services.AccountAmount{
 		AccountID: nodeAccountID._ToProtobuf(),
@@ -226,16 +253,19 @@ func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID Acc
 		return nil, errors.Wrap(err, "error serializing query body")
 	}
 
-	signature := operator.signer(bodyBytes)
+	return bodyBytes, nil
+}
+
+func _QueryPaymentTransaction(bodyBytes []byte, publicKey PublicKey, signature []byte) *services.Transaction {
 	sigPairs := make([]*services.SignaturePair, 0)
-	sigPairs = append(sigPairs, operator.publicKey._ToSignaturePairProtobuf(signature))
+	sigPairs = append(sigPairs, publicKey._ToSignaturePairProtobuf(signature))
 
 	return &services.Transaction{
 		BodyBytes: bodyBytes,
 		SigMap: &services.SignatureMap{
 			SigPair: sigPairs,
 		},
-	}, nil
+	}
 }
 
 func (this *Query) GetPaymentTransactionID() TransactionID {
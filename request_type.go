@@ -22,6 +22,8 @@ package hedera
 
 import (
 	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
 )
 
 type RequestType uint32
@@ -146,6 +148,32 @@ const (
 	RequestTypeScheduleSign RequestType = 72
 	// Get Scheduled Transaction Information
 	RequestTypeScheduleGetInfo RequestType = 73
+	// Get account NFT information
+	RequestTypeTokenGetAccountNftInfos RequestType = 74
+	// Get NFT information
+	RequestTypeTokenGetNftInfo RequestType = 75
+	// Get NFT information for a token
+	RequestTypeTokenGetNftInfos RequestType = 76
+	// Update the custom fees of a token
+	RequestTypeTokenFeeScheduleUpdate RequestType = 77
+	// Get execution time of transactions
+	RequestTypeNetworkGetExecutionTime RequestType = 78
+	// Pause a token
+	RequestTypeTokenPause RequestType = 79
+	// Unpause a token
+	RequestTypeTokenUnpause RequestType = 80
+	// Approve allowances
+	RequestTypeCryptoApproveAllowance RequestType = 81
+	// Delete allowances
+	RequestTypeCryptoDeleteAllowance RequestType = 82
+	// Get account details
+	RequestTypeGetAccountDetails RequestType = 83
+	// Submit an Ethereum transaction
+	RequestTypeEthereumTransaction RequestType = 84
+	// Update node stakes
+	RequestTypeNodeStakeUpdate RequestType = 85
+	// Generate a pseudorandom number
+	RequestTypeUtilPrng RequestType = 86
 )
 
 // String() returns a string representation of the status
@@ -271,7 +299,127 @@ func (requestType RequestType) String() string { // nolint
 		return "SCHEDULE_SIGN"
 	case RequestTypeScheduleGetInfo:
 		return "SCHEDULE_GET_INFO"
+	case RequestTypeTokenGetAccountNftInfos:
+		return "TOKEN_GET_ACCOUNT_NFT_INFOS"
+	case RequestTypeTokenGetNftInfo:
+		return "TOKEN_GET_NFT_INFO"
+	case RequestTypeTokenGetNftInfos:
+		return "TOKEN_GET_NFT_INFOS"
+	case RequestTypeTokenFeeScheduleUpdate:
+		return "TOKEN_FEE_SCHEDULE_UPDATE"
+	case RequestTypeNetworkGetExecutionTime:
+		return "NETWORK_GET_EXECUTION_TIME"
+	case RequestTypeTokenPause:
+		return "TOKEN_PAUSE"
+	case RequestTypeTokenUnpause:
+		return "TOKEN_UNPAUSE"
+	case RequestTypeCryptoApproveAllowance:
+		return "CRYPTO_APPROVE_ALLOWANCE"
+	case RequestTypeCryptoDeleteAllowance:
+		return "CRYPTO_DELETE_ALLOWANCE"
+	case RequestTypeGetAccountDetails:
+		return "GET_ACCOUNT_DETAILS"
+	case RequestTypeEthereumTransaction:
+		return "ETHEREUM_TRANSACTION"
+	case RequestTypeNodeStakeUpdate:
+		return "NODE_STAKE_UPDATE"
+	case RequestTypeUtilPrng:
+		return "UTIL_PRNG"
 	}
 
 	panic(fmt.Sprintf("unreachable: RequestType.String() switch statement is non-exhaustive. RequestType: %v", uint32(requestType)))
 }
+
+// _RequestTypeFromTransactionBody returns the request type of the transaction a body describes.
+func _RequestTypeFromTransactionBody(body *services.TransactionBody) (RequestType, bool) { // nolint
+	switch body.Data.(type) {
+	case *services.TransactionBody_ContractCall:
+		return RequestTypeContractCall, true
+	case *services.TransactionBody_ContractCreateInstance:
+		return RequestTypeContractCreate, true
+	case *services.TransactionBody_ContractUpdateInstance:
+		return RequestTypeContractUpdate, true
+	case *services.TransactionBody_ContractDeleteInstance:
+		return RequestTypeContractDelete, true
+	case *services.TransactionBody_CryptoAddLiveHash:
+		return RequestTypeCryptoAddLiveHash, true
+	case *services.TransactionBody_CryptoCreateAccount:
+		return RequestTypeCryptoCreate, true
+	case *services.TransactionBody_CryptoDelete:
+		return RequestTypeCryptoDelete, true
+	case *services.TransactionBody_CryptoDeleteLiveHash:
+		return RequestTypeCryptoDeleteLiveHash, true
+	case *services.TransactionBody_CryptoTransfer:
+		return RequestTypeCryptoTransfer, true
+	case *services.TransactionBody_CryptoUpdateAccount:
+		return RequestTypeCryptoUpdate, true
+	case *services.TransactionBody_CryptoApproveAllowance:
+		return RequestTypeCryptoApproveAllowance, true
+	case *services.TransactionBody_CryptoDeleteAllowance:
+		return RequestTypeCryptoDeleteAllowance, true
+	case *services.TransactionBody_FileAppend:
+		return RequestTypeFileAppend, true
+	case *services.TransactionBody_FileCreate:
+		return RequestTypeFileCreate, true
+	case *services.TransactionBody_FileDelete:
+		return RequestTypeFileDelete, true
+	case *services.TransactionBody_FileUpdate:
+		return RequestTypeFileUpdate, true
+	case *services.TransactionBody_SystemDelete:
+		return RequestTypeSystemDelete, true
+	case *services.TransactionBody_SystemUndelete:
+		return RequestTypeSystemUndelete, true
+	case *services.TransactionBody_Freeze:
+		return RequestTypeFreeze, true
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return RequestTypeConsensusCreateTopic, true
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return RequestTypeConsensusUpdateTopic, true
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return RequestTypeConsensusDeleteTopic, true
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return RequestTypeConsensusSubmitMessage, true
+	case *services.TransactionBody_TokenCreation:
+		return RequestTypeTokenCreate, true
+	case *services.TransactionBody_TokenFreeze:
+		return RequestTypeTokenFreezeAccount, true
+	case *services.TransactionBody_TokenUnfreeze:
+		return RequestTypeTokenUnfreezeAccount, true
+	case *services.TransactionBody_TokenGrantKyc:
+		return RequestTypeTokenGrantKycToAccount, true
+	case *services.TransactionBody_TokenRevokeKyc:
+		return RequestTypeTokenRevokeKycFromAccount, true
+	case *services.TransactionBody_TokenDeletion:
+		return RequestTypeTokenDelete, true
+	case *services.TransactionBody_TokenUpdate:
+		return RequestTypeTokenUpdate, true
+	case *services.TransactionBody_TokenMint:
+		return RequestTypeTokenMint, true
+	case *services.TransactionBody_TokenBurn:
+		return RequestTypeTokenBurn, true
+	case *services.TransactionBody_TokenWipe:
+		return RequestTypeTokenAccountWipe, true
+	case *services.TransactionBody_TokenAssociate:
+		return RequestTypeTokenAssociateToAccount, true
+	case *services.TransactionBody_TokenDissociate:
+		return RequestTypeTokenDissociateFromAccount, true
+	case *services.TransactionBody_ScheduleCreate:
+		return RequestTypeScheduleCreate, true
+	case *services.TransactionBody_ScheduleSign:
+		return RequestTypeScheduleSign, true
+	case *services.TransactionBody_ScheduleDelete:
+		return RequestTypeScheduleDelete, true
+	case *services.TransactionBody_TokenFeeScheduleUpdate:
+		return RequestTypeTokenFeeScheduleUpdate, true
+	case *services.TransactionBody_TokenPause:
+		return RequestTypeTokenPause, true
+	case *services.TransactionBody_TokenUnpause:
+		return RequestTypeTokenUnpause, true
+	case *services.TransactionBody_EthereumTransaction:
+		return RequestTypeEthereumTransaction, true
+	case *services.TransactionBody_UtilPrng:
+		return RequestTypeUtilPrng, true
+	}
+
+	return RequestTypeNone, false
+}
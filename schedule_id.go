@@ -54,7 +54,7 @@ func ScheduleIDFromString(data string) (ScheduleID, error) {
 }
 
 func (id *ScheduleID) ValidateChecksum(client *Client) error {
-	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
+	if !id._IsZero() && client != nil && client.network._ManagedNetwork._GetLedgerID() != nil {
 		var tempChecksum _ParseAddressResult
 		var err error
 		tempChecksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Schedule))
@@ -69,7 +69,7 @@ func (id *ScheduleID) ValidateChecksum(client *Client) error {
 			return errChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
-			temp, _ := client.network.ledgerID.ToNetworkName()
+			temp, _ := client.network._ManagedNetwork._GetLedgerID().ToNetworkName()
 			return errors.New(fmt.Sprintf("network mismatch or wrong checksum given, given checksum: %s, correct checksum %s, network: %s",
 				*id.checksum,
 				tempChecksum.correctChecksum,
@@ -95,7 +95,7 @@ func (id *ScheduleID) Validate(client *Client) error {
 			return errChecksumMissing
 		}
 		if tempChecksum.correctChecksum != *id.checksum {
-			temp, _ := client.network.ledgerID.ToNetworkName()
+			temp, _ := client.network._ManagedNetwork._GetLedgerID().ToNetworkName()
 			return errors.New(fmt.Sprintf("network mismatch or wrong checksum given, given checksum: %s, correct checksum %s, network: %s",
 				*id.checksum,
 				tempChecksum.correctChecksum,
@@ -118,7 +118,7 @@ func (id ScheduleID) ToStringWithChecksum(client Client) (string, error) {
 	}
 	var checksum _ParseAddressResult
 	var err error
-	if client.network.ledgerID != nil {
+	if client.network._ManagedNetwork._GetLedgerID() != nil {
 		checksum, err = _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Schedule))
 	}
 	if err != nil {
@@ -0,0 +1,141 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"fmt"
+)
+
+// Signer produces signatures on behalf of a single key. Unlike TransactionSigner it may
+// block on a remote service, such as an HSM or a cloud KMS, so it receives the context of
+// the request being signed and reports failures instead of returning an empty signature.
+type Signer interface {
+	// GetPublicKey returns the public key that verifies the signatures produced by Sign.
+	GetPublicKey() PublicKey
+	// Sign returns the signature of the given transaction body bytes.
+	Sign(ctx context.Context, bodyBytes []byte) ([]byte, error)
+}
+
+// BatchSigner is implemented by signers that can sign several messages in a single round
+// trip. When a transaction is sent to several nodes, or split into several chunks, every
+// node and chunk specific body is handed to SignBatch at once instead of calling Sign for
+// each of them.
+type BatchSigner interface {
+	Signer
+	// SignBatch returns the signatures of the given body bytes, in the same order.
+	SignBatch(ctx context.Context, bodyBytes [][]byte) ([][]byte, error)
+}
+
+// LocalSigner is a Signer backed by a PrivateKey held in memory.
+type LocalSigner struct {
+	privateKey PrivateKey
+}
+
+// NewLocalSigner returns a Signer which signs with the given private key.
+func NewLocalSigner(privateKey PrivateKey) *LocalSigner {
+	return &LocalSigner{
+		privateKey: privateKey,
+	}
+}
+
+// GetPublicKey returns the public key of the underlying private key.
+func (signer *LocalSigner) GetPublicKey() PublicKey {
+	return signer.privateKey.PublicKey()
+}
+
+// Sign signs the body bytes with the underlying private key.
+func (signer *LocalSigner) Sign(ctx context.Context, bodyBytes []byte) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return signer.privateKey.Sign(bodyBytes), nil
+}
+
+// SignBatch signs each of the body bytes with the underlying private key.
+func (signer *LocalSigner) SignBatch(ctx context.Context, bodyBytes [][]byte) ([][]byte, error) {
+	signatures := make([][]byte, len(bodyBytes))
+	for i, body := range bodyBytes {
+		signature, err := signer.Sign(ctx, body)
+		if err != nil {
+			return nil, err
+		}
+		signatures[i] = signature
+	}
+
+	return signatures, nil
+}
+
+// _TransactionSignerAdapter lets a TransactionSigner callback be used wherever a Signer is expected.
+type _TransactionSignerAdapter struct {
+	publicKey PublicKey
+	signer    TransactionSigner
+}
+
+func _NewTransactionSignerAdapter(publicKey PublicKey, signer TransactionSigner) Signer {
+	if signer == nil {
+		return nil
+	}
+
+	return &_TransactionSignerAdapter{
+		publicKey: publicKey,
+		signer:    signer,
+	}
+}
+
+func (adapter *_TransactionSignerAdapter) GetPublicKey() PublicKey {
+	return adapter.publicKey
+}
+
+func (adapter *_TransactionSignerAdapter) Sign(_ context.Context, bodyBytes []byte) ([]byte, error) {
+	return adapter.signer(bodyBytes), nil
+}
+
+// _SignerSignAll signs every body with the signer, in a single call when the signer supports batching.
+func _SignerSignAll(ctx context.Context, signer Signer, bodyBytes [][]byte) ([][]byte, error) {
+	if len(bodyBytes) == 0 {
+		return [][]byte{}, nil
+	}
+
+	if batchSigner, ok := signer.(BatchSigner); ok {
+		signatures, err := batchSigner.SignBatch(ctx, bodyBytes)
+		if err != nil {
+			return nil, err
+		}
+		if len(signatures) != len(bodyBytes) {
+			return nil, fmt.Errorf("signer returned %d signatures for %d messages", len(signatures), len(bodyBytes))
+		}
+
+		return signatures, nil
+	}
+
+	signatures := make([][]byte, len(bodyBytes))
+	for i, body := range bodyBytes {
+		signature, err := signer.Sign(ctx, body)
+		if err != nil {
+			return nil, err
+		}
+		signatures[i] = signature
+	}
+
+	return signatures, nil
+}
@@ -0,0 +1,230 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/stretchr/testify/require"
+)
+
+type _CountingSigner struct {
+	*LocalSigner
+	mu      sync.Mutex
+	batches [][][]byte
+	err     error
+}
+
+func (signer *_CountingSigner) SignBatch(ctx context.Context, bodyBytes [][]byte) ([][]byte, error) {
+	signer.mu.Lock()
+	signer.batches = append(signer.batches, bodyBytes)
+	signer.mu.Unlock()
+
+	if signer.err != nil {
+		return nil, signer.err
+	}
+
+	return signer.LocalSigner.SignBatch(ctx, bodyBytes)
+}
+
+func (signer *_CountingSigner) Sign(ctx context.Context, bodyBytes []byte) ([]byte, error) {
+	if signer.err != nil {
+		return nil, signer.err
+	}
+
+	return signer.LocalSigner.Sign(ctx, bodyBytes)
+}
+
+func _NewCountingSigner(t *testing.T) *_CountingSigner {
+	privateKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	return &_CountingSigner{LocalSigner: NewLocalSigner(privateKey)}
+}
+
+func TestUnitLocalSigner(t *testing.T) {
+	privateKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	signer := NewLocalSigner(privateKey)
+	require.Equal(t, privateKey.PublicKey().String(), signer.GetPublicKey().String())
+
+	signature, err := signer.Sign(context.Background(), []byte("message"))
+	require.NoError(t, err)
+	require.True(t, signer.GetPublicKey().Verify([]byte("message"), signature))
+
+	signatures, err := signer.SignBatch(context.Background(), [][]byte{[]byte("a"), []byte("b")})
+	require.NoError(t, err)
+	require.Len(t, signatures, 2)
+	require.True(t, signer.GetPublicKey().Verify([]byte("a"), signatures[0]))
+	require.True(t, signer.GetPublicKey().Verify([]byte("b"), signatures[1]))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = signer.Sign(ctx, []byte("message"))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnitSignerBatchSignsEveryNode(t *testing.T) {
+	signer := _NewCountingSigner(t)
+
+	var verified bool
+	call := func(request *services.Transaction) *services.TransactionResponse {
+		var signedTx services.SignedTransaction
+		require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTx))
+		require.Len(t, signedTx.SigMap.SigPair, 1)
+		verified = signer.GetPublicKey().Verify(signedTx.BodyBytes, signedTx.SigMap.SigPair[0].GetEd25519())
+
+		return &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call}, {call}})
+	defer server.Close()
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, signer)
+	require.Equal(t, signer.GetPublicKey().String(), client.GetOperatorPublicKey().String())
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	require.Len(t, signer.batches, 1)
+	require.Len(t, signer.batches[0], 2)
+}
+
+func TestUnitSignerErrorIsReturned(t *testing.T) {
+	signer := _NewCountingSigner(t)
+	signer.err = errors.New("kms unavailable")
+
+	client, server := NewMockClientAndServer([][]interface{}{{}, {}})
+	defer server.Close()
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, signer)
+
+	_, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.ErrorIs(t, err, signer.err)
+
+	transaction, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	_, err = transaction.SignWithSigner(signer).ToBytes()
+	require.ErrorIs(t, err, signer.err)
+
+	_, err = _TransactionMakeRequest(&transaction.Transaction)
+	require.ErrorIs(t, err, signer.err)
+}
+
+func TestUnitSignerContextIsPassedThrough(t *testing.T) {
+	signer := _NewCountingSigner(t)
+
+	client, server := NewMockClientAndServer([][]interface{}{{}, {}})
+	defer server.Close()
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, signer)
+
+	transaction, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	transaction.SignWithSigner(signer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = transaction.ToBytesWithContext(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = transaction.GetTransactionHashWithContext(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = transaction.GetTransactionHashPerNodeWithContext(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+}
+
+func TestUnitSignerBatchSignsEveryChunk(t *testing.T) {
+	signer := _NewCountingSigner(t)
+
+	client, server := NewMockClientAndServer([][]interface{}{{}, {}})
+	defer server.Close()
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, signer)
+
+	transaction, err := NewTopicMessageSubmitTransaction().
+		SetTopicID(TopicID{Topic: 5}).
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetMessage(make([]byte, chunkSize*3)).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	transaction.SignWithOperator(client)
+	require.NoError(t, transaction._SignAll(context.Background()))
+	require.NoError(t, transaction._SignAll(context.Background()))
+
+	require.Len(t, signer.batches, 1)
+	require.Len(t, signer.batches[0], 6)
+	for i := 0; i < transaction.signedTransactions._Length(); i++ {
+		signedTx := transaction.signedTransactions._Get(i).(*services.SignedTransaction)
+		require.Len(t, signedTx.SigMap.SigPair, 1)
+		require.True(t, signer.GetPublicKey().Verify(signedTx.BodyBytes, signedTx.SigMap.SigPair[0].GetEd25519()))
+	}
+}
+
+func TestUnitSignerBatchSignsQueryPayments(t *testing.T) {
+	signer := _NewCountingSigner(t)
+
+	client, server := NewMockClientAndServer([][]interface{}{{}, {}})
+	defer server.Close()
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, signer)
+
+	query := NewAccountInfoQuery().
+		SetAccountID(AccountID{Account: 5}).
+		SetPaymentTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}})
+
+	err := _QueryGeneratePayments(context.Background(), &query.Query, client, HbarFromTinybar(20))
+	require.NoError(t, err)
+
+	require.Len(t, signer.batches, 1)
+	require.Len(t, query.paymentTransactions, 2)
+	for _, payment := range query.paymentTransactions {
+		require.True(t, signer.GetPublicKey().Verify(payment.BodyBytes, payment.SigMap.SigPair[0].GetEd25519()))
+	}
+
+	signer.err = errors.New("kms unavailable")
+	_, err = _QueryMakePaymentTransaction(context.Background(), TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
+	require.ErrorIs(t, err, signer.err)
+}
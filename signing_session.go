@@ -0,0 +1,443 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-protobufs-go/sdk"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const _SigningSessionVersion = 1
+
+// SigningSession collects the signatures of several parties on a frozen transaction. The session carries the
+// transaction together with the keys that are required to sign it, can be serialized with ToBytes to travel between
+// the parties, and accepts signatures in any order. Every signature is verified against the body bytes it signs before
+// it is accepted, and sessions signed by different parties in parallel can be merged back together.
+//
+// A transaction sent to several nodes, or split into several chunks, has a different body for each node and chunk;
+// GetBodyBytes returns them in the order AddSignatures expects signatures over them.
+type SigningSession struct {
+	signedTransactions []*services.SignedTransaction
+	requiredKeys       []PublicKey
+}
+
+type _SigningSessionJSON struct {
+	Version      int      `json:"version"`
+	Transaction  string   `json:"transaction"`
+	RequiredKeys []string `json:"requiredKeys"`
+}
+
+// NewSigningSession creates a session for a frozen transaction, passed as a pointer as returned by its constructor,
+// which is complete once every one of the required keys has signed it. Signatures already on the transaction are kept.
+func NewSigningSession(transaction interface{}, requiredKeys ...PublicKey) (*SigningSession, error) {
+	base, ok := transaction.(interface{ _GetTransaction() *Transaction })
+	if !ok {
+		return nil, errSigningSessionUnsupportedTransaction
+	}
+
+	data, err := base._GetTransaction().ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return _SigningSessionFromTransactionBytes(data, requiredKeys)
+}
+
+// SigningSessionFromBytes deserializes a session created by SigningSession.ToBytes. Every signature it carries is
+// verified again.
+func SigningSessionFromBytes(data []byte) (*SigningSession, error) {
+	var session _SigningSessionJSON
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, errors.Wrap(err, "failed to parse signing session")
+	}
+
+	if session.Version != _SigningSessionVersion {
+		return nil, errors.Errorf("unsupported signing session version %d", session.Version)
+	}
+
+	transactionBytes, err := base64.StdEncoding.DecodeString(session.Transaction)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode signing session transaction")
+	}
+
+	requiredKeys := make([]PublicKey, 0, len(session.RequiredKeys))
+	for _, s := range session.RequiredKeys {
+		key, err := PublicKeyFromString(s)
+		if err != nil {
+			return nil, err
+		}
+		requiredKeys = append(requiredKeys, key)
+	}
+
+	return _SigningSessionFromTransactionBytes(transactionBytes, requiredKeys)
+}
+
+func _SigningSessionFromTransactionBytes(data []byte, requiredKeys []PublicKey) (*SigningSession, error) {
+	// TransactionFromBytes checks the bodies describe the same, supported, transaction
+	if _, err := TransactionFromBytes(data); err != nil {
+		return nil, err
+	}
+
+	var list sdk.TransactionList
+	if err := protobuf.Unmarshal(data, &list); err != nil {
+		return nil, errors.Wrap(err, "error deserializing from bytes to Transaction List")
+	}
+
+	session := SigningSession{
+		signedTransactions: make([]*services.SignedTransaction, 0, len(list.TransactionList)),
+		requiredKeys:       make([]PublicKey, 0, len(requiredKeys)),
+	}
+
+	for _, transaction := range list.TransactionList {
+		var signedTransaction services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.SignedTransactionBytes, &signedTransaction); err != nil {
+			return nil, errors.Wrap(err, "error deserializing SignedTransactionBytes in TransactionFromBytes")
+		}
+
+		if signedTransaction.SigMap == nil {
+			signedTransaction.SigMap = &services.SignatureMap{}
+		}
+
+		for _, sigPair := range signedTransaction.SigMap.SigPair {
+			key, err := PublicKeyFromBytes(sigPair.PubKeyPrefix)
+			if err != nil {
+				return nil, err
+			}
+
+			if !_PublicKeyVerifyBody(key, signedTransaction.BodyBytes, _SignaturePairSignature(sigPair)) {
+				return nil, errors.Wrapf(errSigningSessionInvalidSignature, "public key %s", key.String())
+			}
+		}
+
+		_SigningSessionSortSignatures(signedTransaction.SigMap)
+		session.signedTransactions = append(session.signedTransactions, &signedTransaction)
+	}
+
+	for _, key := range requiredKeys {
+		if !session._IsRequired(key) {
+			session.requiredKeys = append(session.requiredKeys, key)
+		}
+	}
+
+	return &session, nil
+}
+
+// ToBytes serializes the session, including every signature collected so far. Sessions holding the same transaction,
+// required keys and signatures serialize to the same bytes, regardless of the order the signatures were added in.
+func (session *SigningSession) ToBytes() ([]byte, error) {
+	transactionBytes, err := session._TransactionBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	requiredKeys := make([]string, 0, len(session.requiredKeys))
+	for _, key := range session.requiredKeys {
+		requiredKeys = append(requiredKeys, key.StringDer())
+	}
+
+	return json.Marshal(_SigningSessionJSON{
+		Version:      _SigningSessionVersion,
+		Transaction:  base64.StdEncoding.EncodeToString(transactionBytes),
+		RequiredKeys: requiredKeys,
+	})
+}
+
+// GetTransaction returns the transaction with every signature collected so far, as returned by TransactionFromBytes.
+func (session *SigningSession) GetTransaction() (interface{}, error) {
+	transactionBytes, err := session._TransactionBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return TransactionFromBytes(transactionBytes)
+}
+
+// GetSignatures returns the signatures collected so far for each node, as returned by Transaction.GetSignatures.
+func (session *SigningSession) GetSignatures() (map[AccountID]map[*PublicKey][]byte, error) {
+	transaction, err := session.GetTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	return TransactionGetSignatures(transaction)
+}
+
+// GetBodyBytes returns the body bytes of the transaction for each node and chunk. Each party has to sign all of them.
+func (session *SigningSession) GetBodyBytes() [][]byte {
+	bodyBytes := make([][]byte, 0, len(session.signedTransactions))
+	for _, signedTransaction := range session.signedTransactions {
+		bodyBytes = append(bodyBytes, signedTransaction.BodyBytes)
+	}
+
+	return bodyBytes
+}
+
+func (session *SigningSession) GetRequiredKeys() []PublicKey {
+	return append([]PublicKey{}, session.requiredKeys...)
+}
+
+// GetSignedKeys returns the required keys that have signed every body of the transaction.
+func (session *SigningSession) GetSignedKeys() []PublicKey {
+	keys := make([]PublicKey, 0, len(session.requiredKeys))
+	for _, key := range session.requiredKeys {
+		if session._HasSigned(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// GetMissingKeys returns the required keys that have yet to sign at least one body of the transaction.
+func (session *SigningSession) GetMissingKeys() []PublicKey {
+	keys := make([]PublicKey, 0, len(session.requiredKeys))
+	for _, key := range session.requiredKeys {
+		if !session._HasSigned(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// IsComplete returns true once every required key has signed every body of the transaction.
+func (session *SigningSession) IsComplete() bool {
+	return len(session.GetMissingKeys()) == 0
+}
+
+// Sign signs every body of the transaction that privateKey has yet to sign.
+func (session *SigningSession) Sign(privateKey PrivateKey) error {
+	return session.SignWithSigner(context.Background(), NewLocalSigner(privateKey))
+}
+
+// SignWithSigner signs every body of the transaction that the signer has yet to sign, in a single batch when the
+// signer supports it.
+func (session *SigningSession) SignWithSigner(ctx context.Context, signer Signer) error {
+	publicKey := signer.GetPublicKey()
+	if !session._IsRequired(publicKey) {
+		return errors.Wrapf(errSigningSessionUnexpectedKey, "public key %s", publicKey.String())
+	}
+
+	pending := make([]*services.SignedTransaction, 0, len(session.signedTransactions))
+	bodyBytes := make([][]byte, 0, len(session.signedTransactions))
+	for _, signedTransaction := range session.signedTransactions {
+		if !_SignatureMapContainsKey(signedTransaction.SigMap, publicKey) {
+			pending = append(pending, signedTransaction)
+			bodyBytes = append(bodyBytes, signedTransaction.BodyBytes)
+		}
+	}
+
+	signatures, err := _SignerSignAll(ctx, signer, bodyBytes)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign transaction")
+	}
+
+	for i, signedTransaction := range pending {
+		if err := session._Add(signedTransaction, publicKey, signatures[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSignature adds a signature of a required key. The signature is added to every body of the transaction it is
+// valid for, which for a transaction sent to several nodes is the body of a single node; it is rejected if it is valid
+// for none of them.
+func (session *SigningSession) AddSignature(publicKey PublicKey, signature []byte) error {
+	if !session._IsRequired(publicKey) {
+		return errors.Wrapf(errSigningSessionUnexpectedKey, "public key %s", publicKey.String())
+	}
+
+	valid := false
+	for _, signedTransaction := range session.signedTransactions {
+		if !_PublicKeyVerifyBody(publicKey, signedTransaction.BodyBytes, signature) {
+			continue
+		}
+
+		valid = true
+		if !_SignatureMapContainsKey(signedTransaction.SigMap, publicKey) {
+			signedTransaction.SigMap.SigPair = append(signedTransaction.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
+			_SigningSessionSortSignatures(signedTransaction.SigMap)
+		}
+	}
+
+	if !valid {
+		return errors.Wrapf(errSigningSessionInvalidSignature, "public key %s", publicKey.String())
+	}
+
+	return nil
+}
+
+// AddSignatures adds the signatures of a required key over each body of the transaction, in the order of GetBodyBytes.
+// Either all of the signatures are valid and added, or none of them are.
+func (session *SigningSession) AddSignatures(publicKey PublicKey, signatures [][]byte) error {
+	if !session._IsRequired(publicKey) {
+		return errors.Wrapf(errSigningSessionUnexpectedKey, "public key %s", publicKey.String())
+	}
+
+	if len(signatures) != len(session.signedTransactions) {
+		return errors.Errorf("expected %d signatures, one for each body, got %d", len(session.signedTransactions), len(signatures))
+	}
+
+	for i, signedTransaction := range session.signedTransactions {
+		if !_PublicKeyVerifyBody(publicKey, signedTransaction.BodyBytes, signatures[i]) {
+			return errors.Wrapf(errSigningSessionInvalidSignature, "public key %s", publicKey.String())
+		}
+	}
+
+	for i, signedTransaction := range session.signedTransactions {
+		if err := session._Add(signedTransaction, publicKey, signatures[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Merge adds the signatures collected by another session for the same transaction and required keys.
+func (session *SigningSession) Merge(other *SigningSession) error {
+	if other == nil {
+		return errParameterNull
+	}
+
+	if len(session.signedTransactions) != len(other.signedTransactions) || len(session.requiredKeys) != len(other.requiredKeys) {
+		return errSigningSessionMismatch
+	}
+
+	for i, signedTransaction := range session.signedTransactions {
+		if !bytes.Equal(signedTransaction.BodyBytes, other.signedTransactions[i].BodyBytes) {
+			return errSigningSessionMismatch
+		}
+	}
+
+	for _, key := range other.requiredKeys {
+		if !session._IsRequired(key) {
+			return errSigningSessionMismatch
+		}
+	}
+
+	for i, signedTransaction := range other.signedTransactions {
+		for _, sigPair := range signedTransaction.SigMap.GetSigPair() {
+			key, err := PublicKeyFromBytes(sigPair.PubKeyPrefix)
+			if err != nil {
+				return err
+			}
+
+			if err := session._Add(session.signedTransactions[i], key, _SignaturePairSignature(sigPair)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (session *SigningSession) _Add(signedTransaction *services.SignedTransaction, publicKey PublicKey, signature []byte) error {
+	if _SignatureMapContainsKey(signedTransaction.SigMap, publicKey) {
+		return nil
+	}
+
+	if !_PublicKeyVerifyBody(publicKey, signedTransaction.BodyBytes, signature) {
+		return errors.Wrapf(errSigningSessionInvalidSignature, "public key %s", publicKey.String())
+	}
+
+	signedTransaction.SigMap.SigPair = append(signedTransaction.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
+	_SigningSessionSortSignatures(signedTransaction.SigMap)
+
+	return nil
+}
+
+func (session *SigningSession) _IsRequired(publicKey PublicKey) bool {
+	for _, key := range session.requiredKeys {
+		if bytes.Equal(key.BytesRaw(), publicKey.BytesRaw()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (session *SigningSession) _HasSigned(publicKey PublicKey) bool {
+	for _, signedTransaction := range session.signedTransactions {
+		if !_SignatureMapContainsKey(signedTransaction.SigMap, publicKey) {
+			return false
+		}
+	}
+
+	return len(session.signedTransactions) > 0
+}
+
+func (session *SigningSession) _TransactionBytes() ([]byte, error) {
+	options := protobuf.MarshalOptions{Deterministic: true}
+	list := sdk.TransactionList{TransactionList: make([]*services.Transaction, 0, len(session.signedTransactions))}
+	for _, signedTransaction := range session.signedTransactions {
+		signedTransactionBytes, err := options.Marshal(signedTransaction)
+		if err != nil {
+			return nil, err
+		}
+
+		list.TransactionList = append(list.TransactionList, &services.Transaction{SignedTransactionBytes: signedTransactionBytes})
+	}
+
+	return options.Marshal(&list)
+}
+
+// _PublicKeyVerifyBody verifies a signature over transaction body bytes. ECDSA keys sign the keccak256 hash of the body.
+func _PublicKeyVerifyBody(publicKey PublicKey, bodyBytes []byte, signature []byte) bool {
+	if publicKey.ecdsaPublicKey != nil {
+		return publicKey.ecdsaPublicKey._Verify(crypto.Keccak256(bodyBytes), signature)
+	}
+
+	return publicKey.Verify(bodyBytes, signature)
+}
+
+func _SigningSessionSortSignatures(sigMap *services.SignatureMap) {
+	sort.SliceStable(sigMap.SigPair, func(i, j int) bool {
+		return bytes.Compare(sigMap.SigPair[i].PubKeyPrefix, sigMap.SigPair[j].PubKeyPrefix) < 0
+	})
+}
+
+func _SignaturePairSignature(sigPair *services.SignaturePair) []byte {
+	switch signature := sigPair.Signature.(type) {
+	case *services.SignaturePair_Ed25519:
+		return signature.Ed25519
+	case *services.SignaturePair_ECDSASecp256K1:
+		return signature.ECDSASecp256K1
+	case *services.SignaturePair_ECDSA_384:
+		return signature.ECDSA_384
+	case *services.SignaturePair_RSA_3072:
+		return signature.RSA_3072
+	case *services.SignaturePair_Contract:
+		return signature.Contract
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewUnitSigningSessionTransfer(t *testing.T, nodeAccountIDs []AccountID) *TransferTransaction {
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs(nodeAccountIDs).
+		SetTransactionID(NewTransactionIDWithValidStart(AccountID{Account: 1800}, time.Unix(1600000000, 0))).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	return transfer
+}
+
+func TestUnitSigningSessionMultiParty(t *testing.T) {
+	first, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	second, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	third, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transfer := _NewUnitSigningSessionTransfer(t, []AccountID{{Account: 3}, {Account: 4}})
+	session, err := NewSigningSession(transfer, first.PublicKey(), second.PublicKey(), third.PublicKey())
+	require.NoError(t, err)
+
+	assert.Len(t, session.GetBodyBytes(), 2)
+	assert.Len(t, session.GetMissingKeys(), 3)
+	assert.False(t, session.IsComplete())
+
+	exported, err := session.ToBytes()
+	require.NoError(t, err)
+
+	// Every party signs its own copy of the session
+	firstSession, err := SigningSessionFromBytes(exported)
+	require.NoError(t, err)
+	require.NoError(t, firstSession.Sign(first))
+
+	secondSession, err := SigningSessionFromBytes(exported)
+	require.NoError(t, err)
+	require.NoError(t, secondSession.Sign(second))
+
+	thirdSession, err := SigningSessionFromBytes(exported)
+	require.NoError(t, err)
+	signatures := make([][]byte, 0)
+	for _, bodyBytes := range thirdSession.GetBodyBytes() {
+		signatures = append(signatures, third.Sign(bodyBytes))
+	}
+	require.NoError(t, thirdSession.AddSignatures(third.PublicKey(), signatures))
+	assert.Equal(t, []PublicKey{third.PublicKey()}, thirdSession.GetSignedKeys())
+
+	thirdBytes, err := thirdSession.ToBytes()
+	require.NoError(t, err)
+	thirdSession, err = SigningSessionFromBytes(thirdBytes)
+	require.NoError(t, err)
+
+	require.NoError(t, session.Merge(secondSession))
+	require.NoError(t, session.Merge(thirdSession))
+	require.NoError(t, session.Merge(firstSession))
+	assert.True(t, session.IsComplete())
+	assert.Empty(t, session.GetMissingKeys())
+
+	// The serialization does not depend on the order signatures were collected in
+	require.NoError(t, firstSession.Merge(thirdSession))
+	require.NoError(t, firstSession.Merge(secondSession))
+	sessionBytes, err := session.ToBytes()
+	require.NoError(t, err)
+	firstBytes, err := firstSession.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, sessionBytes, firstBytes)
+
+	signed, err := session.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signed, 2)
+	for _, nodeSignatures := range signed {
+		assert.Len(t, nodeSignatures, 3)
+	}
+
+	transaction, err := session.GetTransaction()
+	require.NoError(t, err)
+	signedTransfer, ok := transaction.(TransferTransaction)
+	require.True(t, ok)
+	assert.True(t, first.PublicKey().VerifyTransaction(signedTransfer.Transaction))
+	assert.True(t, third.PublicKey().VerifyTransaction(signedTransfer.Transaction))
+}
+
+func TestUnitSigningSessionPerNodeSignature(t *testing.T) {
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transfer := _NewUnitSigningSessionTransfer(t, []AccountID{{Account: 3}, {Account: 4}})
+	session, err := NewSigningSession(transfer, key.PublicKey())
+	require.NoError(t, err)
+
+	bodyBytes := session.GetBodyBytes()
+
+	require.NoError(t, session.AddSignature(key.PublicKey(), key.Sign(bodyBytes[1])))
+	assert.False(t, session.IsComplete())
+
+	require.NoError(t, session.AddSignature(key.PublicKey(), key.Sign(bodyBytes[0])))
+	assert.True(t, session.IsComplete())
+	assert.Equal(t, []PublicKey{key.PublicKey()}, session.GetSignedKeys())
+}
+
+func TestUnitSigningSessionRejectsInvalidSignatures(t *testing.T) {
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	other, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transfer := _NewUnitSigningSessionTransfer(t, []AccountID{{Account: 3}})
+	session, err := NewSigningSession(transfer, key.PublicKey())
+	require.NoError(t, err)
+
+	err = session.AddSignature(key.PublicKey(), key.Sign([]byte("something else")))
+	assert.ErrorIs(t, err, errSigningSessionInvalidSignature)
+
+	err = session.AddSignature(key.PublicKey(), other.Sign(session.GetBodyBytes()[0]))
+	assert.ErrorIs(t, err, errSigningSessionInvalidSignature)
+
+	err = session.Sign(other)
+	assert.ErrorIs(t, err, errSigningSessionUnexpectedKey)
+
+	err = session.AddSignatures(key.PublicKey(), [][]byte{})
+	assert.Error(t, err)
+	assert.False(t, session.IsComplete())
+
+	forged := _NewUnitSigningSessionTransfer(t, []AccountID{{Account: 3}})
+	forged.AddSignature(key.PublicKey(), other.Sign(forged.GetSignedTransactionBodyBytes(0)))
+	_, err = NewSigningSession(forged, key.PublicKey())
+	assert.ErrorIs(t, err, errSigningSessionInvalidSignature)
+
+	_, err = NewSigningSession(*transfer, key.PublicKey())
+	assert.ErrorIs(t, err, errSigningSessionUnsupportedTransaction)
+
+	_, err = NewSigningSession(NewTransferTransaction(), key.PublicKey())
+	assert.ErrorIs(t, err, errTransactionIsNotFrozen)
+}
+
+func TestUnitSigningSessionMergeMismatch(t *testing.T) {
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	session, err := NewSigningSession(_NewUnitSigningSessionTransfer(t, []AccountID{{Account: 3}}), key.PublicKey())
+	require.NoError(t, err)
+
+	other, err := NewSigningSession(_NewUnitSigningSessionTransfer(t, []AccountID{{Account: 4}}), key.PublicKey())
+	require.NoError(t, err)
+	assert.ErrorIs(t, session.Merge(other), errSigningSessionMismatch)
+
+	otherKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	other, err = NewSigningSession(_NewUnitSigningSessionTransfer(t, []AccountID{{Account: 3}}), otherKey.PublicKey())
+	require.NoError(t, err)
+	assert.ErrorIs(t, session.Merge(other), errSigningSessionMismatch)
+}
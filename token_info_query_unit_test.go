@@ -78,6 +78,17 @@ func TestUnitTokenInfoFromBytesEmptyBytes(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUnitTokenInfoFromProtobufTreasury(t *testing.T) {
+	tokenInfo := _TokenInfoFromProtobuf(&services.TokenInfo{
+		TokenId:          &services.TokenID{TokenNum: 5},
+		Treasury:         &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 7}},
+		AutoRenewAccount: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 9}},
+	})
+
+	require.Equal(t, "0.0.7", tokenInfo.Treasury.String())
+	require.Equal(t, "0.0.9", tokenInfo.AutoRenewAccountID.String())
+}
+
 func TestUnitTokenInfoQueryGet(t *testing.T) {
 	tokenID := TokenID{Token: 7}
 	deadline := time.Duration(time.Minute)
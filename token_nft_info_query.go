@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -122,7 +123,7 @@ func (query *TokenNftInfoQuery) ByAccountID(id AccountID) *TokenNftInfoQuery {
 }
 
 func (query *TokenNftInfoQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -150,7 +151,13 @@ func (query *TokenNftInfoQuery) _BuildByNft() *services.Query_TokenGetNftInfo {
 }
 
 func (query *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *TokenNftInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -162,7 +169,7 @@ func (query *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -178,6 +185,7 @@ func (query *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 	var resp interface{}
 	resp, err = _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TokenNftInfoQueryShouldRetry,
@@ -201,8 +209,8 @@ func (query *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TokenNftInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetTokenGetNftInfo().Header.NodeTransactionPrecheckCode))
+func _TokenNftInfoQueryShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, Status(response.(*services.Response).GetTokenGetNftInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _TokenNftInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -218,7 +226,13 @@ func _TokenNftInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 }
 
 func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the TokenNftInfoQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *TokenNftInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
+	if client == nil || client._GetOperator() == nil {
 		return []TokenNftInfo{}, errNoClientProvided
 	}
 
@@ -230,7 +244,7 @@ func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error)
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -243,7 +257,7 @@ func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error)
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []TokenNftInfo{}, err
 		}
@@ -262,12 +276,12 @@ func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error)
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return []TokenNftInfo{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return []TokenNftInfo{}, err
 		}
@@ -283,6 +297,7 @@ func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error)
 	var resp interface{}
 	tokenInfos := make([]TokenNftInfo, 0)
 	resp, err = _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TokenNftInfoQueryShouldRetry,
@@ -0,0 +1,143 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// TopicCheckpoint records how far a consumer of a topic has got, so that a TopicSubscription can resume
+// right after the last acknowledged message.
+type TopicCheckpoint struct {
+	// The consensus timestamp of the last acknowledged message
+	ConsensusTimestamp time.Time `json:"consensusTimestamp"`
+	// The sequence number of the last acknowledged message
+	SequenceNumber uint64 `json:"sequenceNumber"`
+	// The running hash of the topic after the last acknowledged message
+	RunningHash []byte `json:"runningHash,omitempty"`
+	// The consensus timestamp to resume from. It is before ConsensusTimestamp when chunks of messages that
+	// were still incomplete had already been received.
+	ResumeTimestamp time.Time `json:"resumeTimestamp"`
+	// The initial transaction IDs of the chunked messages that were still incomplete
+	PendingTransactionIDs []string `json:"pendingTransactionIds,omitempty"`
+}
+
+// CheckpointStore persists the progress of topic subscriptions.
+type CheckpointStore interface {
+	// LoadCheckpoint returns the checkpoint saved for the topic, nil if there is none.
+	LoadCheckpoint(topicID TopicID) (*TopicCheckpoint, error)
+	// SaveCheckpoint replaces the checkpoint of the topic.
+	SaveCheckpoint(topicID TopicID, checkpoint TopicCheckpoint) error
+}
+
+// FileCheckpointStore is a CheckpointStore keeping the checkpoints of all topics in a single JSON file.
+// The file is replaced atomically on every save.
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointStore returns a CheckpointStore backed by the file at path, which is created on the first save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		path: path,
+	}
+}
+
+func (store *FileCheckpointStore) GetPath() string {
+	return store.path
+}
+
+// LoadCheckpoint returns the checkpoint saved for the topic, nil if there is none.
+func (store *FileCheckpointStore) LoadCheckpoint(topicID TopicID) (*TopicCheckpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoints, err := store._Read()
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, ok := checkpoints[topicID.String()]
+	if !ok {
+		return nil, nil
+	}
+
+	return &checkpoint, nil
+}
+
+// SaveCheckpoint replaces the checkpoint of the topic.
+func (store *FileCheckpointStore) SaveCheckpoint(topicID TopicID, checkpoint TopicCheckpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoints, err := store._Read()
+	if err != nil {
+		return err
+	}
+
+	checkpoints[topicID.String()] = checkpoint
+
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(data); err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), store.path)
+}
+
+func (store *FileCheckpointStore) _Read() (map[string]TopicCheckpoint, error) {
+	checkpoints := make(map[string]TopicCheckpoint)
+
+	data, err := os.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, err
+	}
+
+	return checkpoints, nil
+}
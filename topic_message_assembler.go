@@ -0,0 +1,317 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+)
+
+// TopicMessagePart is a single consensus message of a topic, either a whole message or one chunk of a
+// chunked message, as returned by the mirror node.
+type TopicMessagePart struct {
+	ConsensusTimestamp time.Time
+	Contents           []byte
+	RunningHash        []byte
+	SequenceNumber     uint64
+	// The transaction ID of the first chunk, nil for messages which are not chunked
+	InitialTransactionID *TransactionID
+	// The 1-based number of the chunk and the number of chunks of the message, both 1 for messages which are not chunked
+	ChunkNumber int32
+	ChunkTotal  int32
+}
+
+// IncompleteTopicMessage is a chunked message which was given up on before all of its chunks were received.
+type IncompleteTopicMessage struct {
+	InitialTransactionID TransactionID
+	ChunkTotal           int32
+	// The chunks that were received, in the order they were received
+	Parts []TopicMessagePart
+	// True if the message timed out, false if it was evicted to stay within the limits of the assembler
+	Expired bool
+}
+
+// TopicMessageAssembler reassembles chunked topic messages from their parts. Incomplete messages are
+// bounded in number and size and expire once parts reach consensus more than the timeout after their
+// first part; either way they are handed to the OnIncompleteMessage callback. A TopicMessageAssembler is
+// not safe for concurrent use.
+type TopicMessageAssembler struct {
+	maxPendingMessages  int
+	maxPendingBytes     int64
+	timeout             time.Duration
+	onIncompleteMessage func(IncompleteTopicMessage)
+
+	pending      map[string]*_PendingTopicMessage
+	order        []string
+	pendingBytes int64
+
+	// Messages up to this sequence number were delivered before a subscription was resumed. Their parts
+	// are dropped, except those of the messages in resumed which were still incomplete.
+	skipSequenceNumber uint64
+	resumed            map[string]bool
+}
+
+type _PendingTopicMessage struct {
+	initialTransactionID TransactionID
+	total                int32
+	parts                []TopicMessagePart
+	first                time.Time
+	size                 int64
+}
+
+// NewTopicMessageAssembler creates a TopicMessageAssembler keeping at most 1000 incomplete messages and 32 MiB
+// of their contents, for at most 5 minutes of consensus time.
+func NewTopicMessageAssembler() *TopicMessageAssembler {
+	return &TopicMessageAssembler{
+		maxPendingMessages: 1000,
+		maxPendingBytes:    32 * 1024 * 1024,
+		timeout:            5 * time.Minute,
+		pending:            make(map[string]*_PendingTopicMessage),
+		resumed:            make(map[string]bool),
+	}
+}
+
+// SetMaxPendingMessages sets how many incomplete messages are kept, evicting the oldest first. Zero means no limit.
+func (assembler *TopicMessageAssembler) SetMaxPendingMessages(maxPendingMessages int) *TopicMessageAssembler {
+	assembler.maxPendingMessages = maxPendingMessages
+	return assembler
+}
+
+func (assembler *TopicMessageAssembler) GetMaxPendingMessages() int {
+	return assembler.maxPendingMessages
+}
+
+// SetMaxPendingBytes sets the size of the contents of incomplete messages that is kept, evicting the oldest
+// messages first. Zero means no limit.
+func (assembler *TopicMessageAssembler) SetMaxPendingBytes(maxPendingBytes int64) *TopicMessageAssembler {
+	assembler.maxPendingBytes = maxPendingBytes
+	return assembler
+}
+
+func (assembler *TopicMessageAssembler) GetMaxPendingBytes() int64 {
+	return assembler.maxPendingBytes
+}
+
+// SetTimeout sets how much consensus time may pass after the first part of a message before it expires.
+// Zero means messages never expire.
+func (assembler *TopicMessageAssembler) SetTimeout(timeout time.Duration) *TopicMessageAssembler {
+	assembler.timeout = timeout
+	return assembler
+}
+
+func (assembler *TopicMessageAssembler) GetTimeout() time.Duration {
+	return assembler.timeout
+}
+
+// SetOnIncompleteMessage sets the callback called with every message that expires or is evicted.
+func (assembler *TopicMessageAssembler) SetOnIncompleteMessage(onIncompleteMessage func(IncompleteTopicMessage)) *TopicMessageAssembler {
+	assembler.onIncompleteMessage = onIncompleteMessage
+	return assembler
+}
+
+// GetPendingMessages returns the number of incomplete messages.
+func (assembler *TopicMessageAssembler) GetPendingMessages() int {
+	return len(assembler.pending)
+}
+
+// GetPendingBytes returns the size of the contents of the incomplete messages.
+func (assembler *TopicMessageAssembler) GetPendingBytes() int64 {
+	return assembler.pendingBytes
+}
+
+// Add adds a part, which must be given in consensus order, and returns the message it completes, if any.
+// Parts with an invalid chunk number, chunks whose total differs from the one of the first chunk received
+// for their message and chunks received twice are ignored.
+func (assembler *TopicMessageAssembler) Add(part TopicMessagePart) (TopicMessage, bool) {
+	assembler.Expire(part.ConsensusTimestamp)
+
+	if part.InitialTransactionID == nil || part.ChunkTotal <= 1 {
+		if part.SequenceNumber <= assembler.skipSequenceNumber {
+			return TopicMessage{}, false
+		}
+
+		return TopicMessage{
+			ConsensusTimestamp: part.ConsensusTimestamp,
+			Contents:           part.Contents,
+			RunningHash:        part.RunningHash,
+			SequenceNumber:     part.SequenceNumber,
+			Chunks:             nil,
+			TransactionID:      nil,
+		}, true
+	}
+
+	if part.ChunkNumber < 1 || part.ChunkNumber > part.ChunkTotal {
+		return TopicMessage{}, false
+	}
+
+	txID := part.InitialTransactionID.String()
+	if part.SequenceNumber <= assembler.skipSequenceNumber && !assembler.resumed[txID] {
+		return TopicMessage{}, false
+	}
+
+	message, ok := assembler.pending[txID]
+	if !ok {
+		// the chunk total comes from the network, so parts are appended rather than preallocated
+		message = &_PendingTopicMessage{
+			initialTransactionID: *part.InitialTransactionID,
+			total:                part.ChunkTotal,
+			first:                part.ConsensusTimestamp,
+		}
+		assembler.pending[txID] = message
+		assembler.order = append(assembler.order, txID)
+	} else if part.ChunkTotal != message.total {
+		return TopicMessage{}, false
+	}
+
+	for _, received := range message.parts {
+		if received.ChunkNumber == part.ChunkNumber {
+			return TopicMessage{}, false
+		}
+	}
+
+	message.parts = append(message.parts, part)
+	message.size += int64(len(part.Contents))
+	assembler.pendingBytes += int64(len(part.Contents))
+
+	if int32(len(message.parts)) == message.total {
+		assembler._Remove(txID)
+		delete(assembler.resumed, txID)
+
+		return message._Assemble(), true
+	}
+
+	for len(assembler.order) > 0 &&
+		((assembler.maxPendingMessages > 0 && len(assembler.pending) > assembler.maxPendingMessages) ||
+			(assembler.maxPendingBytes > 0 && assembler.pendingBytes > assembler.maxPendingBytes)) {
+		assembler._Evict(assembler.order[0], false)
+	}
+
+	return TopicMessage{}, false
+}
+
+// Expire evicts the messages whose first part reached consensus more than the timeout before now.
+func (assembler *TopicMessageAssembler) Expire(now time.Time) {
+	if assembler.timeout <= 0 {
+		return
+	}
+
+	for len(assembler.order) > 0 && now.Sub(assembler.pending[assembler.order[0]].first) > assembler.timeout {
+		assembler._Evict(assembler.order[0], true)
+	}
+}
+
+func (assembler *TopicMessageAssembler) _Evict(txID string, expired bool) {
+	message := assembler.pending[txID]
+	assembler._Remove(txID)
+
+	if assembler.onIncompleteMessage != nil {
+		assembler.onIncompleteMessage(IncompleteTopicMessage{
+			InitialTransactionID: message.initialTransactionID,
+			ChunkTotal:           message.total,
+			Parts:                message.parts,
+			Expired:              expired,
+		})
+	}
+}
+
+func (assembler *TopicMessageAssembler) _Remove(txID string) {
+	message := assembler.pending[txID]
+	assembler.pendingBytes -= message.size
+	delete(assembler.pending, txID)
+
+	for i, pending := range assembler.order {
+		if pending == txID {
+			assembler.order = append(assembler.order[:i], assembler.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// _Pending returns the initial transaction IDs of the incomplete messages and the consensus timestamp
+// of the earliest part received for them, nil if there are none.
+func (assembler *TopicMessageAssembler) _Pending() ([]string, *time.Time) {
+	transactionIDs := make([]string, 0, len(assembler.pending))
+	var earliest *time.Time
+
+	for txID, message := range assembler.pending {
+		transactionIDs = append(transactionIDs, txID)
+		for _, part := range message.parts {
+			timestamp := part.ConsensusTimestamp
+			if earliest == nil || timestamp.Before(*earliest) {
+				earliest = &timestamp
+			}
+		}
+	}
+
+	sort.Strings(transactionIDs)
+
+	return transactionIDs, earliest
+}
+
+func (message *_PendingTopicMessage) _Assemble() TopicMessage {
+	chunks := make([]TopicMessageChunk, message.total)
+	contents := make([][]byte, message.total)
+	for _, part := range message.parts {
+		chunks[part.ChunkNumber-1] = _NewTopicMessageChunk(part)
+		contents[part.ChunkNumber-1] = part.Contents
+	}
+
+	finalMessage := make([]byte, 0, message.size)
+	for _, content := range contents {
+		finalMessage = append(finalMessage, content...)
+	}
+
+	last := message.parts[len(message.parts)-1]
+	transactionID := message.initialTransactionID
+
+	return TopicMessage{
+		ConsensusTimestamp: last.ConsensusTimestamp,
+		RunningHash:        last.RunningHash,
+		SequenceNumber:     last.SequenceNumber,
+		Contents:           finalMessage,
+		Chunks:             chunks,
+		TransactionID:      &transactionID,
+	}
+}
+
+func _TopicMessagePartFromProtobuf(resp *mirror.ConsensusTopicResponse) TopicMessagePart {
+	part := TopicMessagePart{
+		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
+		Contents:           resp.Message,
+		RunningHash:        resp.RunningHash,
+		SequenceNumber:     resp.SequenceNumber,
+		ChunkNumber:        1,
+		ChunkTotal:         1,
+	}
+
+	if resp.ChunkInfo != nil {
+		part.ChunkNumber = resp.ChunkInfo.Number
+		part.ChunkTotal = resp.ChunkInfo.Total
+		if resp.ChunkInfo.InitialTransactionID != nil {
+			transactionID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID)
+			part.InitialTransactionID = &transactionID
+		}
+	}
+
+	return part
+}
@@ -0,0 +1,219 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+var _TestAssemblerStart = time.Unix(1672531200, 0)
+
+func _NewTestTopicMessagePart(txID *TransactionID, number int32, total int32, sequenceNumber uint64, contents string) TopicMessagePart {
+	return TopicMessagePart{
+		ConsensusTimestamp:   _TestAssemblerStart.Add(time.Duration(sequenceNumber) * time.Second),
+		Contents:             []byte(contents),
+		RunningHash:          []byte{byte(sequenceNumber)},
+		SequenceNumber:       sequenceNumber,
+		InitialTransactionID: txID,
+		ChunkNumber:          number,
+		ChunkTotal:           total,
+	}
+}
+
+func _NewTestTransactionID(account uint64) *TransactionID {
+	transactionID := TransactionIDGenerate(AccountID{Account: account})
+	return &transactionID
+}
+
+func TestUnitTopicMessageAssemblerReassembles(t *testing.T) {
+	assembler := NewTopicMessageAssembler()
+	txID := _NewTestTransactionID(1800)
+
+	message, ok := assembler.Add(_NewTestTopicMessagePart(nil, 1, 1, 1, "single"))
+	require.True(t, ok)
+	require.Equal(t, "single", string(message.Contents))
+	require.Nil(t, message.TransactionID)
+
+	_, ok = assembler.Add(_NewTestTopicMessagePart(txID, 2, 3, 2, "b"))
+	require.False(t, ok)
+	_, ok = assembler.Add(_NewTestTopicMessagePart(txID, 2, 3, 3, "b"))
+	require.False(t, ok)
+	_, ok = assembler.Add(_NewTestTopicMessagePart(txID, 4, 3, 4, "d"))
+	require.False(t, ok)
+	_, ok = assembler.Add(_NewTestTopicMessagePart(txID, 1, 3, 5, "a"))
+	require.False(t, ok)
+	require.Equal(t, 1, assembler.GetPendingMessages())
+	require.Equal(t, int64(2), assembler.GetPendingBytes())
+
+	message, ok = assembler.Add(_NewTestTopicMessagePart(txID, 3, 3, 6, "c"))
+	require.True(t, ok)
+	require.Equal(t, "abc", string(message.Contents))
+	require.Equal(t, uint64(6), message.SequenceNumber)
+	require.Equal(t, txID.String(), message.TransactionID.String())
+	require.Len(t, message.Chunks, 3)
+	require.Equal(t, uint64(5), message.Chunks[0].SequenceNumber)
+	require.Equal(t, 0, assembler.GetPendingMessages())
+	require.Equal(t, int64(0), assembler.GetPendingBytes())
+}
+
+func TestUnitTopicMessageAssemblerChunkTotal(t *testing.T) {
+	assembler := NewTopicMessageAssembler()
+	txID := _NewTestTransactionID(1800)
+
+	// A chunk total near the int32 limit does not allocate for every chunk announced
+	_, ok := assembler.Add(_NewTestTopicMessagePart(txID, 1, math.MaxInt32, 1, "a"))
+	require.False(t, ok)
+	require.Equal(t, 1, assembler.GetPendingMessages())
+	require.Equal(t, int64(1), assembler.GetPendingBytes())
+
+	// Chunks announcing another total than the first chunk of their message are ignored
+	_, ok = assembler.Add(_NewTestTopicMessagePart(txID, 2, 2, 2, "b"))
+	require.False(t, ok)
+	require.Equal(t, int64(1), assembler.GetPendingBytes())
+
+	other := _NewTestTransactionID(1801)
+	_, ok = assembler.Add(_NewTestTopicMessagePart(other, 1, 2, 3, "c"))
+	require.False(t, ok)
+	_, ok = assembler.Add(_NewTestTopicMessagePart(other, 2, 3, 4, "d"))
+	require.False(t, ok)
+	message, ok := assembler.Add(_NewTestTopicMessagePart(other, 2, 2, 5, "d"))
+	require.True(t, ok)
+	require.Equal(t, "cd", string(message.Contents))
+}
+
+func TestUnitTopicMessageAssemblerLimits(t *testing.T) {
+	incomplete := make([]IncompleteTopicMessage, 0)
+	assembler := NewTopicMessageAssembler().
+		SetMaxPendingMessages(2).
+		SetMaxPendingBytes(10).
+		SetOnIncompleteMessage(func(message IncompleteTopicMessage) {
+			incomplete = append(incomplete, message)
+		})
+	require.Equal(t, 2, assembler.GetMaxPendingMessages())
+	require.Equal(t, int64(10), assembler.GetMaxPendingBytes())
+
+	first, second, third := _NewTestTransactionID(1), _NewTestTransactionID(2), _NewTestTransactionID(3)
+	assembler.Add(_NewTestTopicMessagePart(first, 1, 2, 1, "aa"))
+	assembler.Add(_NewTestTopicMessagePart(second, 1, 2, 2, "bb"))
+	require.Empty(t, incomplete)
+
+	// Too many messages, the oldest is evicted
+	assembler.Add(_NewTestTopicMessagePart(third, 1, 3, 3, "cc"))
+	require.Len(t, incomplete, 1)
+	require.Equal(t, first.String(), incomplete[0].InitialTransactionID.String())
+	require.Equal(t, int32(2), incomplete[0].ChunkTotal)
+	require.Equal(t, "aa", string(incomplete[0].Parts[0].Contents))
+	require.False(t, incomplete[0].Expired)
+
+	// Too many bytes
+	assembler.Add(_NewTestTopicMessagePart(third, 2, 3, 4, "cccccccc"))
+	require.Len(t, incomplete, 2)
+	require.Equal(t, second.String(), incomplete[1].InitialTransactionID.String())
+	require.Equal(t, 1, assembler.GetPendingMessages())
+	require.Equal(t, int64(10), assembler.GetPendingBytes())
+
+	// The chunks of an evicted message start over
+	_, ok := assembler.Add(_NewTestTopicMessagePart(first, 2, 2, 5, "aa"))
+	require.False(t, ok)
+}
+
+func TestUnitTopicMessageAssemblerTimeout(t *testing.T) {
+	incomplete := make([]IncompleteTopicMessage, 0)
+	assembler := NewTopicMessageAssembler().
+		SetTimeout(10 * time.Second).
+		SetOnIncompleteMessage(func(message IncompleteTopicMessage) {
+			incomplete = append(incomplete, message)
+		})
+	require.Equal(t, 10*time.Second, assembler.GetTimeout())
+
+	first, second := _NewTestTransactionID(1), _NewTestTransactionID(2)
+	assembler.Add(_NewTestTopicMessagePart(first, 1, 2, 1, "a"))
+	assembler.Add(_NewTestTopicMessagePart(second, 1, 2, 5, "b"))
+
+	// Parts reaching consensus after the timeout expire older messages
+	message, ok := assembler.Add(_NewTestTopicMessagePart(nil, 1, 1, 12, "single"))
+	require.True(t, ok)
+	require.Equal(t, "single", string(message.Contents))
+	require.Len(t, incomplete, 1)
+	require.Equal(t, first.String(), incomplete[0].InitialTransactionID.String())
+	require.True(t, incomplete[0].Expired)
+
+	assembler.Expire(_TestAssemblerStart.Add(time.Minute))
+	require.Len(t, incomplete, 2)
+	require.Equal(t, second.String(), incomplete[1].InitialTransactionID.String())
+	require.Equal(t, 0, assembler.GetPendingMessages())
+}
+
+func TestUnitTopicMessageQueryIncompleteMessage(t *testing.T) {
+	txID := TransactionIDGenerate(AccountID{Account: 1800})
+	responses := []interface{}{
+		&mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(_TestAssemblerStart),
+			Message:            []byte("chunk 1"),
+			SequenceNumber:     1,
+			ChunkInfo:          &services.ConsensusMessageChunkInfo{InitialTransactionID: txID._ToProtobuf(), Total: 2, Number: 1},
+		},
+		&mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(_TestAssemblerStart.Add(time.Hour)),
+			Message:            []byte("single"),
+			SequenceNumber:     2,
+		},
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+
+	incomplete := make(chan IncompleteTopicMessage, 1)
+	received := make(chan TopicMessage, 1)
+	query := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 1001}).
+		SetMaxPendingChunkedMessages(5).
+		SetMaxPendingChunkBytes(1024).
+		SetChunkTimeout(time.Minute).
+		SetOnIncompleteMessage(func(message IncompleteTopicMessage) {
+			incomplete <- message
+		})
+	require.Equal(t, 5, query.GetMaxPendingChunkedMessages())
+	require.Equal(t, int64(1024), query.GetMaxPendingChunkBytes())
+	require.Equal(t, time.Minute, query.GetChunkTimeout())
+
+	_, err := query.Subscribe(client, func(message TopicMessage) {
+		received <- message
+	})
+	require.NoError(t, err)
+
+	select {
+	case message := <-incomplete:
+		require.Equal(t, txID.String(), message.InitialTransactionID.String())
+		require.True(t, message.Expired)
+	case <-time.After(10 * time.Second):
+		t.Fatal("incomplete message was not reported")
+	}
+	require.Equal(t, "single", string((<-received).Contents))
+}
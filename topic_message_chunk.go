@@ -22,8 +22,6 @@ package hedera
 
 import (
 	"time"
-
-	"github.com/hashgraph/hedera-protobufs-go/mirror"
 )
 
 type TopicMessageChunk struct {
@@ -33,11 +31,11 @@ type TopicMessageChunk struct {
 	SequenceNumber     uint64
 }
 
-func _NewTopicMessageChunk(resp *mirror.ConsensusTopicResponse) TopicMessageChunk {
+func _NewTopicMessageChunk(part TopicMessagePart) TopicMessageChunk {
 	return TopicMessageChunk{
-		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
-		ContentSize:        uint64(len(resp.Message)),
-		RunningHash:        resp.RunningHash,
-		SequenceNumber:     resp.SequenceNumber,
+		ConsensusTimestamp: part.ConsensusTimestamp,
+		ContentSize:        uint64(len(part.Contents)),
+		RunningHash:        part.RunningHash,
+		SequenceNumber:     part.SequenceNumber,
 	}
 }
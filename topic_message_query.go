@@ -48,16 +48,31 @@ type TopicMessageQuery struct {
 	startTime         *time.Time
 	endTime           *time.Time
 	limit             uint64
+
+	trustedRunningHash      []byte
+	trustedSequenceNumber   uint64
+	runningHashErrorHandler func(err error)
+
+	checkpointStore CheckpointStore
+	bufferSize      int
+
+	maxPendingChunkedMessages int
+	maxPendingChunkBytes      int64
+	chunkTimeout              time.Duration
+	onIncompleteMessage       func(IncompleteTopicMessage)
 }
 
 // NewTopicMessageQuery creates TopicMessageQuery which
 // listens to messages sent to the specific TopicID
 func NewTopicMessageQuery() *TopicMessageQuery {
 	return &TopicMessageQuery{
-		maxAttempts:       maxAttempts,
-		errorHandler:      _DefaultErrorHandler,
-		retryHandler:      _DefaultRetryHandler,
-		completionHandler: _DefaultCompletionHandler,
+		maxAttempts:  maxAttempts,
+		retryHandler: _DefaultRetryHandler,
+		bufferSize:   16,
+
+		maxPendingChunkedMessages: 1000,
+		maxPendingChunkBytes:      32 * 1024 * 1024,
+		chunkTimeout:              5 * time.Minute,
 	}
 }
 
@@ -142,8 +157,118 @@ func (query *TopicMessageQuery) SetRetryHandler(retryHandler func(err error) boo
 	return query
 }
 
+// SetTrustedRunningHash enables local verification of the topic running hash. The running hash and
+// sequence number must be those of a message that is already trusted, such as the TopicRunningHash and
+// TopicSequenceNumber of a TransactionReceipt, or 48 zero bytes and 0 for a newly created topic.
+// Every message received after it is checked against the version 3 running hash computed locally, and
+// skipped messages or mismatching hashes are passed to the running hash error handler.
+//
+// The running hash covers the account that paid for the message, which the mirror node only reports
+// through the chunk info of the message. Messages submitted without chunk info, which includes single
+// chunk messages sent by other clients, are passed to the handler as ErrTopicRunningHashUnverifiable.
+// TopicMessageSubmitTransaction sets the chunk info on every chunk, including the only one of a short
+// message, so the messages it submits can be verified.
+func (query *TopicMessageQuery) SetTrustedRunningHash(runningHash []byte, sequenceNumber uint64) *TopicMessageQuery {
+	query.trustedRunningHash = append([]byte{}, runningHash...)
+	query.trustedSequenceNumber = sequenceNumber
+	return query
+}
+
+// SetTrustedReceipt enables local verification of the topic running hash, starting from the running hash
+// in the receipt of a TopicMessageSubmitTransaction.
+func (query *TopicMessageQuery) SetTrustedReceipt(receipt TransactionReceipt) *TopicMessageQuery {
+	return query.SetTrustedRunningHash(receipt.TopicRunningHash, receipt.TopicSequenceNumber)
+}
+
+func (query *TopicMessageQuery) GetTrustedRunningHash() []byte {
+	return query.trustedRunningHash
+}
+
+func (query *TopicMessageQuery) GetTrustedSequenceNumber() uint64 {
+	return query.trustedSequenceNumber
+}
+
+// SetRunningHashErrorHandler sets the handler called with an ErrTopicSequenceGap or an
+// ErrTopicRunningHashMismatch when running hash verification fails, or with an
+// ErrTopicRunningHashUnverifiable when a message does not carry what is needed to verify it. The message
+// is still delivered afterwards and verification continues from the running hash reported by the mirror node.
+func (query *TopicMessageQuery) SetRunningHashErrorHandler(runningHashErrorHandler func(err error)) *TopicMessageQuery {
+	query.runningHashErrorHandler = runningHashErrorHandler
+	return query
+}
+
+// SetCheckpointStore sets the store SubscribeChannel saves acknowledged messages to and resumes from.
+func (query *TopicMessageQuery) SetCheckpointStore(store CheckpointStore) *TopicMessageQuery {
+	query.checkpointStore = store
+	return query
+}
+
+func (query *TopicMessageQuery) GetCheckpointStore() CheckpointStore {
+	return query.checkpointStore
+}
+
+// SetBufferSize sets how many messages SubscribeChannel buffers before it stops reading from the mirror node.
+// Defaults to 16.
+func (query *TopicMessageQuery) SetBufferSize(bufferSize int) *TopicMessageQuery {
+	query.bufferSize = bufferSize
+	return query
+}
+
+func (query *TopicMessageQuery) GetBufferSize() int {
+	return query.bufferSize
+}
+
+// SetMaxPendingChunkedMessages sets how many chunked messages may be waiting for their remaining chunks. When
+// there are more the oldest is given up on and passed to the incomplete message handler. Defaults to 1000,
+// zero means no limit.
+func (query *TopicMessageQuery) SetMaxPendingChunkedMessages(maxPendingChunkedMessages int) *TopicMessageQuery {
+	query.maxPendingChunkedMessages = maxPendingChunkedMessages
+	return query
+}
+
+func (query *TopicMessageQuery) GetMaxPendingChunkedMessages() int {
+	return query.maxPendingChunkedMessages
+}
+
+// SetMaxPendingChunkBytes sets the size of the chunks that may be kept for chunked messages waiting for their
+// remaining chunks, giving up on the oldest messages first. Defaults to 32 MiB, zero means no limit.
+func (query *TopicMessageQuery) SetMaxPendingChunkBytes(maxPendingChunkBytes int64) *TopicMessageQuery {
+	query.maxPendingChunkBytes = maxPendingChunkBytes
+	return query
+}
+
+func (query *TopicMessageQuery) GetMaxPendingChunkBytes() int64 {
+	return query.maxPendingChunkBytes
+}
+
+// SetChunkTimeout sets how much consensus time may pass after the first chunk of a chunked message before it
+// is given up on. Defaults to 5 minutes, zero means chunked messages never time out.
+func (query *TopicMessageQuery) SetChunkTimeout(chunkTimeout time.Duration) *TopicMessageQuery {
+	query.chunkTimeout = chunkTimeout
+	return query
+}
+
+func (query *TopicMessageQuery) GetChunkTimeout() time.Duration {
+	return query.chunkTimeout
+}
+
+// SetOnIncompleteMessage sets the handler called with the chunked messages that are given up on, either
+// because they timed out or to stay within the limits. By default they are logged.
+func (query *TopicMessageQuery) SetOnIncompleteMessage(onIncompleteMessage func(IncompleteTopicMessage)) *TopicMessageQuery {
+	query.onIncompleteMessage = onIncompleteMessage
+	return query
+}
+
+func (query *TopicMessageQuery) _NewTopicMessageAssembler() *TopicMessageAssembler {
+	return NewTopicMessageAssembler().
+		SetMaxPendingMessages(query.maxPendingChunkedMessages).
+		SetMaxPendingBytes(query.maxPendingChunkBytes).
+		SetTimeout(query.chunkTimeout).
+		SetOnIncompleteMessage(query.onIncompleteMessage)
+}
+
 func (query *TopicMessageQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -178,43 +303,100 @@ func (query *TopicMessageQuery) _Build() *mirror.ConsensusTopicQuery {
 }
 
 func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessage)) (SubscriptionHandle, error) {
-	handle := SubscriptionHandle{}
-
 	err := query._ValidateNetworkOnIDs(client)
 	if err != nil {
 		return SubscriptionHandle{}, err
 	}
 
-	pb := query._Build()
+	var verifier *_TopicRunningHashVerifier
+	if query.trustedRunningHash != nil {
+		verifier = _NewTopicRunningHashVerifier(query.GetTopicID(), query.trustedRunningHash, query.trustedSequenceNumber)
+	}
 
-	messages := make(map[string][]*mirror.ConsensusTopicResponse)
+	return query._Subscribe(client, query._Build(), query._NewTopicMessageAssembler(), verifier, onNext, nil)
+}
 
+// _Subscribe streams the responses of the mirror node for the query in pb, retrying as configured, and passes
+// every message completed by the assembler to onNext. onEnd, if set, is called once no more messages will be delivered.
+func (query *TopicMessageQuery) _Subscribe(
+	client *Client,
+	pb *mirror.ConsensusTopicQuery,
+	assembler *TopicMessageAssembler,
+	verifier *_TopicRunningHashVerifier,
+	onNext func(TopicMessage),
+	onEnd func(),
+) (SubscriptionHandle, error) {
 	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetConsensusServiceClient()
 	if err != nil {
-		return handle, err
+		return SubscriptionHandle{}, err
+	}
+
+	logger := client._GetLogger().With("topicId", query.GetTopicID().String())
+	errorHandler := query.errorHandler
+	if errorHandler == nil {
+		errorHandler = _DefaultErrorHandler(logger)
+	}
+	completionHandler := query.completionHandler
+	if completionHandler == nil {
+		completionHandler = _DefaultCompletionHandler(logger)
+	}
+	runningHashErrorHandler := query.runningHashErrorHandler
+	if runningHashErrorHandler == nil {
+		runningHashErrorHandler = _DefaultRunningHashErrorHandler(logger)
+	}
+	if assembler.onIncompleteMessage == nil {
+		assembler.onIncompleteMessage = _DefaultIncompleteMessageHandler(logger)
+	}
+
+	spanCtx, span := client._GetTracer().Start(context.Background(), SpanTopicSubscribe, Attribute{AttributeTopicID, query.GetTopicID().String()})
+	meter := client._GetMeter()
+
+	subscriptionCtx, unsubscribe := context.WithCancel(spanCtx)
+	handle := SubscriptionHandle{
+		onUnsubscribe: unsubscribe,
 	}
 
 	go func() {
 		var subClient mirror.ConsensusService_SubscribeTopicClient
+		var cancelAttempt context.CancelFunc
 		var err error
 
+		defer span.End()
+		if onEnd != nil {
+			defer onEnd()
+		}
+
 		for {
 			if err != nil {
-				handle.Unsubscribe()
+				cancelAttempt()
+
+				if subscriptionCtx.Err() != nil {
+					span.SetAttributes(Attribute{AttributeStatus, codes.Canceled.String()}, Attribute{AttributeRetryCount, query.attempt})
+					break
+				}
 
 				if grpcErr, ok := status.FromError(err); ok { // nolint
 					if query.attempt < query.maxAttempts && query.retryHandler(err) {
 						subClient = nil
 
 						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
+						span.AddEvent(EventRetry,
+							Attribute{AttributeAttempt, query.attempt},
+							Attribute{AttributeBackoffDelay, delay},
+							Attribute{AttributeError, grpcErr.Code().String()},
+						)
+						meter.RecordHistogram(spanCtx, MetricBackoffDelay, delay, Attribute{AttributeRequestType, "TopicMessageQuery"})
 						time.Sleep(time.Duration(delay) * time.Millisecond)
 						query.attempt++
 					} else {
-						query.errorHandler(*grpcErr)
+						span.RecordError(err)
+						span.SetAttributes(Attribute{AttributeStatus, grpcErr.Code().String()}, Attribute{AttributeRetryCount, query.attempt})
+						errorHandler(*grpcErr)
 						break
 					}
 				} else if err == io.EOF {
-					query.completionHandler()
+					span.SetAttributes(Attribute{AttributeStatus, codes.OK.String()}, Attribute{AttributeRetryCount, query.attempt})
+					completionHandler()
 					break
 				} else {
 					panic(err)
@@ -222,8 +404,8 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 			}
 
 			if subClient == nil {
-				ctx, cancel := context.WithCancel(context.TODO())
-				handle.onUnsubscribe = cancel
+				var ctx context.Context
+				ctx, cancelAttempt = context.WithCancel(subscriptionCtx)
 
 				subClient, err = (*channel).SubscribeTopic(ctx, pb)
 
@@ -247,36 +429,50 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 				pb.Limit--
 			}
 
-			if resp.ChunkInfo == nil || resp.ChunkInfo.Total == 1 {
-				onNext(_TopicMessageOfSingle(resp))
-			} else {
-				txID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID).String()
-				message, ok := messages[txID]
-				if !ok {
-					message = make([]*mirror.ConsensusTopicResponse, 0, resp.ChunkInfo.Total)
-				}
-
-				message = append(message, resp)
-				messages[txID] = message
-
-				if int32(len(message)) == resp.ChunkInfo.Total {
-					delete(messages, txID)
+			meter.AddCounter(spanCtx, MetricTopicMessages, 1, Attribute{AttributeTopicID, query.GetTopicID().String()})
 
-					onNext(_TopicMessageOfMany(message))
+			if verifier != nil {
+				if err := verifier._Verify(resp); err != nil {
+					runningHashErrorHandler(err)
 				}
 			}
+
+			if message, ok := assembler.Add(_TopicMessagePartFromProtobuf(resp)); ok {
+				onNext(message)
+			}
 		}
 	}()
 
 	return handle, nil
 }
 
-func _DefaultErrorHandler(stat status.Status) {
-	println("Failed to subscribe to topic with status", stat.Code().String())
+func _DefaultErrorHandler(logger Logger) func(stat status.Status) {
+	return func(stat status.Status) {
+		logger.Error("failed to subscribe to topic", "status", stat.Code().String())
+	}
 }
 
-func _DefaultCompletionHandler() {
-	println("Subscription to topic finished")
+func _DefaultCompletionHandler(logger Logger) func() {
+	return func() {
+		logger.Info("subscription to topic finished")
+	}
+}
+
+func _DefaultRunningHashErrorHandler(logger Logger) func(err error) {
+	return func(err error) {
+		if _, ok := err.(ErrTopicRunningHashUnverifiable); ok {
+			logger.Warn("cannot verify topic running hash", "error", err.Error())
+			return
+		}
+		logger.Error("failed to verify topic running hash", "error", err.Error())
+	}
+}
+
+func _DefaultIncompleteMessageHandler(logger Logger) func(message IncompleteTopicMessage) {
+	return func(message IncompleteTopicMessage) {
+		logger.Warn("dropped incomplete chunked topic message", "transactionId", message.InitialTransactionID.String(),
+			"receivedChunks", len(message.Parts), "totalChunks", message.ChunkTotal, "expired", message.Expired)
+	}
 }
 
 func _DefaultRetryHandler(err error) bool {
@@ -377,3 +377,55 @@ func TestIntegrationTopicMessageQueryCanExecuteWithTls(t *testing.T) {
 
 	handle.Unsubscribe()
 }
+
+// The running hash computed locally must match the one the consensus nodes put in the receipt; the inputs are
+// logged so that a failure can be turned into a unit test vector.
+func TestIntegrationTopicRunningHashMatchesReceipts(t *testing.T) {
+	env := NewIntegrationTestEnv(t)
+
+	resp, err := NewTopicCreateTransaction().
+		SetAdminKey(env.Client.GetOperatorPublicKey()).
+		SetNodeAccountIDs(env.NodeAccountIDs).
+		Execute(env.Client)
+	require.NoError(t, err)
+
+	receipt, err := resp.SetValidateStatus(true).GetReceipt(env.Client)
+	require.NoError(t, err)
+	topicID := *receipt.TopicID
+
+	previous := receipt.TopicRunningHash
+	for _, message := range []string{"first", "second"} {
+		resp, err = NewTopicMessageSubmitTransaction().
+			SetNodeAccountIDs([]AccountID{resp.NodeID}).
+			SetMessage([]byte(message)).
+			SetTopicID(topicID).
+			Execute(env.Client)
+		require.NoError(t, err)
+
+		record, err := resp.SetValidateStatus(true).GetRecord(env.Client)
+		require.NoError(t, err)
+
+		t.Logf("previous=%x payer=%s topic=%s timestamp=%d sequence=%d message=%q running hash=%x",
+			previous, record.TransactionID.AccountID.String(), topicID.String(), record.ConsensusTimestamp.UnixNano(),
+			record.Receipt.TopicSequenceNumber, message, record.Receipt.TopicRunningHash)
+
+		if previous != nil {
+			expected := _TopicRunningHashV3(previous, *record.TransactionID.AccountID, topicID,
+				_TimeToProtobuf(record.ConsensusTimestamp), record.Receipt.TopicSequenceNumber, []byte(message))
+			assert.Equal(t, record.Receipt.TopicRunningHash, expected)
+		}
+		previous = record.Receipt.TopicRunningHash
+	}
+
+	resp, err = NewTopicDeleteTransaction().
+		SetTopicID(topicID).
+		SetNodeAccountIDs([]AccountID{resp.NodeID}).
+		Execute(env.Client)
+	require.NoError(t, err)
+
+	_, err = resp.SetValidateStatus(true).GetReceipt(env.Client)
+	require.NoError(t, err)
+
+	err = CloseIntegrationTestEnv(env, nil)
+	require.NoError(t, err)
+}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -126,7 +127,7 @@ func (transaction *TopicMessageSubmitTransaction) SignWithOperator(
 
 	if client == nil {
 		return nil, errNoClientProvided
-	} else if client.operator == nil {
+	} else if client._GetOperator() == nil {
 		return nil, errClientOperatorSigning
 	}
 
@@ -136,7 +137,7 @@ func (transaction *TopicMessageSubmitTransaction) SignWithOperator(
 			return transaction, err
 		}
 	}
-	return transaction.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return transaction.SignWithSigner(client._GetOperator().signer), nil
 }
 
 // SignWith executes the TransactionSigner and adds the resulting signature data to the Transaction's signature map
@@ -152,8 +153,20 @@ func (transaction *TopicMessageSubmitTransaction) SignWith(
 	return transaction
 }
 
+// SignWithSigner uses the Signer to sign the transaction and adds the resulting signature data to the
+// Transaction's signature map with the Signer's public key as the map key.
+func (transaction *TopicMessageSubmitTransaction) SignWithSigner(
+	signer Signer,
+) *TopicMessageSubmitTransaction {
+	if !transaction._KeyAlreadySigned(signer.GetPublicKey()) {
+		transaction._SignWithSigner(signer)
+	}
+
+	return transaction
+}
+
 func (transaction *TopicMessageSubmitTransaction) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -223,6 +236,15 @@ func (transaction *TopicMessageSubmitTransaction) _ConstructScheduleProtobuf() (
 
 func (transaction *TopicMessageSubmitTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (transaction *TopicMessageSubmitTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -232,7 +254,7 @@ func (transaction *TopicMessageSubmitTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
-	list, err := transaction.ExecuteAll(client)
+	list, err := transaction.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		return TransactionResponse{}, err
@@ -249,6 +271,19 @@ func (transaction *TopicMessageSubmitTransaction) Execute(
 func (transaction *TopicMessageSubmitTransaction) ExecuteAll(
 	client *Client,
 ) ([]TransactionResponse, error) {
+	return transaction.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes all the Transactions with the provided client. The context bounds
+// every attempt, the backoff waits between attempts and node selection.
+func (transaction *TopicMessageSubmitTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
+) ([]TransactionResponse, error) {
+	if err := transaction._SelectNodesForExecute(ctx, client); err != nil {
+		return []TransactionResponse{}, err
+	}
+
 	if !transaction.IsFrozen() {
 		_, err := transaction.FreezeWith(client)
 		if err != nil {
@@ -262,10 +297,7 @@ func (transaction *TopicMessageSubmitTransaction) ExecuteAll(
 	}
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(accountID) {
-		transaction.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		transaction.SignWithSigner(client._GetOperator().signer)
 	}
 
 	size := transaction.signedTransactions._Length() / transaction.nodeAccountIDs._Length()
@@ -273,6 +305,7 @@ func (transaction *TopicMessageSubmitTransaction) ExecuteAll(
 
 	for i := 0; i < size; i++ {
 		resp, err := _Execute(
+			ctx,
 			client,
 			&transaction.Transaction,
 			_TransactionShouldRetry,
@@ -0,0 +1,148 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// TopicRunningHashVersion is the version of the running hash computed by TopicMessageQuery when verifying messages
+const TopicRunningHashVersion uint64 = 3
+
+// _TopicRunningHashVerifier recomputes the running hash of a topic for every message received from the
+// mirror node, starting from a trusted running hash, and checks it against the one the mirror node reports.
+type _TopicRunningHashVerifier struct {
+	topicID        TopicID
+	runningHash    []byte
+	sequenceNumber uint64
+}
+
+func _NewTopicRunningHashVerifier(topicID TopicID, runningHash []byte, sequenceNumber uint64) *_TopicRunningHashVerifier {
+	return &_TopicRunningHashVerifier{
+		topicID:        topicID,
+		runningHash:    append([]byte{}, runningHash...),
+		sequenceNumber: sequenceNumber,
+	}
+}
+
+// _Verify checks the message against the running hash chain. Messages at or before the trusted starting
+// point are ignored, and messages whose hash cannot be computed are reported as unverifiable rather than
+// as a mismatch. After a failure the chain continues from the running hash reported by the mirror node,
+// so that a single bad message is not reported again for every message that follows.
+func (verifier *_TopicRunningHashVerifier) _Verify(resp *mirror.ConsensusTopicResponse) error {
+	if resp.SequenceNumber <= verifier.sequenceNumber {
+		return nil
+	}
+
+	defer func() {
+		verifier.runningHash = resp.RunningHash
+		verifier.sequenceNumber = resp.SequenceNumber
+	}()
+
+	if resp.SequenceNumber != verifier.sequenceNumber+1 {
+		return ErrTopicSequenceGap{
+			TopicID:                verifier.topicID,
+			ExpectedSequenceNumber: verifier.sequenceNumber + 1,
+			SequenceNumber:         resp.SequenceNumber,
+		}
+	}
+
+	if resp.RunningHashVersion != 0 && resp.RunningHashVersion != TopicRunningHashVersion {
+		return ErrTopicRunningHashUnverifiable{
+			TopicID:        verifier.topicID,
+			SequenceNumber: resp.SequenceNumber,
+			Reason:         fmt.Sprintf("unsupported running hash version %d", resp.RunningHashVersion),
+		}
+	}
+
+	// The mirror node only reports the payer of a message through the chunk info
+	if resp.ChunkInfo == nil || resp.ChunkInfo.InitialTransactionID == nil || resp.ChunkInfo.InitialTransactionID.AccountID == nil {
+		return ErrTopicRunningHashUnverifiable{
+			TopicID:        verifier.topicID,
+			SequenceNumber: resp.SequenceNumber,
+			Reason:         "payer unknown",
+		}
+	}
+
+	payer := *_AccountIDFromProtobuf(resp.ChunkInfo.InitialTransactionID.AccountID)
+	mismatch := ErrTopicRunningHashMismatch{
+		TopicID:        verifier.topicID,
+		SequenceNumber: resp.SequenceNumber,
+		RunningHash:    resp.RunningHash,
+		ExpectedRunningHash: _TopicRunningHashV3(
+			verifier.runningHash,
+			payer,
+			verifier.topicID,
+			resp.ConsensusTimestamp,
+			resp.SequenceNumber,
+			resp.Message,
+		),
+	}
+
+	if !bytes.Equal(mismatch.ExpectedRunningHash, resp.RunningHash) {
+		return mismatch
+	}
+
+	return nil
+}
+
+// _TopicRunningHashV3 computes the version 3 running hash of a topic after the given message, as done by the
+// consensus nodes: SHA-384 over the previous running hash, the version, the payer and topic IDs, the consensus
+// timestamp, the sequence number and the SHA-384 hash of the message.
+func _TopicRunningHashV3(
+	previous []byte,
+	payer AccountID,
+	topicID TopicID,
+	consensusTimestamp *services.Timestamp,
+	sequenceNumber uint64,
+	message []byte,
+) []byte {
+	var buffer bytes.Buffer
+	buffer.Write(previous)
+
+	for _, value := range []uint64{
+		TopicRunningHashVersion,
+		payer.Shard,
+		payer.Realm,
+		payer.Account,
+		topicID.Shard,
+		topicID.Realm,
+		topicID.Topic,
+		uint64(consensusTimestamp.GetSeconds()),
+	} {
+		_ = binary.Write(&buffer, binary.BigEndian, value)
+	}
+	_ = binary.Write(&buffer, binary.BigEndian, consensusTimestamp.GetNanos())
+	_ = binary.Write(&buffer, binary.BigEndian, sequenceNumber)
+
+	messageHash := sha512.Sum384(message)
+	buffer.Write(messageHash[:])
+
+	runningHash := sha512.Sum384(buffer.Bytes())
+
+	return runningHash[:]
+}
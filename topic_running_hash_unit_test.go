@@ -0,0 +1,187 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+type _TopicRunningHashChain struct {
+	topicID        TopicID
+	runningHash    []byte
+	sequenceNumber uint64
+	timestamp      time.Time
+}
+
+func (chain *_TopicRunningHashChain) _Next(payer AccountID, message []byte, chunkInfo *services.ConsensusMessageChunkInfo) *mirror.ConsensusTopicResponse {
+	chain.sequenceNumber++
+	chain.timestamp = chain.timestamp.Add(time.Second)
+	if chunkInfo == nil {
+		chunkInfo = &services.ConsensusMessageChunkInfo{Total: 1, Number: 1}
+	}
+	chunkInfo.InitialTransactionID = TransactionIDGenerate(payer)._ToProtobuf()
+
+	timestamp := _TimeToProtobuf(chain.timestamp)
+	chain.runningHash = _TopicRunningHashV3(chain.runningHash, payer, chain.topicID, timestamp, chain.sequenceNumber, message)
+
+	return &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: timestamp,
+		Message:            message,
+		RunningHash:        chain.runningHash,
+		SequenceNumber:     chain.sequenceNumber,
+		RunningHashVersion: TopicRunningHashVersion,
+		ChunkInfo:          chunkInfo,
+	}
+}
+
+func TestUnitTopicRunningHashV3(t *testing.T) {
+	timestamp := &services.Timestamp{Seconds: 1672531200, Nanos: 5}
+	hash := _TopicRunningHashV3(make([]byte, 48), AccountID{Account: 2}, TopicID{Topic: 1001}, timestamp, 1, []byte("hello"))
+	require.Len(t, hash, 48)
+
+	// Every input is part of the hash
+	require.NotEqual(t, hash, _TopicRunningHashV3(make([]byte, 48), AccountID{Account: 3}, TopicID{Topic: 1001}, timestamp, 1, []byte("hello")))
+	require.NotEqual(t, hash, _TopicRunningHashV3(make([]byte, 48), AccountID{Account: 2}, TopicID{Topic: 1002}, timestamp, 1, []byte("hello")))
+	require.NotEqual(t, hash, _TopicRunningHashV3(make([]byte, 48), AccountID{Account: 2}, TopicID{Topic: 1001}, &services.Timestamp{Seconds: 1672531200, Nanos: 6}, 1, []byte("hello")))
+	require.NotEqual(t, hash, _TopicRunningHashV3(make([]byte, 48), AccountID{Account: 2}, TopicID{Topic: 1001}, timestamp, 2, []byte("hello")))
+	require.NotEqual(t, hash, _TopicRunningHashV3(make([]byte, 48), AccountID{Account: 2}, TopicID{Topic: 1001}, timestamp, 1, []byte("hellO")))
+	require.Equal(t, hash, _TopicRunningHashV3(make([]byte, 48), AccountID{Account: 2}, TopicID{Topic: 1001}, timestamp, 1, []byte("hello")))
+
+	// The preimage written out field by field as the consensus nodes lay it out, all integers big-endian
+	preimage, err := hex.DecodeString(strings.Join([]string{
+		strings.Repeat("00", 48),                                   // previous running hash
+		"0000000000000003",                                         // running hash version
+		"0000000000000000", "0000000000000000", "0000000000000002", // payer 0.0.2
+		"0000000000000000", "0000000000000000", "00000000000003e9", // topic 0.0.1001
+		"0000000063b0cd00", // consensus timestamp seconds
+		"00000005",         // consensus timestamp nanos, 32 bits
+		"0000000000000001", // sequence number
+	}, ""))
+	require.NoError(t, err)
+	messageHash := sha512.Sum384([]byte("hello"))
+	expected := sha512.Sum384(append(preimage, messageHash[:]...))
+	require.Equal(t, expected[:], hash)
+}
+
+func TestUnitTopicRunningHashVerifier(t *testing.T) {
+	topicID := TopicID{Topic: 1001}
+	payer := AccountID{Account: 1800}
+	chain := &_TopicRunningHashChain{topicID: topicID, runningHash: make([]byte, 48), timestamp: time.Unix(1672531200, 0)}
+	verifier := _NewTopicRunningHashVerifier(topicID, chain.runningHash, 0)
+
+	require.NoError(t, verifier._Verify(chain._Next(payer, []byte("first"), nil)))
+	require.NoError(t, verifier._Verify(chain._Next(payer, []byte("second"), nil)))
+
+	// Replayed messages are ignored
+	replayed := chain._Next(payer, []byte("third"), nil)
+	require.NoError(t, verifier._Verify(replayed))
+	require.NoError(t, verifier._Verify(replayed))
+
+	tampered := chain._Next(payer, []byte("fourth"), nil)
+	tampered.Message = []byte("forged")
+	err := verifier._Verify(tampered)
+	require.ErrorAs(t, err, &ErrTopicRunningHashMismatch{})
+	require.Equal(t, uint64(4), err.(ErrTopicRunningHashMismatch).SequenceNumber)
+	require.NotNil(t, err.(ErrTopicRunningHashMismatch).ExpectedRunningHash)
+
+	// Verification continues from the hash reported by the mirror node
+	require.NoError(t, verifier._Verify(chain._Next(payer, []byte("fifth"), nil)))
+
+	chain._Next(payer, []byte("skipped"), nil)
+	err = verifier._Verify(chain._Next(payer, []byte("seventh"), nil))
+	require.Equal(t, ErrTopicSequenceGap{TopicID: topicID, ExpectedSequenceNumber: 6, SequenceNumber: 7}, err)
+
+	// Messages without a payer are reported as unverifiable, not as tampered with
+	unverifiable := chain._Next(payer, []byte("eighth"), nil)
+	unverifiable.ChunkInfo = nil
+	err = verifier._Verify(unverifiable)
+	require.Equal(t, ErrTopicRunningHashUnverifiable{TopicID: topicID, SequenceNumber: 8, Reason: "payer unknown"}, err)
+
+	unsupported := chain._Next(payer, []byte("ninth"), nil)
+	unsupported.RunningHashVersion = 4
+	err = verifier._Verify(unsupported)
+	require.ErrorAs(t, err, &ErrTopicRunningHashUnverifiable{})
+
+	require.NoError(t, verifier._Verify(chain._Next(payer, []byte("tenth"), nil)))
+}
+
+func TestUnitTopicMessageQueryVerifiesRunningHash(t *testing.T) {
+	topicID := TopicID{Topic: 1001}
+	payer := AccountID{Account: 1800}
+	chain := &_TopicRunningHashChain{topicID: topicID, runningHash: make([]byte, 48), timestamp: time.Unix(1672531200, 0)}
+
+	trusted := chain._Next(payer, []byte("trusted"), nil)
+	single := chain._Next(payer, []byte("single"), nil)
+	firstChunk := chain._Next(payer, []byte("chunk 1 "), &services.ConsensusMessageChunkInfo{Total: 2, Number: 1})
+	secondChunk := chain._Next(payer, []byte("chunk 2"), &services.ConsensusMessageChunkInfo{Total: 2, Number: 2})
+	secondChunk.ChunkInfo.InitialTransactionID = firstChunk.ChunkInfo.InitialTransactionID
+	tampered := chain._Next(payer, []byte("tampered"), nil)
+	tampered.Message = []byte("forged")
+
+	client, server := NewMockClientAndServer([][]interface{}{{trusted, single, firstChunk, secondChunk, tampered}})
+	defer server.Close()
+
+	var mu sync.Mutex
+	contents := make([]string, 0)
+	errs := make([]error, 0)
+	done := make(chan struct{})
+
+	_, err := NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetTrustedRunningHash(trusted.RunningHash, trusted.SequenceNumber).
+		SetRunningHashErrorHandler(func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			errs = append(errs, err)
+		}).
+		SetCompletionHandler(func() {
+			close(done)
+		}).
+		Subscribe(client, func(message TopicMessage) {
+			mu.Lock()
+			defer mu.Unlock()
+			contents = append(contents, string(message.Contents))
+		})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"trusted", "single", "chunk 1 chunk 2", "forged"}, contents)
+	require.Len(t, errs, 1)
+	require.Equal(t, tampered.SequenceNumber, errs[0].(ErrTopicRunningHashMismatch).SequenceNumber)
+}
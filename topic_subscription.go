@@ -0,0 +1,225 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+)
+
+// TopicSubscription delivers the messages of a topic through a bounded channel, returned by
+// TopicMessageQuery.SubscribeChannel. While the channel is full no more messages are read from the mirror
+// node. Acknowledged messages are recorded in the query's CheckpointStore, so that a later subscription
+// to the same topic resumes right after the last acknowledged message.
+type TopicSubscription struct {
+	topicID  TopicID
+	store    CheckpointStore
+	messages chan TopicMessage
+	handle   SubscriptionHandle
+	closed   chan struct{}
+	once     sync.Once
+
+	mu           sync.Mutex
+	delivered    []TopicCheckpoint
+	acknowledged *TopicCheckpoint
+	err          error
+}
+
+// SubscribeChannel subscribes to the topic and returns a TopicSubscription delivering its messages. If a
+// CheckpointStore is set the subscription resumes after the last message acknowledged for the topic, ignoring
+// the start time. The subscription is closed when ctx is done.
+func (query *TopicMessageQuery) SubscribeChannel(ctx context.Context, client *Client) (*TopicSubscription, error) {
+	err := query._ValidateNetworkOnIDs(client)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := query._Build()
+	assembler := query._NewTopicMessageAssembler()
+
+	var verifier *_TopicRunningHashVerifier
+	if query.trustedRunningHash != nil {
+		verifier = _NewTopicRunningHashVerifier(query.GetTopicID(), query.trustedRunningHash, query.trustedSequenceNumber)
+	}
+
+	subscription := &TopicSubscription{
+		topicID:  query.GetTopicID(),
+		store:    query.checkpointStore,
+		messages: make(chan TopicMessage, query.bufferSize),
+		closed:   make(chan struct{}),
+	}
+
+	if query.checkpointStore != nil {
+		checkpoint, err := query.checkpointStore.LoadCheckpoint(query.GetTopicID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load topic checkpoint")
+		}
+
+		if checkpoint != nil {
+			pb.ConsensusStartTime = _TimeToProtobuf(checkpoint.ResumeTimestamp)
+			assembler.skipSequenceNumber = checkpoint.SequenceNumber
+			for _, txID := range checkpoint.PendingTransactionIDs {
+				assembler.resumed[txID] = true
+			}
+			if verifier != nil && checkpoint.RunningHash != nil {
+				verifier = _NewTopicRunningHashVerifier(query.GetTopicID(), checkpoint.RunningHash, checkpoint.SequenceNumber)
+			}
+			subscription.acknowledged = checkpoint
+		}
+	}
+
+	errorHandler := query.errorHandler
+	subscriptionQuery := *query
+	subscriptionQuery.errorHandler = func(stat status.Status) {
+		subscription.mu.Lock()
+		subscription.err = stat.Err()
+		subscription.mu.Unlock()
+
+		if errorHandler != nil {
+			errorHandler(stat)
+		}
+	}
+
+	onNext := func(message TopicMessage) {
+		if subscription.store != nil {
+			subscription._Delivered(message, assembler)
+		}
+
+		select {
+		case subscription.messages <- message:
+		case <-subscription.closed:
+		}
+	}
+
+	subscription.handle, err = subscriptionQuery._Subscribe(client, pb, assembler, verifier, onNext, func() {
+		close(subscription.messages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			subscription.Close()
+		case <-subscription.closed:
+		}
+	}()
+
+	return subscription, nil
+}
+
+// Messages returns the channel the messages of the topic are delivered on. It is closed when the
+// subscription ends; Err then tells why.
+func (subscription *TopicSubscription) Messages() <-chan TopicMessage {
+	return subscription.messages
+}
+
+// Next waits for the next message of the topic. It returns io.EOF once the subscription completed or was
+// closed, and the error it failed with otherwise.
+func (subscription *TopicSubscription) Next(ctx context.Context) (TopicMessage, error) {
+	select {
+	case message, ok := <-subscription.messages:
+		if !ok {
+			if err := subscription.Err(); err != nil {
+				return TopicMessage{}, err
+			}
+			return TopicMessage{}, io.EOF
+		}
+		return message, nil
+	case <-ctx.Done():
+		return TopicMessage{}, ctx.Err()
+	}
+}
+
+// Ack acknowledges the message and every message delivered before it, saving a checkpoint to the
+// CheckpointStore so that a later subscription resumes right after it.
+func (subscription *TopicSubscription) Ack(message TopicMessage) error {
+	if subscription.store == nil {
+		return nil
+	}
+
+	subscription.mu.Lock()
+	defer subscription.mu.Unlock()
+
+	if subscription.acknowledged != nil && message.SequenceNumber <= subscription.acknowledged.SequenceNumber {
+		return nil
+	}
+
+	for i, checkpoint := range subscription.delivered {
+		if checkpoint.SequenceNumber != message.SequenceNumber {
+			continue
+		}
+
+		if err := subscription.store.SaveCheckpoint(subscription.topicID, checkpoint); err != nil {
+			return errors.Wrap(err, "failed to save topic checkpoint")
+		}
+
+		subscription.acknowledged = &checkpoint
+		subscription.delivered = subscription.delivered[i+1:]
+
+		return nil
+	}
+
+	return errors.Errorf("message %d was not delivered by this subscription", message.SequenceNumber)
+}
+
+// Err returns the error the subscription failed with, nil while it is running or if it completed.
+func (subscription *TopicSubscription) Err() error {
+	subscription.mu.Lock()
+	defer subscription.mu.Unlock()
+
+	return subscription.err
+}
+
+// Close stops the subscription. Messages that were delivered but not acknowledged are delivered again by
+// the next subscription using the same CheckpointStore.
+func (subscription *TopicSubscription) Close() {
+	subscription.once.Do(func() {
+		close(subscription.closed)
+		subscription.handle.Unsubscribe()
+	})
+}
+
+// _Delivered records the checkpoint to save when the message is acknowledged.
+func (subscription *TopicSubscription) _Delivered(message TopicMessage, assembler *TopicMessageAssembler) {
+	pending, earliest := assembler._Pending()
+
+	checkpoint := TopicCheckpoint{
+		ConsensusTimestamp:    message.ConsensusTimestamp,
+		SequenceNumber:        message.SequenceNumber,
+		RunningHash:           message.RunningHash,
+		ResumeTimestamp:       message.ConsensusTimestamp.Add(1 * time.Nanosecond),
+		PendingTransactionIDs: pending,
+	}
+	if earliest != nil && earliest.Before(checkpoint.ResumeTimestamp) {
+		checkpoint.ResumeTimestamp = *earliest
+	}
+
+	subscription.mu.Lock()
+	subscription.delivered = append(subscription.delivered, checkpoint)
+	subscription.mu.Unlock()
+}
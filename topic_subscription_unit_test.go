@@ -0,0 +1,189 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/mirror"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewTopicSubscriptionStream() []*mirror.ConsensusTopicResponse {
+	chain := &_TopicRunningHashChain{topicID: TopicID{Topic: 1001}, runningHash: make([]byte, 48), timestamp: time.Unix(1672531200, 0)}
+	payer := AccountID{Account: 1800}
+
+	first := chain._Next(payer, []byte("first"), nil)
+	firstChunk := chain._Next(payer, []byte("chunk 1 "), &services.ConsensusMessageChunkInfo{Total: 2, Number: 1})
+	second := chain._Next(payer, []byte("second"), nil)
+	secondChunk := chain._Next(payer, []byte("chunk 2"), &services.ConsensusMessageChunkInfo{Total: 2, Number: 2})
+	secondChunk.ChunkInfo.InitialTransactionID = firstChunk.ChunkInfo.InitialTransactionID
+	third := chain._Next(payer, []byte("third"), nil)
+
+	return []*mirror.ConsensusTopicResponse{first, firstChunk, second, secondChunk, third}
+}
+
+// _NewTopicSubscriptionServer serves the responses the mirror node would return for a subscription starting at the given time.
+func _NewTopicSubscriptionServer(stream []*mirror.ConsensusTopicResponse, start time.Time) (*Client, *MockServers) {
+	responses := make([]interface{}, 0, len(stream))
+	for _, resp := range stream {
+		if !_TimeFromProtobuf(resp.ConsensusTimestamp).Before(start) {
+			responses = append(responses, resp)
+		}
+	}
+
+	return NewMockClientAndServer([][]interface{}{responses})
+}
+
+func _ReadTopicSubscription(t *testing.T, subscription *TopicSubscription, count int) []TopicMessage {
+	messages := make([]TopicMessage, 0, count)
+	for len(messages) < count {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		message, err := subscription.Next(ctx)
+		cancel()
+		require.NoError(t, err)
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+func TestUnitTopicSubscriptionResumesAfterAcknowledged(t *testing.T) {
+	stream := _NewTopicSubscriptionStream()
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+	topicID := TopicID{Topic: 1001}
+
+	client, server := _NewTopicSubscriptionServer(stream, time.Time{})
+	subscription, err := NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetCheckpointStore(store).
+		SubscribeChannel(context.Background(), client)
+	require.NoError(t, err)
+
+	messages := _ReadTopicSubscription(t, subscription, 2)
+	require.Equal(t, "first", string(messages[0].Contents))
+	require.Equal(t, "second", string(messages[1].Contents))
+	require.NoError(t, subscription.Ack(messages[0]))
+	require.NoError(t, subscription.Ack(messages[1]))
+	require.NoError(t, subscription.Ack(messages[0]))
+	subscription.Close()
+	server.Close()
+
+	// The chunked message was still incomplete, so the subscription resumes from its first chunk
+	checkpoint, err := store.LoadCheckpoint(topicID)
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	require.Equal(t, stream[2].SequenceNumber, checkpoint.SequenceNumber)
+	require.Equal(t, stream[2].RunningHash, checkpoint.RunningHash)
+	require.True(t, _TimeFromProtobuf(stream[1].ConsensusTimestamp).Equal(checkpoint.ResumeTimestamp))
+	require.Len(t, checkpoint.PendingTransactionIDs, 1)
+
+	client, server = _NewTopicSubscriptionServer(stream, checkpoint.ResumeTimestamp)
+	subscription, err = NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetCheckpointStore(NewFileCheckpointStore(store.GetPath())).
+		SubscribeChannel(context.Background(), client)
+	require.NoError(t, err)
+
+	messages = _ReadTopicSubscription(t, subscription, 2)
+	require.Equal(t, "chunk 1 chunk 2", string(messages[0].Contents))
+	require.Equal(t, "third", string(messages[1].Contents))
+	require.NoError(t, subscription.Ack(messages[0]))
+
+	_, err = subscription.Next(context.Background())
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, subscription.Err())
+	server.Close()
+
+	checkpoint, err = store.LoadCheckpoint(topicID)
+	require.NoError(t, err)
+	require.Equal(t, stream[3].SequenceNumber, checkpoint.SequenceNumber)
+	require.True(t, _TimeFromProtobuf(stream[3].ConsensusTimestamp).Add(time.Nanosecond).Equal(checkpoint.ResumeTimestamp))
+	require.Empty(t, checkpoint.PendingTransactionIDs)
+
+	client, server = _NewTopicSubscriptionServer(stream, checkpoint.ResumeTimestamp)
+	defer server.Close()
+	subscription, err = NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetCheckpointStore(store).
+		SubscribeChannel(context.Background(), client)
+	require.NoError(t, err)
+
+	messages = _ReadTopicSubscription(t, subscription, 1)
+	require.Equal(t, "third", string(messages[0].Contents))
+	require.NoError(t, subscription.Ack(messages[0]))
+}
+
+func TestUnitTopicSubscriptionBackpressure(t *testing.T) {
+	stream := _NewTopicSubscriptionStream()
+
+	client, server := _NewTopicSubscriptionServer(stream, time.Time{})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	subscription, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 1001}).
+		SetBufferSize(0).
+		SubscribeChannel(ctx, client)
+	require.NoError(t, err)
+
+	// Nothing is buffered, so the subscription waits for the consumer
+	message := <-subscription.Messages()
+	require.Equal(t, "first", string(message.Contents))
+	time.Sleep(50 * time.Millisecond)
+	require.Len(t, subscription.Messages(), 0)
+
+	// Acknowledging without a store is a no-op
+	require.NoError(t, subscription.Ack(message))
+
+	cancel()
+	for range subscription.Messages() {
+	}
+	require.NoError(t, subscription.Err())
+}
+
+func TestUnitFileCheckpointStore(t *testing.T) {
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+
+	checkpoint, err := store.LoadCheckpoint(TopicID{Topic: 1})
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	timestamp := time.Unix(1672531200, 123456789).UTC()
+	require.NoError(t, store.SaveCheckpoint(TopicID{Topic: 1}, TopicCheckpoint{ConsensusTimestamp: timestamp, SequenceNumber: 7, ResumeTimestamp: timestamp}))
+	require.NoError(t, store.SaveCheckpoint(TopicID{Topic: 2}, TopicCheckpoint{SequenceNumber: 9}))
+
+	checkpoint, err = store.LoadCheckpoint(TopicID{Topic: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), checkpoint.SequenceNumber)
+	require.True(t, timestamp.Equal(checkpoint.ConsensusTimestamp))
+
+	checkpoint, err = NewFileCheckpointStore(store.GetPath()).LoadCheckpoint(TopicID{Topic: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), checkpoint.SequenceNumber)
+}
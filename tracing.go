@@ -0,0 +1,388 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Span names used by the SDK
+const (
+	SpanTransactionExecute = "hedera.transaction.execute"
+	SpanQueryExecute       = "hedera.query.execute"
+	SpanReceiptPoll        = "hedera.receipt.poll"
+	SpanTopicSubscribe     = "hedera.topic.subscribe"
+)
+
+// Span event names used by the SDK
+const (
+	EventAttempt       = "attempt"
+	EventBackoff       = "backoff"
+	EventNodeUnhealthy = "node_unhealthy"
+	EventRetry         = "retry"
+)
+
+// Attribute keys used by the SDK
+const (
+	AttributeRequestType   = "hedera.request_type"
+	AttributeNodeAccountID = "hedera.node_account_id"
+	AttributeTransactionID = "hedera.transaction_id"
+	AttributeTopicID       = "hedera.topic_id"
+	AttributeStatus        = "hedera.status"
+	AttributeAttempt       = "hedera.attempt"
+	AttributeRetryCount    = "hedera.retry_count"
+	AttributeBackoffDelay  = "hedera.backoff_delay_ms"
+	AttributeLatency       = "hedera.latency_ms"
+	AttributeError         = "hedera.error"
+)
+
+// Metric names used by the SDK
+const (
+	// Counter of finished executions, with request type and status attributes
+	MetricRequests = "hedera.requests"
+	// Counter of attempts sent to nodes, with request type and node attributes
+	MetricAttempts = "hedera.attempts"
+	// Histogram of execution durations in milliseconds
+	MetricRequestDuration = "hedera.request.duration_ms"
+	// Histogram of backoff delays in milliseconds
+	MetricBackoffDelay = "hedera.backoff.delay_ms"
+	// Counter of messages received by topic subscriptions
+	MetricTopicMessages = "hedera.topic.messages"
+)
+
+// Attribute is a key value pair attached to spans, span events and metrics
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span is a unit of work traced by the SDK. It maps directly onto an OpenTelemetry span.
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	AddEvent(name string, attributes ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Tracer starts spans. The returned context carries the span so nested spans can find their parent.
+type Tracer interface {
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+// Meter records counters and histograms. It maps directly onto OpenTelemetry synchronous instruments.
+type Meter interface {
+	AddCounter(ctx context.Context, name string, value int64, attributes ...Attribute)
+	RecordHistogram(ctx context.Context, name string, value float64, attributes ...Attribute)
+}
+
+type _NoopSpan struct{}
+
+func (_NoopSpan) SetAttributes(...Attribute)    {}
+func (_NoopSpan) AddEvent(string, ...Attribute) {}
+func (_NoopSpan) RecordError(error)             {}
+func (_NoopSpan) End()                          {}
+
+type _NoopTracer struct{}
+
+func (_NoopTracer) Start(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, _NoopSpan{}
+}
+
+type _NoopMeter struct{}
+
+func (_NoopMeter) AddCounter(context.Context, string, int64, ...Attribute)        {}
+func (_NoopMeter) RecordHistogram(context.Context, string, float64, ...Attribute) {}
+
+// SetTracer sets the tracer used for transactions, queries and topic subscriptions
+func (client *Client) SetTracer(tracer Tracer) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
+	client.tracer = tracer
+	return client
+}
+
+// GetTracer returns the tracer set on the client, or nil if none was set
+func (client *Client) GetTracer() Tracer {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	return client.tracer
+}
+
+// SetMeter sets the meter used for transactions, queries and topic subscriptions
+func (client *Client) SetMeter(meter Meter) *Client {
+	client._Mu().Lock()
+	defer client._Mu().Unlock()
+
+	client.meter = meter
+	return client
+}
+
+// GetMeter returns the meter set on the client, or nil if none was set
+func (client *Client) GetMeter() Meter {
+	client._Mu().RLock()
+	defer client._Mu().RUnlock()
+
+	return client.meter
+}
+
+func (client *Client) _GetTracer() Tracer {
+	if client == nil {
+		return _NoopTracer{}
+	}
+
+	if tracer := client.GetTracer(); tracer != nil {
+		return tracer
+	}
+
+	return _NoopTracer{}
+}
+
+func (client *Client) _GetMeter() Meter {
+	if client == nil {
+		return _NoopMeter{}
+	}
+
+	if meter := client.GetMeter(); meter != nil {
+		return meter
+	}
+
+	return _NoopMeter{}
+}
+
+// InMemorySpanEvent is an event recorded on an InMemorySpan
+type InMemorySpanEvent struct {
+	Name       string
+	Time       time.Time
+	Attributes map[string]interface{}
+}
+
+// InMemorySpan is a span recorded by InMemoryTracer
+type InMemorySpan struct {
+	mu         sync.Mutex
+	Name       string
+	Parent     *InMemorySpan
+	StartTime  time.Time
+	EndTime    time.Time
+	Attributes map[string]interface{}
+	Events     []InMemorySpanEvent
+	Err        error
+}
+
+func (span *InMemorySpan) SetAttributes(attributes ...Attribute) {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+
+	for _, attribute := range attributes {
+		span.Attributes[attribute.Key] = attribute.Value
+	}
+}
+
+func (span *InMemorySpan) AddEvent(name string, attributes ...Attribute) {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+
+	span.Events = append(span.Events, InMemorySpanEvent{
+		Name:       name,
+		Time:       time.Now(),
+		Attributes: _AttributesToMap(attributes),
+	})
+}
+
+func (span *InMemorySpan) RecordError(err error) {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+
+	span.Err = err
+}
+
+func (span *InMemorySpan) End() {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+
+	if span.EndTime.IsZero() {
+		span.EndTime = time.Now()
+	}
+}
+
+// IsEnded returns true once End has been called
+func (span *InMemorySpan) IsEnded() bool {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+
+	return !span.EndTime.IsZero()
+}
+
+// GetAttribute returns the value of the attribute with the given key
+func (span *InMemorySpan) GetAttribute(key string) (interface{}, bool) {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+
+	value, ok := span.Attributes[key]
+	return value, ok
+}
+
+// GetEvents returns a copy of the events recorded on the span
+func (span *InMemorySpan) GetEvents() []InMemorySpanEvent {
+	span.mu.Lock()
+	defer span.mu.Unlock()
+
+	return append([]InMemorySpanEvent{}, span.Events...)
+}
+
+type _InMemorySpanKey struct{}
+
+// InMemoryTracer is a Tracer that keeps every span in memory, intended for tests
+type InMemoryTracer struct {
+	mu    sync.Mutex
+	spans []*InMemorySpan
+}
+
+func NewInMemoryTracer() *InMemoryTracer {
+	return &InMemoryTracer{}
+}
+
+func (tracer *InMemoryTracer) Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	parent, _ := ctx.Value(_InMemorySpanKey{}).(*InMemorySpan)
+	span := &InMemorySpan{
+		Name:       name,
+		Parent:     parent,
+		StartTime:  time.Now(),
+		Attributes: _AttributesToMap(attributes),
+	}
+
+	tracer.mu.Lock()
+	tracer.spans = append(tracer.spans, span)
+	tracer.mu.Unlock()
+
+	return context.WithValue(ctx, _InMemorySpanKey{}, span), span
+}
+
+// Spans returns the spans started so far, in start order
+func (tracer *InMemoryTracer) Spans() []*InMemorySpan {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+
+	return append([]*InMemorySpan{}, tracer.spans...)
+}
+
+// SpansNamed returns the spans with the given name, in start order
+func (tracer *InMemoryTracer) SpansNamed(name string) []*InMemorySpan {
+	spans := make([]*InMemorySpan, 0)
+	for _, span := range tracer.Spans() {
+		if span.Name == name {
+			spans = append(spans, span)
+		}
+	}
+
+	return spans
+}
+
+// Reset removes all recorded spans
+func (tracer *InMemoryTracer) Reset() {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+
+	tracer.spans = nil
+}
+
+// InMemoryMetricPoint is a single counter increment or histogram record
+type InMemoryMetricPoint struct {
+	Name       string
+	Value      float64
+	Attributes map[string]interface{}
+}
+
+// InMemoryMeter is a Meter that keeps every recorded value in memory, intended for tests
+type InMemoryMeter struct {
+	mu         sync.Mutex
+	counters   []InMemoryMetricPoint
+	histograms []InMemoryMetricPoint
+}
+
+func NewInMemoryMeter() *InMemoryMeter {
+	return &InMemoryMeter{}
+}
+
+func (meter *InMemoryMeter) AddCounter(_ context.Context, name string, value int64, attributes ...Attribute) {
+	meter.mu.Lock()
+	defer meter.mu.Unlock()
+
+	meter.counters = append(meter.counters, InMemoryMetricPoint{Name: name, Value: float64(value), Attributes: _AttributesToMap(attributes)})
+}
+
+func (meter *InMemoryMeter) RecordHistogram(_ context.Context, name string, value float64, attributes ...Attribute) {
+	meter.mu.Lock()
+	defer meter.mu.Unlock()
+
+	meter.histograms = append(meter.histograms, InMemoryMetricPoint{Name: name, Value: value, Attributes: _AttributesToMap(attributes)})
+}
+
+// Counter returns the sum of all increments of the named counter
+func (meter *InMemoryMeter) Counter(name string) int64 {
+	meter.mu.Lock()
+	defer meter.mu.Unlock()
+
+	var total int64
+	for _, point := range meter.counters {
+		if point.Name == name {
+			total += int64(point.Value)
+		}
+	}
+
+	return total
+}
+
+// Histogram returns every value recorded for the named histogram
+func (meter *InMemoryMeter) Histogram(name string) []float64 {
+	meter.mu.Lock()
+	defer meter.mu.Unlock()
+
+	values := make([]float64, 0)
+	for _, point := range meter.histograms {
+		if point.Name == name {
+			values = append(values, point.Value)
+		}
+	}
+
+	return values
+}
+
+// Points returns every counter increment and histogram record, counters first
+func (meter *InMemoryMeter) Points() []InMemoryMetricPoint {
+	meter.mu.Lock()
+	defer meter.mu.Unlock()
+
+	points := append([]InMemoryMetricPoint{}, meter.counters...)
+	return append(points, meter.histograms...)
+}
+
+func _AttributesToMap(attributes []Attribute) map[string]interface{} {
+	result := make(map[string]interface{}, len(attributes))
+	for _, attribute := range attributes {
+		result[attribute.Key] = attribute.Value
+	}
+
+	return result
+}
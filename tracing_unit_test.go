@@ -0,0 +1,141 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTracingTransactionAndReceipt(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+					Receipt: &services.TransactionReceipt{
+						Status: services.ResponseCodeEnum_SUCCESS,
+					},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	tracer := NewInMemoryTracer()
+	meter := NewInMemoryMeter()
+	client.SetTracer(tracer).SetMeter(meter)
+	require.Equal(t, tracer, client.GetTracer())
+	require.Equal(t, meter, client.GetMeter())
+
+	ctx, parent := tracer.Start(context.Background(), "test")
+
+	resp, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		ExecuteWithContext(ctx, client)
+	require.NoError(t, err)
+
+	_, err = resp.GetReceiptWithContext(ctx, client)
+	require.NoError(t, err)
+	parent.End()
+
+	spans := tracer.SpansNamed(SpanTransactionExecute)
+	require.Len(t, spans, 1)
+	span := spans[0]
+	require.True(t, span.IsEnded())
+	require.Equal(t, parent, span.Parent)
+	require.NoError(t, span.Err)
+
+	requestType, _ := span.GetAttribute(AttributeRequestType)
+	require.Equal(t, "TransferTransaction", requestType)
+	status, _ := span.GetAttribute(AttributeStatus)
+	require.Equal(t, StatusOk.String(), status)
+	retries, _ := span.GetAttribute(AttributeRetryCount)
+	require.Equal(t, int64(1), retries)
+	node, _ := span.GetAttribute(AttributeNodeAccountID)
+	require.Equal(t, "0.0.3", node)
+	transactionID, _ := span.GetAttribute(AttributeTransactionID)
+	require.Equal(t, resp.TransactionID.String(), transactionID)
+
+	events := span.GetEvents()
+	require.Len(t, events, 3)
+	require.Equal(t, EventAttempt, events[0].Name)
+	require.Contains(t, events[0].Attributes[AttributeError], StatusBusy.String())
+	require.Equal(t, EventBackoff, events[1].Name)
+	require.Equal(t, EventAttempt, events[2].Name)
+
+	receipts := tracer.SpansNamed(SpanReceiptPoll)
+	require.Len(t, receipts, 1)
+	status, _ = receipts[0].GetAttribute(AttributeStatus)
+	require.Equal(t, StatusSuccess.String(), status)
+	transactionID, _ = receipts[0].GetAttribute(AttributeTransactionID)
+	require.Equal(t, resp.TransactionID.String(), transactionID)
+
+	require.Equal(t, int64(2), meter.Counter(MetricRequests))
+	require.Equal(t, int64(3), meter.Counter(MetricAttempts))
+	require.Len(t, meter.Histogram(MetricRequestDuration), 2)
+	require.Len(t, meter.Histogram(MetricBackoffDelay), 1)
+}
+
+func TestUnitTracingPrecheckError(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	tracer := NewInMemoryTracer()
+	client.SetTracer(tracer)
+
+	_, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	require.Error(t, err)
+
+	spans := tracer.SpansNamed(SpanTransactionExecute)
+	require.Len(t, spans, 1)
+	require.Equal(t, err, spans[0].Err)
+	status, _ := spans[0].GetAttribute(AttributeStatus)
+	require.Equal(t, StatusInsufficientPayerBalance.String(), status)
+	retries, _ := spans[0].GetAttribute(AttributeRetryCount)
+	require.Equal(t, int64(0), retries)
+}
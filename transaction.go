@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha512"
 	"fmt"
 	"reflect"
@@ -56,7 +57,7 @@ type Transaction struct {
 	nodeAccountIDs     *_LockableSlice
 
 	publicKeys         []PublicKey
-	transactionSigners []TransactionSigner
+	transactionSigners []Signer
 
 	freezeError error
 
@@ -113,7 +114,7 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 		signedTransactions:      _NewLockableSlice(),
 		nodeAccountIDs:          _NewLockableSlice(),
 		publicKeys:              make([]PublicKey, 0),
-		transactionSigners:      make([]TransactionSigner, 0),
+		transactionSigners:      make([]Signer, 0),
 		freezeError:             nil,
 		regenerateTransactionID: true,
 		minBackoff:              &minBackoff,
@@ -195,6 +196,7 @@ func TransactionFromBytes(data []byte) (interface{}, error) { // nolint
 			tx.transactionIDs = tx.transactionIDs._Push(transactionID)
 		}
 
+		found = false
 		for _, id := range tx.GetNodeAccountIDs() {
 			if id._Equals(nodeAccountID) {
 				found = true
@@ -384,6 +386,8 @@ func (this *Transaction) GetSignatures() (map[AccountID]map[*PublicKey][]byte, e
 				inner[&key] = sigPair.GetRSA_3072()
 			case *services.SignaturePair_ECDSA_384:
 				inner[&key] = sigPair.GetECDSA_384()
+			case *services.SignaturePair_ECDSASecp256K1:
+				inner[&key] = sigPair.GetECDSASecp256K1()
 			}
 		}
 
@@ -395,7 +399,12 @@ func (this *Transaction) GetSignatures() (map[AccountID]map[*PublicKey][]byte, e
 }
 
 func (this *Transaction) GetTransactionHash() ([]byte, error) {
-	current, err := this._BuildTransaction(0)
+	return this.GetTransactionHashWithContext(context.Background())
+}
+
+// GetTransactionHashWithContext is GetTransactionHash with a context passed to the signers of the transaction.
+func (this *Transaction) GetTransactionHashWithContext(ctx context.Context) ([]byte, error) {
+	current, err := this._BuildTransaction(ctx, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -409,12 +418,18 @@ func (this *Transaction) GetTransactionHash() ([]byte, error) {
 }
 
 func (this *Transaction) GetTransactionHashPerNode() (map[AccountID][]byte, error) {
+	return this.GetTransactionHashPerNodeWithContext(context.Background())
+}
+
+// GetTransactionHashPerNodeWithContext is GetTransactionHashPerNode with a context passed to the signers of the
+// transaction.
+func (this *Transaction) GetTransactionHashPerNodeWithContext(ctx context.Context) (map[AccountID][]byte, error) {
 	transactionHash := make(map[AccountID][]byte)
 	if !this._IsFrozen() {
 		return transactionHash, errTransactionIsNotFrozen
 	}
 
-	allTx, err := this._BuildAllTransactions()
+	allTx, err := this._BuildAllTransactions(ctx)
 	if err != nil {
 		return transactionHash, err
 	}
@@ -464,9 +479,9 @@ func (this *Transaction) _InitFee(client *Client) {
 func (this *Transaction) _InitTransactionID(client *Client) error {
 	if this.transactionIDs._Length() == 0 {
 		if client != nil {
-			if client.operator != nil {
+			if client._GetOperator() != nil {
 				this.transactionIDs = _NewLockableSlice()
-				this.transactionIDs = this.transactionIDs._Push(TransactionIDGenerate(client.operator.accountID))
+				this.transactionIDs = this.transactionIDs._Push(TransactionIDGenerate(client._GetOperator().accountID))
 			} else {
 				return errNoClientOrTransactionID
 			}
@@ -479,6 +494,12 @@ func (this *Transaction) _InitTransactionID(client *Client) error {
 	return nil
 }
 
+// _GetTransaction returns the embedded Transaction, letting code that only holds one of the concrete
+// transaction types as an interface{} reach the shared state.
+func (this *Transaction) _GetTransaction() *Transaction {
+	return this
+}
+
 func (this *Transaction) _IsFrozen() bool {
 	return this.signedTransactions._Length() > 0
 }
@@ -495,6 +516,29 @@ func (this *Transaction) _RequireOneNodeAccountID() {
 	}
 }
 
+// _SelectNodesForExecute picks the nodes of a transaction which is not frozen and has no nodes set
+// before it is frozen for execution, waiting for a node to be readmitted while none is healthy.
+func (this *Transaction) _SelectNodesForExecute(ctx context.Context, client *Client) error {
+	if client == nil || this._IsFrozen() || !this.nodeAccountIDs._IsEmpty() {
+		return nil
+	}
+
+	nodeAccountIDs, err := client.network._GetNodeAccountIDsForExecuteWithContext(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ErrContextDone{Err: ctx.Err()}
+		}
+
+		return err
+	}
+
+	for _, nodeAccountID := range nodeAccountIDs {
+		this.nodeAccountIDs._Push(nodeAccountID)
+	}
+
+	return nil
+}
+
 func _TransactionFreezeWith(
 	transaction *Transaction,
 	client *Client,
@@ -511,8 +555,8 @@ func _TransactionFreezeWith(
 	}
 
 	if client != nil {
-		if client.defaultRegenerateTransactionIDs != transaction.regenerateTransactionID {
-			transaction.regenerateTransactionID = client.defaultRegenerateTransactionIDs
+		if client.GetDefaultRegenerateTransactionIDs() != transaction.regenerateTransactionID {
+			transaction.regenerateTransactionID = client.GetDefaultRegenerateTransactionIDs()
 		}
 	}
 
@@ -541,6 +585,14 @@ func (this *Transaction) _SignWith(
 ) {
 	this.transactions = _NewLockableSlice()
 	this.publicKeys = append(this.publicKeys, publicKey)
+	this.transactionSigners = append(this.transactionSigners, _NewTransactionSignerAdapter(publicKey, signer))
+}
+
+func (this *Transaction) _SignWithSigner(
+	signer Signer,
+) {
+	this.transactions = _NewLockableSlice()
+	this.publicKeys = append(this.publicKeys, signer.GetPublicKey())
 	this.transactionSigners = append(this.transactionSigners, signer)
 }
 
@@ -556,9 +608,9 @@ func (this *Transaction) _KeyAlreadySigned(
 	return false
 }
 
-func _TransactionShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
+func _TransactionShouldRetry(logger Logger, _ interface{}, response interface{}) _ExecutionState {
 	status := Status(response.(*services.TransactionResponse).NodeTransactionPrecheckCode)
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("transaction precheck status received")
+	logger.Trace("transaction precheck status received", "status", status.String())
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusPlatformNotActive, StatusBusy:
 		return executionStateRetry
@@ -571,12 +623,12 @@ func _TransactionShouldRetry(logID string, _ interface{}, response interface{})
 	return executionStateError
 }
 
-func _TransactionMakeRequest(request interface{}) interface{} {
+func _TransactionMakeRequest(request interface{}) (interface{}, error) {
 	transaction := request.(*Transaction)
 	index := transaction.nodeAccountIDs._Length()*transaction.transactionIDs.index + transaction.nodeAccountIDs.index
-	tx, _ := transaction._BuildTransaction(index)
 
-	return tx
+	// the executor signs every transaction with its context before this, so no signer is invoked here
+	return transaction._BuildTransaction(context.Background(), index)
 }
 
 func _TransactionAdvanceRequest(request interface{}) {
@@ -625,11 +677,17 @@ func (this *Transaction) String() string {
 // ToBytes Builds then converts the current transaction to []byte
 // Requires Transaction to be frozen
 func (this *Transaction) ToBytes() ([]byte, error) {
+	return this.ToBytesWithContext(context.Background())
+}
+
+// ToBytesWithContext is ToBytes with a context passed to the signers of the transaction, which bounds
+// signing with a remote Signer.
+func (this *Transaction) ToBytesWithContext(ctx context.Context) ([]byte, error) {
 	if !this._IsFrozen() {
 		return make([]byte, 0), errTransactionIsNotFrozen
 	}
 
-	allTx, err := this._BuildAllTransactions()
+	allTx, err := this._BuildAllTransactions(ctx)
 	if err != nil {
 		return make([]byte, 0), err
 	}
@@ -646,66 +704,83 @@ func (this *Transaction) ToBytes() ([]byte, error) {
 	return pbTransactionList, nil
 }
 
-func (this *Transaction) _SignTransaction(index int) {
-	initialTx := this.signedTransactions._Get(index).(*services.SignedTransaction)
-	bodyBytes := initialTx.GetBodyBytes()
-	if len(initialTx.SigMap.SigPair) != 0 {
-		for i, key := range this.publicKeys {
-			if this.transactionSigners[i] != nil {
-				if key.ed25519PublicKey != nil {
-					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ed25519PublicKey.keyData) {
-						if !this.regenerateTransactionID {
-							return
-						}
-						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
-						case *services.SignaturePair_Ed25519:
-							if bytes.Equal(t.Ed25519, this.transactionSigners[0](bodyBytes)) && len(t.Ed25519) > 0 {
-								return
-							}
-						}
-					}
-				}
-				if key.ecdsaPublicKey != nil {
-					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ecdsaPublicKey._BytesRaw()) {
-						if !this.regenerateTransactionID {
-							return
-						}
-						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
-						case *services.SignaturePair_ECDSASecp256K1:
-							if bytes.Equal(t.ECDSASecp256K1, this.transactionSigners[0](bodyBytes)) && len(t.ECDSASecp256K1) > 0 {
-								return
-							}
-						}
-					}
-				}
+// _SignTransactions adds the signatures that are still missing from the signed transactions at the given
+// indexes. Every signer is called once with all the body bytes it has yet to sign.
+func (this *Transaction) _SignTransactions(ctx context.Context, indexes []int) error {
+	for i, signer := range this.transactionSigners {
+		if signer == nil {
+			continue
+		}
+
+		publicKey := this.publicKeys[i]
+		pending := make([]*services.SignedTransaction, 0, len(indexes))
+		bodyBytes := make([][]byte, 0, len(indexes))
+		for _, index := range indexes {
+			signedTx := this.signedTransactions._Get(index).(*services.SignedTransaction)
+			if _SignatureMapContainsKey(signedTx.SigMap, publicKey) {
+				continue
 			}
+
+			pending = append(pending, signedTx)
+			bodyBytes = append(bodyBytes, signedTx.BodyBytes)
+		}
+
+		signatures, err := _SignerSignAll(ctx, signer, bodyBytes)
+		if err != nil {
+			return errors.Wrap(err, "failed to sign transaction")
+		}
+
+		for j, signedTx := range pending {
+			if signedTx.SigMap == nil {
+				signedTx.SigMap = &services.SignatureMap{}
+			}
+			signedTx.SigMap.SigPair = append(signedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signatures[j]))
 		}
 	}
 
-	if this.regenerateTransactionID && !this.transactionIDs.locked {
-		modifiedTx := this.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = make([]*services.SignaturePair, 0)
-		this.signedTransactions._Set(index, modifiedTx)
+	return nil
+}
+
+// _SignAll brings the body of every node and chunk specific transaction up to date and signs all of them,
+// so that signers supporting batches are called once for the whole transaction.
+func (this *Transaction) _SignAll(ctx context.Context) error {
+	nodeCount := this.nodeAccountIDs._Length()
+	if nodeCount == 0 || this.transactionIDs._Length() == 0 {
+		return nil
 	}
 
-	for i := 0; i < len(this.publicKeys); i++ {
-		publicKey := this.publicKeys[i]
-		signer := this.transactionSigners[i]
+	indexes := make([]int, 0, this.signedTransactions._Length())
+	for index := 0; index < this.signedTransactions._Length(); index++ {
+		txID := this.transactionIDs._GetCurrent().(TransactionID)
+		if chunk := index / nodeCount; chunk < this.transactionIDs._Length() {
+			txID = this.transactionIDs._Get(chunk).(TransactionID)
+		}
 
-		if signer == nil {
-			continue
+		err := this._UpdateBodyBytes(index, txID, this.nodeAccountIDs._Get(index%nodeCount).(AccountID))
+		if err != nil {
+			return err
 		}
+		indexes = append(indexes, index)
+	}
+
+	return this._SignTransactions(ctx, indexes)
+}
 
-		modifiedTx := this.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = append(modifiedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signer(bodyBytes)))
-		this.signedTransactions._Set(index, modifiedTx)
+func _SignatureMapContainsKey(sigMap *services.SignatureMap, publicKey PublicKey) bool {
+	keyBytes := publicKey.BytesRaw()
+	for _, sigPair := range sigMap.GetSigPair() {
+		if bytes.Equal(sigPair.PubKeyPrefix, keyBytes) {
+			return true
+		}
 	}
+
+	return false
 }
 
-func (this *Transaction) _BuildAllTransactions() ([]*services.Transaction, error) {
+func (this *Transaction) _BuildAllTransactions(ctx context.Context) ([]*services.Transaction, error) {
 	allTx := make([]*services.Transaction, 0)
 	for i := 0; i < this.signedTransactions._Length(); i++ {
-		tx, err := this._BuildTransaction(i)
+		tx, err := this._BuildTransaction(ctx, i)
 		this.transactionIDs._Advance()
 		if err != nil {
 			return []*services.Transaction{}, err
@@ -716,15 +791,16 @@ func (this *Transaction) _BuildAllTransactions() ([]*services.Transaction, error
 	return allTx, nil
 }
 
-func (this *Transaction) _BuildTransaction(index int) (*services.Transaction, error) {
+// _UpdateBodyBytes updates the body of the signed transaction at the given index with the transaction ID,
+// memo and fee currently set. Signatures over a body which changed are no longer valid and are dropped.
+func (this *Transaction) _UpdateBodyBytes(index int, txID TransactionID, nodeAccountID AccountID) error {
 	signedTx := this.signedTransactions._Get(index).(*services.SignedTransaction)
 
-	txID := this.transactionIDs._GetCurrent().(TransactionID)
 	originalBody := services.TransactionBody{}
 	_ = protobuf.Unmarshal(signedTx.BodyBytes, &originalBody)
 
 	if originalBody.NodeAccountID == nil {
-		originalBody.NodeAccountID = this.nodeAccountIDs._GetCurrent().(AccountID)._ToProtobuf()
+		originalBody.NodeAccountID = nodeAccountID._ToProtobuf()
 	}
 
 	if originalBody.TransactionID.String() != txID._ToProtobuf().String() {
@@ -740,29 +816,33 @@ func (this *Transaction) _BuildTransaction(index int) (*services.Transaction, er
 
 	updatedBody, err := protobuf.Marshal(&originalBody)
 	if err != nil {
-		return &services.Transaction{}, errors.Wrap(err, "failed to update this ID")
+		return errors.Wrap(err, "failed to update this ID")
 	}
 
-	// Bellow are checks whether we need to sign the transaction or we already have the same signed
-	if bytes.Equal(signedTx.BodyBytes, updatedBody) {
-		sigPairLen := len(signedTx.SigMap.GetSigPair())
-		// For cases where we need more than 1 signature
-		if sigPairLen > 0 && sigPairLen == len(this.publicKeys) {
-			data, err := protobuf.Marshal(signedTx)
-			if err != nil {
-				return &services.Transaction{}, errors.Wrap(err, "failed to serialize transactions for building")
-			}
-			transaction := &services.Transaction{
-				SignedTransactionBytes: data,
-			}
-
-			return transaction, nil
+	if !bytes.Equal(signedTx.BodyBytes, updatedBody) {
+		signedTx.BodyBytes = updatedBody
+		signedTx.SigMap = &services.SignatureMap{
+			SigPair: make([]*services.SignaturePair, 0),
 		}
+		this.signedTransactions._Set(index, signedTx)
 	}
 
-	signedTx.BodyBytes = updatedBody
-	this.signedTransactions._Set(index, signedTx)
-	this._SignTransaction(index)
+	return nil
+}
+
+func (this *Transaction) _BuildTransaction(ctx context.Context, index int) (*services.Transaction, error) {
+	err := this._UpdateBodyBytes(
+		index,
+		this.transactionIDs._GetCurrent().(TransactionID),
+		this.nodeAccountIDs._GetCurrent().(AccountID),
+	)
+	if err != nil {
+		return &services.Transaction{}, err
+	}
+
+	if err = this._SignTransactions(ctx, []int{index}); err != nil {
+		return &services.Transaction{}, err
+	}
 
 	tx := this.signedTransactions._Get(index).(*services.SignedTransaction)
 	data, err := protobuf.Marshal(tx)
@@ -4339,163 +4419,167 @@ func TransactionToBytes(transaction interface{}) ([]byte, error) { // nolint
 }
 
 func TransactionExecute(transaction interface{}, client *Client) (TransactionResponse, error) { // nolint
+	return TransactionExecuteWithContext(context.Background(), transaction, client)
+}
+
+func TransactionExecuteWithContext(ctx context.Context, transaction interface{}, client *Client) (TransactionResponse, error) { // nolint
 	switch i := transaction.(type) {
 	case AccountCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case AccountDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case AccountUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case ContractCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case ContractDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case ContractExecuteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case ContractUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case FileAppendTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case FileCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case FileDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case FileUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case FreezeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case LiveHashAddTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case LiveHashDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case ScheduleCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case ScheduleDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case ScheduleSignTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case SystemDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case SystemUndeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenAssociateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenBurnTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenDissociateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenFeeScheduleUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenFreezeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenGrantKycTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenMintTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenPauseTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenRevokeKycTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenUnfreezeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenUnpauseTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TokenWipeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TopicCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TopicDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TopicMessageSubmitTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TopicUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case TransferTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *AccountCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *AccountDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *AccountUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *ContractCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *ContractDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *ContractExecuteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *ContractUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *FileAppendTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *FileCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *FileDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *FileUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *FreezeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *LiveHashAddTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *LiveHashDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *ScheduleCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *ScheduleDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *ScheduleSignTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *SystemDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *SystemUndeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenAssociateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenBurnTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenDissociateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenFeeScheduleUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenFreezeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenGrantKycTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenMintTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenPauseTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenRevokeKycTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenUnfreezeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenUnpauseTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TokenWipeTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TopicCreateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TopicDeleteTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TopicMessageSubmitTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TopicUpdateTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	case *TransferTransaction:
-		return i.Execute(client)
+		return i.ExecuteWithContext(ctx, client)
 	default:
 		return TransactionResponse{}, errors.New("(BUG) non-exhaustive switch statement")
 	}
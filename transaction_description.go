@@ -0,0 +1,690 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/sdk"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const _TransactionJSONVersion = 1
+
+// TransactionDescription is a summary of what a transaction does, meant for people reviewing a transaction before
+// signing it. Entity IDs, amounts, keys and times are rendered as text; the fields specific to the type of the
+// transaction which have no dedicated summary are listed in Details, keyed by their protobuf JSON names.
+type TransactionDescription struct {
+	// Type is the request type of the transaction, such as CRYPTO_TRANSFER.
+	Type              string   `json:"type"`
+	TransactionID     string   `json:"transactionId,omitempty"`
+	Payer             string   `json:"payer,omitempty"`
+	NodeAccountIDs    []string `json:"nodeAccountIds,omitempty"`
+	MaxTransactionFee string   `json:"maxTransactionFee"`
+	ValidDuration     string   `json:"validDuration,omitempty"`
+	Memo              string   `json:"memo,omitempty"`
+	// Chunks is the number of chunks of a transaction split across several transaction IDs, such as a large file
+	// append. The contents of all the chunks are joined in Details.
+	Chunks       int                      `json:"chunks,omitempty"`
+	Transfers    []TransferDescription    `json:"transfers,omitempty"`
+	NftTransfers []NftTransferDescription `json:"nftTransfers,omitempty"`
+	// Keys lists every key the transaction sets, such as the admin key of a token being updated.
+	Keys    []KeyDescription       `json:"keys,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+	// ScheduledTransaction describes the transaction a schedule create transaction schedules.
+	ScheduledTransaction *TransactionDescription `json:"scheduledTransaction,omitempty"`
+}
+
+// TransferDescription is a single hbar or fungible token transfer. Token amounts are only shown with their decimals
+// when the transaction states the decimals it expects.
+type TransferDescription struct {
+	AccountID string `json:"accountId"`
+	// TokenID is empty for hbar transfers.
+	TokenID  string `json:"tokenId,omitempty"`
+	Amount   string `json:"amount"`
+	Approved bool   `json:"approved,omitempty"`
+}
+
+type NftTransferDescription struct {
+	TokenID      string `json:"tokenId"`
+	SerialNumber int64  `json:"serialNumber"`
+	Sender       string `json:"sender"`
+	Receiver     string `json:"receiver"`
+	Approved     bool   `json:"approved,omitempty"`
+}
+
+// KeyDescription is a key set by a transaction, along with the path of the field setting it.
+type KeyDescription struct {
+	Field string `json:"field"`
+	Key   string `json:"key"`
+}
+
+type _TransactionJSON struct {
+	Version            int                      `json:"version"`
+	Description        json.RawMessage          `json:"description"`
+	SignedTransactions []_SignedTransactionJSON `json:"signedTransactions"`
+}
+
+type _SignedTransactionJSON struct {
+	BodyBytes  string               `json:"bodyBytes"`
+	Signatures []_SignaturePairJSON `json:"signatures"`
+}
+
+type _SignaturePairJSON struct {
+	PublicKey string `json:"publicKey"`
+	Type      string `json:"type"`
+	Signature string `json:"signature"`
+}
+
+// DescribeTransaction summarizes a frozen transaction, such as one returned by TransactionFromBytes.
+func DescribeTransaction(transaction interface{}) (TransactionDescription, error) {
+	tx, ok := _TransactionOf(transaction)
+	if !ok {
+		return TransactionDescription{}, errDescribeUnsupportedTransaction
+	}
+
+	if !tx._IsFrozen() {
+		return TransactionDescription{}, errTransactionIsNotFrozen
+	}
+
+	bodies := make([]*services.TransactionBody, 0, tx.signedTransactions._Length())
+	for _, value := range tx.signedTransactions.slice {
+		var body services.TransactionBody
+		if err := protobuf.Unmarshal(value.(*services.SignedTransaction).BodyBytes, &body); err != nil {
+			return TransactionDescription{}, errors.Wrap(err, "error deserializing BodyBytes in DescribeTransaction")
+		}
+		bodies = append(bodies, &body)
+	}
+
+	return _DescribeTransactionBodies(bodies), nil
+}
+
+// TransactionToJSON encodes a frozen transaction as JSON holding its description, as returned by DescribeTransaction,
+// along with the exact body bytes and signatures of every node and chunk. The encoding is deterministic, and
+// TransactionFromJSON restores a transaction whose ToBytes matches the original's.
+func TransactionToJSON(transaction interface{}) ([]byte, error) {
+	tx, ok := _TransactionOf(transaction)
+	if !ok {
+		return nil, errDescribeUnsupportedTransaction
+	}
+
+	description, err := DescribeTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptionJSON, err := json.Marshal(description)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := tx.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var list sdk.TransactionList
+	if err := protobuf.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	document := _TransactionJSON{
+		Version:            _TransactionJSONVersion,
+		Description:        descriptionJSON,
+		SignedTransactions: make([]_SignedTransactionJSON, 0, len(list.TransactionList)),
+	}
+
+	for _, transaction := range list.TransactionList {
+		var signedTransaction services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.SignedTransactionBytes, &signedTransaction); err != nil {
+			return nil, err
+		}
+
+		signedTransactionJSON := _SignedTransactionJSON{
+			BodyBytes:  base64.StdEncoding.EncodeToString(signedTransaction.BodyBytes),
+			Signatures: make([]_SignaturePairJSON, 0, len(signedTransaction.GetSigMap().GetSigPair())),
+		}
+
+		for _, sigPair := range signedTransaction.GetSigMap().GetSigPair() {
+			signatureType, err := _SignaturePairType(sigPair)
+			if err != nil {
+				return nil, err
+			}
+
+			signedTransactionJSON.Signatures = append(signedTransactionJSON.Signatures, _SignaturePairJSON{
+				PublicKey: hex.EncodeToString(sigPair.PubKeyPrefix),
+				Type:      signatureType,
+				Signature: hex.EncodeToString(_SignaturePairSignature(sigPair)),
+			})
+		}
+
+		document.SignedTransactions = append(document.SignedTransactions, signedTransactionJSON)
+	}
+
+	return json.Marshal(document)
+}
+
+// TransactionFromJSON decodes a transaction encoded by TransactionToJSON, returning it the way TransactionFromBytes
+// does. The description is checked against the body bytes, so a description edited by hand is rejected.
+func TransactionFromJSON(data []byte) (interface{}, error) {
+	var document _TransactionJSON
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, errors.Wrap(err, "failed to parse transaction JSON")
+	}
+
+	if document.Version != _TransactionJSONVersion {
+		return nil, errors.Errorf("unsupported transaction JSON version %d", document.Version)
+	}
+
+	list := sdk.TransactionList{TransactionList: make([]*services.Transaction, 0, len(document.SignedTransactions))}
+	for _, signedTransactionJSON := range document.SignedTransactions {
+		bodyBytes, err := base64.StdEncoding.DecodeString(signedTransactionJSON.BodyBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode body bytes")
+		}
+
+		signedTransaction := services.SignedTransaction{
+			BodyBytes: bodyBytes,
+			SigMap:    &services.SignatureMap{SigPair: make([]*services.SignaturePair, 0, len(signedTransactionJSON.Signatures))},
+		}
+
+		for _, signatureJSON := range signedTransactionJSON.Signatures {
+			sigPair, err := _SignaturePairFromJSON(signatureJSON)
+			if err != nil {
+				return nil, err
+			}
+			signedTransaction.SigMap.SigPair = append(signedTransaction.SigMap.SigPair, sigPair)
+		}
+
+		signedTransactionBytes, err := protobuf.Marshal(&signedTransaction)
+		if err != nil {
+			return nil, err
+		}
+
+		list.TransactionList = append(list.TransactionList, &services.Transaction{SignedTransactionBytes: signedTransactionBytes})
+	}
+
+	listBytes, err := protobuf.Marshal(&list)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := TransactionFromBytes(listBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := DescribeTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptionJSON, err := json.Marshal(description)
+	if err != nil {
+		return nil, err
+	}
+
+	// The description may have been reformatted or had its keys reordered, so the decoded documents are
+	// compared rather than their bytes
+	expected, err := _DecodeJSONValue(document.Description)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse transaction description")
+	}
+
+	actual, err := _DecodeJSONValue(descriptionJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		return nil, errDescriptionMismatch
+	}
+
+	return transaction, nil
+}
+
+// _DecodeJSONValue decodes a JSON document into maps, slices and strings, keeping numbers as json.Number
+// so that no precision is lost when two documents are compared.
+func _DecodeJSONValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// _TransactionOf returns the embedded Transaction of any of the transaction types, whether passed by pointer or, as
+// returned by TransactionFromBytes, by value.
+func _TransactionOf(transaction interface{}) (*Transaction, bool) {
+	if base, ok := transaction.(interface{ _GetTransaction() *Transaction }); ok {
+		return base._GetTransaction(), true
+	}
+
+	value := reflect.ValueOf(transaction)
+	if !value.IsValid() || value.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	pointer := reflect.New(value.Type())
+	pointer.Elem().Set(value)
+	if base, ok := pointer.Interface().(interface{ _GetTransaction() *Transaction }); ok {
+		return base._GetTransaction(), true
+	}
+
+	return nil, false
+}
+
+func _DescribeTransactionBodies(bodies []*services.TransactionBody) TransactionDescription {
+	first := bodies[0]
+	requestType, _ := _RequestTypeFromTransactionBody(first)
+
+	description := TransactionDescription{
+		Type:              requestType.String(),
+		MaxTransactionFee: _DescribeAmount(int64(first.TransactionFee), 8) + " " + HbarUnits.Hbar.Symbol(),
+		Memo:              first.Memo,
+	}
+
+	if first.TransactionID != nil {
+		transactionID := _TransactionIDFromProtobuf(first.TransactionID)
+		description.TransactionID = transactionID.String()
+		if transactionID.AccountID != nil {
+			description.Payer = transactionID.AccountID.String()
+		}
+	}
+
+	if first.TransactionValidDuration != nil {
+		description.ValidDuration = _DurationFromProtobuf(first.TransactionValidDuration).String()
+	}
+
+	// Bodies are ordered by chunk and then by node
+	chunks := make([]*services.TransactionBody, 0, 1)
+	transactionIDs := make(map[string]bool)
+	nodeAccountIDs := make(map[string]bool)
+	for _, body := range bodies {
+		if body.NodeAccountID != nil {
+			nodeAccountID := _AccountIDFromProtobuf(body.NodeAccountID).String()
+			if !nodeAccountIDs[nodeAccountID] {
+				nodeAccountIDs[nodeAccountID] = true
+				description.NodeAccountIDs = append(description.NodeAccountIDs, nodeAccountID)
+			}
+		}
+
+		transactionID := _TransactionIDFromProtobuf(body.TransactionID).String()
+		if !transactionIDs[transactionID] {
+			transactionIDs[transactionID] = true
+			chunks = append(chunks, body)
+		}
+	}
+
+	describer := _TransactionDescriber{}
+
+	switch data := first.Data.(type) {
+	case *services.TransactionBody_CryptoTransfer:
+		description.Transfers, description.NftTransfers = _DescribeTransfers(data.CryptoTransfer)
+		description.Details = describer._Message("", data.CryptoTransfer.ProtoReflect(), "transfers", "tokenTransfers")
+	case *services.TransactionBody_ScheduleCreate:
+		description.Details = describer._Message("", data.ScheduleCreate.ProtoReflect(), "scheduledTransactionBody")
+		if scheduled := data.ScheduleCreate.GetScheduledTransactionBody(); scheduled != nil {
+			scheduledDescription := _DescribeTransactionBodies([]*services.TransactionBody{_TransactionBodyFromSchedulable(scheduled)})
+			description.ScheduledTransaction = &scheduledDescription
+		}
+	default:
+		if message := _TransactionBodyData(first); message != nil {
+			description.Details = describer._Message("", message)
+		}
+	}
+
+	if len(chunks) > 1 {
+		description.Chunks = len(chunks)
+		if description.Details == nil {
+			description.Details = make(map[string]interface{})
+		}
+
+		var joined []byte
+		for _, chunk := range chunks {
+			switch data := chunk.Data.(type) {
+			case *services.TransactionBody_FileAppend:
+				joined = append(joined, data.FileAppend.GetContents()...)
+			case *services.TransactionBody_ConsensusSubmitMessage:
+				joined = append(joined, data.ConsensusSubmitMessage.GetMessage()...)
+			}
+		}
+
+		switch first.Data.(type) {
+		case *services.TransactionBody_FileAppend:
+			description.Details["contents"] = hex.EncodeToString(joined)
+		case *services.TransactionBody_ConsensusSubmitMessage:
+			description.Details["message"] = hex.EncodeToString(joined)
+		}
+	}
+
+	if len(description.Details) == 0 {
+		description.Details = nil
+	}
+
+	sort.Slice(describer.keys, func(i, j int) bool {
+		return describer.keys[i].Field < describer.keys[j].Field
+	})
+	description.Keys = describer.keys
+
+	return description
+}
+
+func _DescribeTransfers(transfer *services.CryptoTransferTransactionBody) ([]TransferDescription, []NftTransferDescription) {
+	var transfers []TransferDescription
+	var nftTransfers []NftTransferDescription
+
+	for _, accountAmount := range transfer.GetTransfers().GetAccountAmounts() {
+		transfers = append(transfers, TransferDescription{
+			AccountID: _AccountIDFromProtobuf(accountAmount.AccountID).String(),
+			Amount:    _DescribeAmount(accountAmount.Amount, 8) + " " + HbarUnits.Hbar.Symbol(),
+			Approved:  accountAmount.IsApproval,
+		})
+	}
+
+	for _, tokenTransfers := range transfer.GetTokenTransfers() {
+		tokenID := _TokenIDFromProtobuf(tokenTransfers.Token).String()
+
+		for _, accountAmount := range tokenTransfers.GetTransfers() {
+			amount := strconv.FormatInt(accountAmount.Amount, 10)
+			if tokenTransfers.ExpectedDecimals != nil {
+				amount = _DescribeAmount(accountAmount.Amount, tokenTransfers.ExpectedDecimals.Value)
+			}
+
+			transfers = append(transfers, TransferDescription{
+				AccountID: _AccountIDFromProtobuf(accountAmount.AccountID).String(),
+				TokenID:   tokenID,
+				Amount:    amount,
+				Approved:  accountAmount.IsApproval,
+			})
+		}
+
+		for _, nftTransfer := range tokenTransfers.GetNftTransfers() {
+			nftTransfers = append(nftTransfers, NftTransferDescription{
+				TokenID:      tokenID,
+				SerialNumber: nftTransfer.SerialNumber,
+				Sender:       _AccountIDFromProtobuf(nftTransfer.SenderAccountID).String(),
+				Receiver:     _AccountIDFromProtobuf(nftTransfer.ReceiverAccountID).String(),
+				Approved:     nftTransfer.IsApproval,
+			})
+		}
+	}
+
+	return transfers, nftTransfers
+}
+
+// _DescribeAmount formats an amount of the smallest unit of a currency with the given number of decimals exactly,
+// without trailing zeros.
+func _DescribeAmount(amount int64, decimals uint32) string {
+	digits := strconv.FormatUint(uint64(amount), 10)
+	sign := ""
+	if amount < 0 {
+		digits = strconv.FormatUint(uint64(-(amount+1))+1, 10)
+		sign = "-"
+	}
+
+	if decimals == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+
+	whole := digits[:len(digits)-int(decimals)]
+	fraction := strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	if fraction == "" {
+		return sign + whole
+	}
+
+	return sign + whole + "." + fraction
+}
+
+// _TransactionBodyData returns the message of the transaction specific part of a body.
+func _TransactionBodyData(body *services.TransactionBody) protoreflect.Message {
+	message := body.ProtoReflect()
+	oneof := message.Descriptor().Oneofs().ByName("data")
+	if oneof == nil {
+		return nil
+	}
+
+	field := message.WhichOneof(oneof)
+	if field == nil || field.Message() == nil {
+		return nil
+	}
+
+	return message.Get(field).Message()
+}
+
+// _TransactionBodyFromSchedulable converts the body of a scheduled transaction to a transaction body. Both hold the
+// transaction specific part in a field of the same name.
+func _TransactionBodyFromSchedulable(scheduled *services.SchedulableTransactionBody) *services.TransactionBody {
+	body := &services.TransactionBody{
+		TransactionFee: scheduled.TransactionFee,
+		Memo:           scheduled.Memo,
+	}
+
+	source := scheduled.ProtoReflect()
+	oneof := source.Descriptor().Oneofs().ByName("data")
+	if oneof == nil {
+		return body
+	}
+
+	if field := source.WhichOneof(oneof); field != nil {
+		target := body.ProtoReflect()
+		if targetField := target.Descriptor().Fields().ByName(field.Name()); targetField != nil && targetField.Message() != nil &&
+			targetField.Message().FullName() == field.Message().FullName() {
+			target.Set(targetField, source.Get(field))
+		}
+	}
+
+	return body
+}
+
+type _TransactionDescriber struct {
+	keys []KeyDescription
+}
+
+func (describer *_TransactionDescriber) _Message(path string, message protoreflect.Message, skip ...string) map[string]interface{} {
+	details := make(map[string]interface{})
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := field.JSONName()
+		for _, skipped := range skip {
+			if name == skipped {
+				return true
+			}
+		}
+
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+
+		if field.IsList() {
+			list := value.List()
+			items := make([]interface{}, 0, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				if item, ok := describer._Value(fmt.Sprintf("%s[%d]", fieldPath, i), field, list.Get(i)); ok {
+					items = append(items, item)
+				}
+			}
+
+			if len(items) > 0 {
+				details[name] = items
+			}
+		} else if !field.IsMap() {
+			if item, ok := describer._Value(fieldPath, field, value); ok {
+				details[name] = item
+			}
+		}
+
+		return true
+	})
+
+	return details
+}
+
+// _Value renders a single value. Integers of 64 bits are rendered as strings, the way protobuf JSON does, so they
+// survive JSON parsers which use floating point numbers.
+func (describer *_TransactionDescriber) _Value(path string, field protoreflect.FieldDescriptor, value protoreflect.Value) (interface{}, bool) { // nolint
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return value.Bool(), true
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name()), true
+		}
+		return int32(value.Enum()), true
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return value.Int(), true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return value.Uint(), true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(value.Int(), 10), true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(value.Uint(), 10), true
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float(), true
+	case protoreflect.StringKind:
+		return value.String(), true
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(value.Bytes()), true
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return describer._MessageValue(path, value.Message())
+	}
+
+	return nil, false
+}
+
+func (describer *_TransactionDescriber) _MessageValue(path string, message protoreflect.Message) (interface{}, bool) {
+	switch pb := message.Interface().(type) {
+	case *services.AccountID:
+		return _AccountIDFromProtobuf(pb).String(), true
+	case *services.ContractID:
+		return _ContractIDFromProtobuf(pb).String(), true
+	case *services.FileID:
+		return _FileIDFromProtobuf(pb).String(), true
+	case *services.TokenID:
+		return _TokenIDFromProtobuf(pb).String(), true
+	case *services.TopicID:
+		return _TopicIDFromProtobuf(pb).String(), true
+	case *services.ScheduleID:
+		return _ScheduleIDFromProtobuf(pb).String(), true
+	case *services.NftID:
+		return _NftIDFromProtobuf(pb).String(), true
+	case *services.TransactionID:
+		return _TransactionIDFromProtobuf(pb).String(), true
+	case *services.Timestamp:
+		return _TimeFromProtobuf(pb).UTC().Format(time.RFC3339Nano), true
+	case *services.TimestampSeconds:
+		return time.Unix(pb.Seconds, 0).UTC().Format(time.RFC3339), true
+	case *services.Duration:
+		return _DurationFromProtobuf(pb).String(), true
+	case *services.Key:
+		describer.keys = append(describer.keys, KeyDescription{Field: path, Key: _DescribeKey(pb)})
+		return nil, false
+	}
+
+	// Well known wrappers, such as google.protobuf.StringValue, hold a single field called value
+	if strings.HasPrefix(string(message.Descriptor().FullName()), "google.protobuf.") {
+		if field := message.Descriptor().Fields().ByName("value"); field != nil {
+			return describer._Value(path, field, message.Get(field))
+		}
+	}
+
+	return describer._Message(path, message), true
+}
+
+func _DescribeKey(pb *services.Key) string {
+	if keyList := pb.GetKeyList(); keyList != nil && len(keyList.Keys) == 0 {
+		return "{[]}"
+	}
+
+	key, err := _KeyFromProtobuf(pb)
+	if err != nil {
+		return "unsupported key: " + err.Error()
+	}
+
+	return key.String()
+}
+
+func _SignaturePairType(sigPair *services.SignaturePair) (string, error) {
+	switch sigPair.Signature.(type) {
+	case *services.SignaturePair_Ed25519:
+		return "ed25519", nil
+	case *services.SignaturePair_ECDSASecp256K1:
+		return "ecdsaSecp256k1", nil
+	case *services.SignaturePair_ECDSA_384:
+		return "ecdsa384", nil
+	case *services.SignaturePair_RSA_3072:
+		return "rsa3072", nil
+	case *services.SignaturePair_Contract:
+		return "contract", nil
+	}
+
+	return "", errors.New("signature pair has no signature")
+}
+
+func _SignaturePairFromJSON(signatureJSON _SignaturePairJSON) (*services.SignaturePair, error) {
+	prefix, err := hex.DecodeString(signatureJSON.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode signature public key")
+	}
+
+	signature, err := hex.DecodeString(signatureJSON.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode signature")
+	}
+
+	sigPair := services.SignaturePair{PubKeyPrefix: prefix}
+	switch signatureJSON.Type {
+	case "ed25519":
+		sigPair.Signature = &services.SignaturePair_Ed25519{Ed25519: signature}
+	case "ecdsaSecp256k1":
+		sigPair.Signature = &services.SignaturePair_ECDSASecp256K1{ECDSASecp256K1: signature}
+	case "ecdsa384":
+		sigPair.Signature = &services.SignaturePair_ECDSA_384{ECDSA_384: signature}
+	case "rsa3072":
+		sigPair.Signature = &services.SignaturePair_RSA_3072{RSA_3072: signature}
+	case "contract":
+		sigPair.Signature = &services.SignaturePair_Contract{Contract: signature}
+	default:
+		return nil, errors.Errorf("unknown signature type %q", signatureJSON.Type)
+	}
+
+	return &sigPair, nil
+}
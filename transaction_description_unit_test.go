@@ -0,0 +1,278 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewUnitDescriptionTransactionID() TransactionID {
+	return NewTransactionIDWithValidStart(AccountID{Account: 1800}, time.Unix(1600000000, 5))
+}
+
+func TestUnitDescribeTransfer(t *testing.T) {
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(_NewUnitDescriptionTransactionID()).
+		SetTransactionMemo("rent").
+		SetMaxTransactionFee(NewHbar(2)).
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-150000000)).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(150000000)).
+		AddTokenTransferWithDecimals(TokenID{Token: 7}, AccountID{Account: 1800}, -1250, 2).
+		AddTokenTransferWithDecimals(TokenID{Token: 7}, AccountID{Account: 2}, 1250, 2).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 8}, SerialNumber: 3}, AccountID{Account: 1800}, AccountID{Account: 2}).
+		Freeze()
+	require.NoError(t, err)
+
+	description, err := DescribeTransaction(transfer)
+	require.NoError(t, err)
+
+	assert.Equal(t, "CRYPTO_TRANSFER", description.Type)
+	assert.Equal(t, "0.0.1800", description.Payer)
+	assert.Equal(t, _NewUnitDescriptionTransactionID().String(), description.TransactionID)
+	assert.Equal(t, []string{"0.0.3", "0.0.4"}, description.NodeAccountIDs)
+	assert.Equal(t, "2 ℏ", description.MaxTransactionFee)
+	assert.Equal(t, "rent", description.Memo)
+	assert.Equal(t, "2m0s", description.ValidDuration)
+	assert.Contains(t, description.Transfers, TransferDescription{AccountID: "0.0.1800", Amount: "-1.5 ℏ"})
+	assert.Contains(t, description.Transfers, TransferDescription{AccountID: "0.0.2", TokenID: "0.0.7", Amount: "12.5"})
+	assert.Equal(t, []NftTransferDescription{{TokenID: "0.0.8", SerialNumber: 3, Sender: "0.0.1800", Receiver: "0.0.2"}}, description.NftTransfers)
+	assert.Nil(t, description.Details)
+
+	// Transactions returned by TransactionFromBytes are described the same way
+	data, err := transfer.ToBytes()
+	require.NoError(t, err)
+	fromBytes, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+	fromBytesDescription, err := DescribeTransaction(fromBytes)
+	require.NoError(t, err)
+	assert.Equal(t, description, fromBytesDescription)
+}
+
+func TestUnitDescribeKeysAndSchedule(t *testing.T) {
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	update, err := NewTokenUpdateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(_NewUnitDescriptionTransactionID()).
+		SetTokenID(TokenID{Token: 7}).
+		SetTokenName("renamed").
+		SetAdminKey(key.PublicKey()).
+		SetSupplyKey(NewKeyList().Add(key.PublicKey())).
+		Freeze()
+	require.NoError(t, err)
+
+	description, err := DescribeTransaction(update)
+	require.NoError(t, err)
+	assert.Equal(t, "TOKEN_UPDATE", description.Type)
+	assert.Equal(t, []KeyDescription{
+		{Field: "adminKey", Key: key.PublicKey().String()},
+		{Field: "supplyKey", Key: "{[" + key.PublicKey().String() + "]}"},
+	}, description.Keys)
+	assert.Equal(t, "0.0.7", description.Details["token"])
+	assert.Equal(t, "renamed", description.Details["name"])
+
+	scheduled := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1))
+	schedule, err := NewScheduleCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(_NewUnitDescriptionTransactionID()).
+		SetScheduleMemo("payroll").
+		SetScheduledTransaction(scheduled)
+	require.NoError(t, err)
+	schedule, err = schedule.Freeze()
+	require.NoError(t, err)
+
+	description, err = DescribeTransaction(schedule)
+	require.NoError(t, err)
+	assert.Equal(t, "SCHEDULE_CREATE", description.Type)
+	assert.Equal(t, "payroll", description.Details["memo"])
+	require.NotNil(t, description.ScheduledTransaction)
+	assert.Equal(t, "CRYPTO_TRANSFER", description.ScheduledTransaction.Type)
+	assert.Contains(t, description.ScheduledTransaction.Transfers, TransferDescription{AccountID: "0.0.2", Amount: "1 ℏ"})
+}
+
+func TestUnitDescribeChunks(t *testing.T) {
+	contents := bytes.Repeat([]byte{0xab}, 25)
+	appendTransaction, err := NewFileAppendTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(_NewUnitDescriptionTransactionID()).
+		SetFileID(FileID{File: 150}).
+		SetMaxChunkSize(10).
+		SetContents(contents).
+		Freeze()
+	require.NoError(t, err)
+
+	description, err := DescribeTransaction(appendTransaction)
+	require.NoError(t, err)
+	assert.Equal(t, 3, description.Chunks)
+	assert.Equal(t, hex.EncodeToString(contents), description.Details["contents"])
+	assert.Equal(t, "0.0.150", description.Details["fileID"])
+}
+
+func TestUnitTransactionJSONRoundTrip(t *testing.T) {
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	nodes := []AccountID{{Account: 3}, {Account: 4}}
+
+	transactionID := _NewUnitDescriptionTransactionID()
+
+	transactions := []func() (interface{}, error){
+		func() (interface{}, error) {
+			return NewTransferTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).Freeze()
+		},
+		func() (interface{}, error) {
+			return NewAccountCreateTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetKey(key.PublicKey()).SetInitialBalance(NewHbar(5)).SetAccountMemo("memo").Freeze()
+		},
+		func() (interface{}, error) {
+			return NewAccountUpdateTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetAccountID(AccountID{Account: 5}).SetKey(key.PublicKey()).Freeze()
+		},
+		func() (interface{}, error) {
+			return NewTokenCreateTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetTokenName("name").SetTokenSymbol("SYM").SetDecimals(3).SetAdminKey(key.PublicKey()).Freeze()
+		},
+		func() (interface{}, error) {
+			return NewTokenMintTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetTokenID(TokenID{Token: 7}).SetAmount(100).Freeze()
+		},
+		func() (interface{}, error) {
+			return NewTopicCreateTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetSubmitKey(key.PublicKey()).SetTopicMemo("topic").Freeze()
+		},
+		func() (interface{}, error) {
+			return NewTopicMessageSubmitTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetTopicID(TopicID{Topic: 9}).SetMessage([]byte("hello")).Freeze()
+		},
+		func() (interface{}, error) {
+			return NewFileCreateTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetKeys(key.PublicKey()).SetContents([]byte("contents")).Freeze()
+		},
+		func() (interface{}, error) {
+			return NewContractExecuteTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				SetContractID(ContractID{Contract: 5}).SetGas(100000).SetFunctionParameters([]byte{1, 2}).Freeze()
+		},
+		func() (interface{}, error) {
+			return NewAccountAllowanceApproveTransaction().SetNodeAccountIDs(nodes).SetTransactionID(transactionID).
+				ApproveHbarAllowance(AccountID{Account: 1800}, AccountID{Account: 2}, NewHbar(3)).Freeze()
+		},
+	}
+
+	for _, build := range transactions {
+		transaction, err := build()
+		require.NoError(t, err)
+
+		tx, ok := _TransactionOf(transaction)
+		require.True(t, ok)
+		tx._SignWith(key.PublicKey(), key.Sign)
+
+		expected, err := tx.ToBytes()
+		require.NoError(t, err)
+
+		encoded, err := TransactionToJSON(transaction)
+		require.NoError(t, err)
+		again, err := TransactionToJSON(transaction)
+		require.NoError(t, err)
+		assert.Equal(t, encoded, again)
+
+		decoded, err := TransactionFromJSON(encoded)
+		require.NoError(t, err)
+		decodedTx, ok := _TransactionOf(decoded)
+		require.True(t, ok)
+		actual, err := decodedTx.ToBytes()
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		reencoded, err := TransactionToJSON(decoded)
+		require.NoError(t, err)
+		assert.Equal(t, encoded, reencoded)
+	}
+}
+
+func TestUnitTransactionJSONAcceptsReformattedDescription(t *testing.T) {
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(_NewUnitDescriptionTransactionID()).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	expected, err := transfer.ToBytes()
+	require.NoError(t, err)
+	encoded, err := TransactionToJSON(transfer)
+	require.NoError(t, err)
+
+	// decoding into a map sorts the keys of the description, and indenting adds whitespace
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &document))
+	reformatted, err := json.MarshalIndent(document, "", "    ")
+	require.NoError(t, err)
+	require.NotEqual(t, encoded, reformatted)
+
+	decoded, err := TransactionFromJSON(reformatted)
+	require.NoError(t, err)
+	decodedTx, ok := _TransactionOf(decoded)
+	require.True(t, ok)
+	actual, err := decodedTx.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitTransactionJSONRejectsEditedDescription(t *testing.T) {
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(_NewUnitDescriptionTransactionID()).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	encoded, err := TransactionToJSON(transfer)
+	require.NoError(t, err)
+
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &document))
+	document["description"].(map[string]interface{})["memo"] = "harmless"
+	edited, err := json.Marshal(document)
+	require.NoError(t, err)
+
+	_, err = TransactionFromJSON(edited)
+	assert.ErrorIs(t, err, errDescriptionMismatch)
+
+	_, err = DescribeTransaction(NewTransferTransaction())
+	assert.ErrorIs(t, err, errTransactionIsNotFrozen)
+	_, err = DescribeTransaction("transaction")
+	assert.ErrorIs(t, err, errDescribeUnsupportedTransaction)
+}
@@ -0,0 +1,377 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"sync"
+)
+
+// TransactionPipeline submits many transactions concurrently, spreading them across the nodes of
+// the client's network. Each transaction is started on the node that has the fewest pipelined
+// requests in flight, and every attempt, including the retries sent to other nodes, counts against
+// the per node limit while it is in flight. Nodes that are backing off are only used once no healthy
+// node is available. Results are streamed back as they complete.
+//
+// Frozen transactions are submitted to one of their own node account IDs. Transactions without node
+// account IDs are drawn a node from the whole network and frozen for that node alone.
+//
+// A TransactionPipeline may be reused for several batches, but the same transaction must not be
+// submitted more than once at a time.
+type TransactionPipeline struct {
+	client             *Client
+	maxConcurrency     int
+	maxInFlightPerNode int
+	waitForReceipts    bool
+
+	mu       sync.Mutex
+	inFlight map[string]int
+	released chan struct{}
+	next     int
+}
+
+// TransactionPipelineResult is the outcome of a single transaction submitted through a TransactionPipeline.
+type TransactionPipelineResult struct {
+	// Index is the position of the transaction in the submitted batch.
+	Index       int
+	Transaction interface{}
+	Response    TransactionResponse
+	// Receipt is only set when the pipeline waits for receipts and the receipt was retrieved.
+	Receipt *TransactionReceipt
+	Err     error
+}
+
+// NewTransactionPipeline creates a TransactionPipeline which submits transactions using this client.
+func (client *Client) NewTransactionPipeline() *TransactionPipeline {
+	return &TransactionPipeline{
+		client:             client,
+		maxConcurrency:     16,
+		maxInFlightPerNode: 4,
+		inFlight:           make(map[string]int),
+		released:           make(chan struct{}),
+	}
+}
+
+// SetMaxConcurrency sets the maximum number of transactions the pipeline processes at once,
+// including the time spent waiting for receipts. Defaults to 16.
+func (pipeline *TransactionPipeline) SetMaxConcurrency(max int) *TransactionPipeline {
+	if max < 1 {
+		max = 1
+	}
+	pipeline.maxConcurrency = max
+	return pipeline
+}
+
+// GetMaxConcurrency returns the maximum number of transactions the pipeline processes at once.
+func (pipeline *TransactionPipeline) GetMaxConcurrency() int {
+	return pipeline.maxConcurrency
+}
+
+// SetMaxInFlightPerNode sets the maximum number of pipelined transactions submitted to a single
+// node at once. Defaults to 4.
+func (pipeline *TransactionPipeline) SetMaxInFlightPerNode(max int) *TransactionPipeline {
+	if max < 1 {
+		max = 1
+	}
+	pipeline.maxInFlightPerNode = max
+	return pipeline
+}
+
+// GetMaxInFlightPerNode returns the maximum number of pipelined transactions submitted to a single node at once.
+func (pipeline *TransactionPipeline) GetMaxInFlightPerNode() int {
+	return pipeline.maxInFlightPerNode
+}
+
+// SetWaitForReceipts sets whether the pipeline fetches the receipt of every successfully submitted
+// transaction before reporting its result.
+func (pipeline *TransactionPipeline) SetWaitForReceipts(wait bool) *TransactionPipeline {
+	pipeline.waitForReceipts = wait
+	return pipeline
+}
+
+// GetWaitForReceipts returns whether the pipeline fetches receipts.
+func (pipeline *TransactionPipeline) GetWaitForReceipts() bool {
+	return pipeline.waitForReceipts
+}
+
+// Execute submits the transactions and returns a channel on which one result per transaction is
+// delivered in completion order. The channel is closed once every transaction has been processed.
+func (pipeline *TransactionPipeline) Execute(ctx context.Context, transactions []interface{}) <-chan TransactionPipelineResult {
+	input := make(chan interface{})
+
+	go func() {
+		defer close(input)
+		for _, transaction := range transactions {
+			select {
+			case input <- transaction:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return pipeline.Submit(ctx, input)
+}
+
+// Submit reads transactions from the channel until it is closed and returns a channel on which one
+// result per transaction is delivered in completion order. The result Index counts transactions
+// in the order they were received. The returned channel is closed once the input channel has been
+// drained, or the context is done, and every transaction received has been processed. Once the
+// context is done, results the caller does not receive are dropped rather than blocking the pipeline.
+func (pipeline *TransactionPipeline) Submit(ctx context.Context, transactions <-chan interface{}) <-chan TransactionPipelineResult {
+	results := make(chan TransactionPipelineResult, pipeline.maxConcurrency)
+	sem := make(chan struct{}, pipeline.maxConcurrency)
+	var wg sync.WaitGroup
+
+	go func() {
+		defer func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		index := 0
+		for {
+			var transaction interface{}
+			var ok bool
+
+			select {
+			case transaction, ok = <-transactions:
+			case <-ctx.Done():
+				return
+			}
+			if !ok {
+				return
+			}
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				_SendPipelineResult(ctx, results, TransactionPipelineResult{Index: index, Transaction: transaction, Err: ctx.Err()})
+				return
+			}
+
+			wg.Add(1)
+			go func(index int, transaction interface{}) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				_SendPipelineResult(ctx, results, pipeline._Process(ctx, index, transaction))
+			}(index, transaction)
+			index++
+		}
+	}()
+
+	return results
+}
+
+// _SendPipelineResult delivers the result unless the context is done and the caller stopped receiving.
+func _SendPipelineResult(ctx context.Context, results chan<- TransactionPipelineResult, result TransactionPipelineResult) {
+	select {
+	case results <- result:
+		return
+	default:
+	}
+
+	select {
+	case results <- result:
+	case <-ctx.Done():
+	}
+}
+
+func (pipeline *TransactionPipeline) _Process(ctx context.Context, index int, transaction interface{}) TransactionPipelineResult {
+	result := TransactionPipelineResult{Index: index, Transaction: transaction}
+
+	base, ok := transaction.(interface{ _GetTransaction() *Transaction })
+	if !ok {
+		result.Err = errPipelineUnsupportedTransaction
+		return result
+	}
+
+	tx := base._GetTransaction()
+	if !tx._IsFrozen() && !tx.nodeAccountIDs._IsEmpty() {
+		result.Err = errTransactionIsNotFrozen
+		return result
+	}
+
+	key, err := pipeline._AcquireNode(ctx, tx)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	attempts := &_PipelineAttempts{pipeline: pipeline, reserved: key}
+	result.Response, result.Err = TransactionExecuteWithContext(_ContextWithAttemptLimiter(ctx, attempts), transaction, pipeline.client)
+	attempts._Done()
+
+	if result.Err != nil || !pipeline.waitForReceipts {
+		return result
+	}
+
+	receipt, err := result.Response.GetReceiptWithContext(ctx, pipeline.client)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Receipt = &receipt
+
+	return result
+}
+
+// _AcquireNode points the transaction at the least loaded node it can be submitted to and reserves
+// a slot on it, waiting while every usable node is at capacity. A transaction without node account
+// IDs is set to the selected node of the network before it is frozen.
+func (pipeline *TransactionPipeline) _AcquireNode(ctx context.Context, tx *Transaction) (string, error) {
+	for {
+		fromNetwork := tx.nodeAccountIDs._Length() == 0
+		var nodeAccountIDs []AccountID
+		if fromNetwork {
+			nodeAccountIDs = pipeline.client.network._GetNodeAccountIDs()
+		} else {
+			nodeAccountIDs = make([]AccountID, 0, tx.nodeAccountIDs._Length())
+			for _, nodeAccountID := range tx.nodeAccountIDs.slice {
+				nodeAccountIDs = append(nodeAccountIDs, nodeAccountID.(AccountID))
+			}
+		}
+
+		pipeline.mu.Lock()
+		index := pipeline._SelectNodeLocked(nodeAccountIDs)
+		if index >= 0 {
+			key := nodeAccountIDs[index].String()
+			pipeline.inFlight[key]++
+			pipeline.mu.Unlock()
+
+			if fromNetwork {
+				tx.SetNodeAccountIDs([]AccountID{nodeAccountIDs[index]})
+			} else {
+				tx.nodeAccountIDs.index = index
+			}
+			return key, nil
+		}
+		released := pipeline.released
+		pipeline.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+// _AcquireNodeSlot reserves a slot on the node, waiting while it is at capacity.
+func (pipeline *TransactionPipeline) _AcquireNodeSlot(ctx context.Context, key string) error {
+	for {
+		pipeline.mu.Lock()
+		if pipeline.inFlight[key] < pipeline.maxInFlightPerNode {
+			pipeline.inFlight[key]++
+			pipeline.mu.Unlock()
+			return nil
+		}
+		released := pipeline.released
+		pipeline.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (pipeline *TransactionPipeline) _ReleaseNode(key string) {
+	pipeline.mu.Lock()
+	defer pipeline.mu.Unlock()
+
+	pipeline.inFlight[key]--
+	if pipeline.inFlight[key] <= 0 {
+		delete(pipeline.inFlight, key)
+	}
+
+	close(pipeline.released)
+	pipeline.released = make(chan struct{})
+}
+
+// _SelectNodeLocked returns the index, within the given node account IDs, of the node to submit to,
+// or -1 when every candidate is at capacity. Healthy nodes are preferred over nodes that are backing
+// off, and ties are broken round robin so equally loaded nodes share the work.
+func (pipeline *TransactionPipeline) _SelectNodeLocked(nodeAccountIDs []AccountID) int {
+	length := len(nodeAccountIDs)
+	if length == 0 {
+		return -1
+	}
+
+	offset := pipeline.next % length
+	pipeline.next++
+
+	bestIndex, bestLoad, bestHealthy := -1, 0, false
+	for i := 0; i < length; i++ {
+		index := (offset + i) % length
+		accountID := nodeAccountIDs[index]
+
+		load := pipeline.inFlight[accountID.String()]
+		if load >= pipeline.maxInFlightPerNode {
+			continue
+		}
+
+		node, ok := pipeline.client.network._GetNodeForAccountID(accountID)
+		healthy := ok && node._IsHealthy()
+
+		if bestIndex < 0 || (healthy && !bestHealthy) || (healthy == bestHealthy && load < bestLoad) {
+			bestIndex, bestLoad, bestHealthy = index, load, healthy
+		}
+	}
+
+	return bestIndex
+}
+
+// _PipelineAttempts holds the slots of one pipelined transaction. Its first attempt uses the slot
+// reserved when the transaction was started, if it is sent to that node, and every other attempt
+// acquires a slot on its own node.
+type _PipelineAttempts struct {
+	pipeline *TransactionPipeline
+	reserved string
+}
+
+func (attempts *_PipelineAttempts) _AcquireAttempt(ctx context.Context, nodeAccountID AccountID) (func(), error) {
+	key := nodeAccountID.String()
+	reserved := attempts.reserved
+	attempts.reserved = ""
+
+	if reserved != key {
+		if reserved != "" {
+			attempts.pipeline._ReleaseNode(reserved)
+		}
+		if err := attempts.pipeline._AcquireNodeSlot(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return func() { attempts.pipeline._ReleaseNode(key) }, nil
+}
+
+// _Done releases the reserved slot if the execution ended before its first attempt.
+func (attempts *_PipelineAttempts) _Done() {
+	if attempts.reserved != "" {
+		attempts.pipeline._ReleaseNode(attempts.reserved)
+		attempts.reserved = ""
+	}
+}
@@ -0,0 +1,260 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2023 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _NewPipelineTransfers(t *testing.T, client *Client, count int) []interface{} {
+	transactions := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+			FreezeWith(client)
+		require.NoError(t, err)
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions
+}
+
+func _NewPipelineResponses(count int) []interface{} {
+	responses := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		responses = append(responses, &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		})
+	}
+
+	return responses
+}
+
+func TestUnitTransactionPipelineSpreadsAcrossNodes(t *testing.T) {
+	const count = 8
+
+	client, server := NewMockClientAndServer([][]interface{}{_NewPipelineResponses(count), _NewPipelineResponses(count)})
+	defer server.Close()
+
+	pipeline := client.NewTransactionPipeline().
+		SetMaxConcurrency(4).
+		SetMaxInFlightPerNode(1)
+	require.Equal(t, 4, pipeline.GetMaxConcurrency())
+	require.Equal(t, 1, pipeline.GetMaxInFlightPerNode())
+
+	seen := make(map[int]bool)
+	nodes := make(map[string]int)
+	for result := range pipeline.Execute(context.Background(), _NewPipelineTransfers(t, client, count)) {
+		require.NoError(t, result.Err)
+		require.Nil(t, result.Receipt)
+		require.False(t, seen[result.Index])
+		seen[result.Index] = true
+		nodes[result.Response.NodeID.String()]++
+	}
+
+	require.Len(t, seen, count)
+	require.Greater(t, nodes["0.0.3"], 0)
+	require.Greater(t, nodes["0.0.4"], 0)
+}
+
+func TestUnitTransactionPipelineAvoidsBackedOffNodes(t *testing.T) {
+	const count = 4
+
+	client, server := NewMockClientAndServer([][]interface{}{{}, _NewPipelineResponses(count)})
+	defer server.Close()
+
+	node, ok := client.network._GetNodeForAccountID(AccountID{Account: 3})
+	require.True(t, ok)
+	node._SetMaxBackoff(time.Hour)
+	node.currentBackoff = time.Minute
+	node._IncreaseBackoff()
+	require.False(t, node._IsHealthy())
+
+	for result := range client.NewTransactionPipeline().Execute(context.Background(), _NewPipelineTransfers(t, client, count)) {
+		require.NoError(t, result.Err)
+		require.Equal(t, AccountID{Account: 4}, result.Response.NodeID)
+	}
+}
+
+func TestUnitTransactionPipelineWaitsForReceipts(t *testing.T) {
+	receipt := &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{
+					Cost:         0,
+					ResponseType: services.ResponseType_ANSWER_ONLY,
+				},
+				Receipt: &services.TransactionReceipt{
+					Status: services.ResponseCodeEnum_SUCCESS,
+				},
+			},
+		},
+	}
+	submitted := &services.TransactionResponse{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{submitted, receipt, submitted, receipt}})
+	defer server.Close()
+
+	transactions := make([]interface{}, 0, 2)
+	for i := 0; i < 2; i++ {
+		transaction, err := NewTransferTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+			FreezeWith(client)
+		require.NoError(t, err)
+		transactions = append(transactions, transaction)
+	}
+
+	pipeline := client.NewTransactionPipeline().
+		SetMaxConcurrency(1).
+		SetWaitForReceipts(true)
+	require.True(t, pipeline.GetWaitForReceipts())
+
+	count := 0
+	for result := range pipeline.Execute(context.Background(), transactions) {
+		require.NoError(t, result.Err)
+		require.NotNil(t, result.Receipt)
+		require.Equal(t, StatusSuccess, result.Receipt.Status)
+		count++
+	}
+	require.Equal(t, 2, count)
+}
+
+func TestUnitTransactionPipelineRejectsInvalidTransactions(t *testing.T) {
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+
+	unfrozen := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}})
+
+	input := make(chan interface{}, 2)
+	input <- *unfrozen
+	input <- unfrozen
+	close(input)
+
+	results := make(map[int]error)
+	for result := range client.NewTransactionPipeline().Submit(context.Background(), input) {
+		results[result.Index] = result.Err
+	}
+
+	require.Len(t, results, 2)
+	require.ErrorIs(t, results[0], errPipelineUnsupportedTransaction)
+	require.ErrorIs(t, results[1], errTransactionIsNotFrozen)
+}
+
+func TestUnitTransactionPipelineContextCancelled(t *testing.T) {
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	input := make(chan interface{})
+	for result := range client.NewTransactionPipeline().Submit(ctx, input) {
+		require.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
+
+func TestUnitTransactionPipelineDrawsNodesFromNetwork(t *testing.T) {
+	const count = 8
+
+	client, server := NewMockClientAndServer([][]interface{}{_NewPipelineResponses(count), _NewPipelineResponses(count)})
+	defer server.Close()
+
+	transactions := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		transactions = append(transactions, NewTransferTransaction().
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)))
+	}
+
+	nodes := make(map[string]int)
+	for result := range client.NewTransactionPipeline().SetMaxInFlightPerNode(1).Execute(context.Background(), transactions) {
+		require.NoError(t, result.Err)
+		nodes[result.Response.NodeID.String()]++
+
+		transaction := result.Transaction.(*TransferTransaction)
+		require.True(t, transaction.IsFrozen())
+		require.Equal(t, []AccountID{result.Response.NodeID}, transaction.GetNodeAccountIDs())
+	}
+
+	require.Equal(t, count, nodes["0.0.3"]+nodes["0.0.4"])
+	require.Greater(t, nodes["0.0.3"], 0)
+	require.Greater(t, nodes["0.0.4"], 0)
+}
+
+func TestUnitTransactionPipelineAttemptsCountPerNode(t *testing.T) {
+	client, server := NewMockClientAndServer([][]interface{}{{}, {}})
+	defer server.Close()
+
+	pipeline := client.NewTransactionPipeline().SetMaxInFlightPerNode(1)
+	attempts := &_PipelineAttempts{pipeline: pipeline, reserved: "0.0.3"}
+	pipeline.inFlight["0.0.3"] = 1
+
+	// a retry on another node gives back the slot reserved on the first one
+	release, err := attempts._AcquireAttempt(context.Background(), AccountID{Account: 4})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"0.0.4": 1}, pipeline.inFlight)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	other := &_PipelineAttempts{pipeline: pipeline}
+	_, err = other._AcquireAttempt(ctx, AccountID{Account: 4})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	acquired := make(chan struct{})
+	go func() {
+		otherRelease, err := other._AcquireAttempt(context.Background(), AccountID{Account: 4})
+		require.NoError(t, err)
+		otherRelease()
+		close(acquired)
+	}()
+
+	release()
+	<-acquired
+	attempts._Done()
+	require.Empty(t, pipeline.inFlight)
+}
+
+func TestUnitTransactionPipelineDropsResultsAfterContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := make(chan TransactionPipelineResult)
+	_SendPipelineResult(ctx, results, TransactionPipelineResult{Err: ctx.Err()})
+
+	buffered := make(chan TransactionPipelineResult, 1)
+	_SendPipelineResult(ctx, buffered, TransactionPipelineResult{Index: 1})
+	require.Equal(t, 1, (<-buffered).Index)
+}
@@ -204,10 +204,17 @@ func (receipt TransactionReceipt) _ToProtobuf() *services.TransactionGetReceiptR
 
 func (receipt TransactionReceipt) ValidateStatus(shouldValidate bool) error {
 	if shouldValidate && receipt.Status != StatusSuccess {
+		statusErr := _NewErrHederaReceiptStatus(TransactionID{}, receipt.Status)
 		if receipt.TransactionID != nil {
-			return _NewErrHederaReceiptStatus(*receipt.TransactionID, receipt.Status)
+			statusErr = _NewErrHederaReceiptStatus(*receipt.TransactionID, receipt.Status)
 		}
-		return _NewErrHederaReceiptStatus(TransactionID{}, receipt.Status)
+
+		// receipts do not carry the result of the call, the record of the transaction has the revert reason
+		if receipt.Status == StatusContractRevertExecuted {
+			return _NewErrContractRevert(statusErr, receipt.Status, receipt.ContractID, nil)
+		}
+
+		return statusErr
 	}
 
 	return nil
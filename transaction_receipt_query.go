@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -93,7 +94,7 @@ func (query *TransactionReceiptQuery) GetIncludeDuplicates() bool {
 }
 
 func (query *TransactionReceiptQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -127,6 +128,12 @@ func (query *TransactionReceiptQuery) _Build() *services.Query_TransactionGetRec
 }
 
 func (query *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *TransactionReceiptQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -141,7 +148,7 @@ func (query *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 	query.timestamp = time.Now()
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -156,6 +163,7 @@ func (query *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionReceiptQueryShouldRetry,
@@ -180,9 +188,9 @@ func (query *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TransactionReceiptQueryShouldRetry(logID string, request interface{}, response interface{}) _ExecutionState {
+func _TransactionReceiptQueryShouldRetry(logger Logger, request interface{}, response interface{}) _ExecutionState {
 	status := Status(response.(*services.Response).GetTransactionGetReceipt().GetHeader().GetNodeTransactionPrecheckCode())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("receipt precheck status received")
+	logger.Trace("receipt precheck status received", "status", status.String())
 
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusBusy, StatusUnknown, StatusReceiptNotFound, StatusRecordNotFound:
@@ -194,7 +202,7 @@ func _TransactionReceiptQueryShouldRetry(logID string, request interface{}, resp
 	}
 
 	status = Status(response.(*services.Response).GetTransactionGetReceipt().GetReceipt().GetStatus())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("receipt status received")
+	logger.Trace("receipt status received", "status", status.String())
 
 	switch status {
 	case StatusBusy, StatusUnknown, StatusOk, StatusReceiptNotFound, StatusRecordNotFound:
@@ -295,6 +303,12 @@ func (query *TransactionReceiptQuery) GetMinBackoff() time.Duration {
 }
 
 func (query *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the TransactionReceiptQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *TransactionReceiptQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	if client == nil {
 		return TransactionReceipt{}, errNoClientProvided
 	}
@@ -317,6 +331,7 @@ func (query *TransactionReceiptQuery) Execute(client *Client) (TransactionReceip
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionReceiptQueryShouldRetry,
@@ -323,7 +323,7 @@ func (record TransactionRecord) _ToProtobuf() (*services.TransactionGetRecordRes
 		tRecord.Body = &services.TransactionRecord_ContractCreateResult{
 			ContractCreateResult: choice._ToProtobuf(),
 		}
-	} else {
+	} else if record.CallResult != nil {
 		var choice, err = record.GetContractExecuteResult()
 
 		if err != nil {
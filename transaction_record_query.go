@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -96,7 +97,7 @@ func (query *TransactionRecordQuery) GetIncludeDuplicates() bool {
 }
 
 func (query *TransactionRecordQuery) _ValidateNetworkOnIDs(client *Client) error {
-	if client == nil || !client.autoValidateChecksums {
+	if client == nil || !client.GetAutoValidateChecksums() {
 		return nil
 	}
 
@@ -130,7 +131,13 @@ func (query *TransactionRecordQuery) _Build() *services.Query_TransactionGetReco
 }
 
 func (query *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
-	if client == nil || client.operator == nil {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext gets the cost of the query. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *TransactionRecordQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
+	if client == nil || client._GetOperator() == nil {
 		return Hbar{}, errNoClientProvided
 	}
 
@@ -142,7 +149,7 @@ func (query *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	for range query.nodeAccountIDs.slice {
-		paymentTransaction, err := _QueryMakePaymentTransaction(TransactionID{}, AccountID{}, client.operator, Hbar{})
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, TransactionID{}, AccountID{}, client._GetOperator(), Hbar{})
 		if err != nil {
 			return Hbar{}, err
 		}
@@ -157,6 +164,7 @@ func (query *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionRecordQueryShouldRetry,
@@ -181,9 +189,9 @@ func (query *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TransactionRecordQueryShouldRetry(logID string, request interface{}, response interface{}) _ExecutionState {
+func _TransactionRecordQueryShouldRetry(logger Logger, request interface{}, response interface{}) _ExecutionState {
 	status := Status(response.(*services.Response).GetTransactionGetRecord().GetHeader().GetNodeTransactionPrecheckCode())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("precheck status received")
+	logger.Trace("precheck status received", "status", status.String())
 
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusBusy, StatusUnknown, StatusReceiptNotFound, StatusRecordNotFound:
@@ -197,7 +205,7 @@ func _TransactionRecordQueryShouldRetry(logID string, request interface{}, respo
 	}
 
 	status = Status(response.(*services.Response).GetTransactionGetRecord().GetTransactionRecord().GetReceipt().GetStatus())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("record's receipt status received")
+	logger.Trace("record's receipt status received", "status", status.String())
 
 	switch status {
 	case StatusBusy, StatusUnknown, StatusOk, StatusReceiptNotFound, StatusRecordNotFound:
@@ -220,11 +228,35 @@ func _TransactionRecordQueryMapStatusError(request interface{}, response interfa
 		}
 	}
 
-	return ErrHederaReceiptStatus{
+	statusErr := ErrHederaReceiptStatus{
 		Status: Status(query.GetTransactionGetRecord().GetTransactionRecord().GetReceipt().GetStatus()),
 		// TxID:    _TransactionIDFromProtobuf(_Request.query.pb.GetTransactionGetRecord().TransactionID, networkName),
 		Receipt: _TransactionReceiptFromProtobuf(query.GetTransactionGetReceipt(), nil),
 	}
+
+	if statusErr.Status != StatusContractRevertExecuted {
+		return statusErr
+	}
+
+	record := query.GetTransactionGetRecord().GetTransactionRecord()
+
+	var contractID *ContractID
+	if record.GetReceipt().GetContractID() != nil {
+		contractID = _ContractIDFromProtobuf(record.GetReceipt().GetContractID())
+	}
+
+	functionResult := record.GetContractCallResult()
+	if functionResult == nil {
+		functionResult = record.GetContractCreateResult()
+	}
+
+	var result *ContractFunctionResult
+	if functionResult != nil {
+		converted := _ContractFunctionResultFromProtobuf(functionResult)
+		result = &converted
+	}
+
+	return _NewErrContractRevert(statusErr, statusErr.Status, contractID, result)
 }
 
 func _TransactionRecordQueryGetMethod(_ interface{}, channel *_Channel) _Method {
@@ -303,7 +335,13 @@ func (query *TransactionRecordQuery) GetMinBackoff() time.Duration {
 }
 
 func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord, error) {
-	if client == nil || client.operator == nil {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the TransactionRecordQuery with the provided client. The context bounds every attempt,
+// the backoff waits between attempts and node selection.
+func (query *TransactionRecordQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
+	if client == nil || client._GetOperator() == nil {
 		return TransactionRecord{}, errNoClientProvided
 	}
 
@@ -315,7 +353,7 @@ func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord,
 	}
 
 	if !query.paymentTransactionIDs.locked {
-		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client.operator.accountID))
+		query.paymentTransactionIDs._Clear()._Push(TransactionIDGenerate(client._GetOperator().accountID))
 	}
 
 	var cost Hbar
@@ -328,7 +366,7 @@ func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord,
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return TransactionRecord{}, err
 		}
@@ -347,12 +385,12 @@ func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord,
 	query.paymentTransactions = make([]*services.Transaction, 0)
 
 	if query.nodeAccountIDs.locked {
-		err = _QueryGeneratePayments(&query.Query, client, cost)
+		err = _QueryGeneratePayments(ctx, &query.Query, client, cost)
 		if err != nil {
 			return TransactionRecord{}, err
 		}
 	} else {
-		paymentTransaction, err := _QueryMakePaymentTransaction(query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client.operator, cost)
+		paymentTransaction, err := _QueryMakePaymentTransaction(ctx, query.paymentTransactionIDs._GetCurrent().(TransactionID), AccountID{}, client._GetOperator(), cost)
 		if err != nil {
 			return TransactionRecord{}, err
 		}
@@ -366,6 +404,7 @@ func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord,
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionRecordQueryShouldRetry,
@@ -158,3 +158,20 @@ func TestUnitTransactionRecordReceiptNotFound(t *testing.T) {
 	require.Equal(t, "exceptional precheck status RECEIPT_NOT_FOUND", err.Error())
 	require.Equal(t, StatusReceiptNotFound, record.Receipt.Status)
 }
+
+func TestUnitTransactionRecordToBytesWithoutContractResult(t *testing.T) {
+	record := TransactionRecord{
+		Receipt:         TransactionReceipt{Status: StatusSuccess},
+		TransactionMemo: "memo",
+		TransactionFee:  HbarFromTinybar(10),
+	}
+
+	data := record.ToBytes()
+	require.NotEmpty(t, data)
+
+	decoded, err := TransactionRecordFromBytes(data)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, decoded.Receipt.Status)
+	require.Equal(t, "memo", decoded.TransactionMemo)
+	require.Nil(t, decoded.CallResult)
+}
@@ -20,6 +20,8 @@ package hedera
  *
  */
 
+import "context"
+
 type TransactionResponse struct {
 	TransactionID          TransactionID
 	ScheduledTransactionId TransactionID // nolint
@@ -29,10 +31,15 @@ type TransactionResponse struct {
 }
 
 func (response TransactionResponse) GetReceipt(client *Client) (TransactionReceipt, error) {
+	return response.GetReceiptWithContext(context.Background(), client)
+}
+
+// GetReceiptWithContext is GetReceipt with a context that can cancel the receipt polling.
+func (response TransactionResponse) GetReceiptWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return receipt, err
@@ -42,10 +49,15 @@ func (response TransactionResponse) GetReceipt(client *Client) (TransactionRecei
 }
 
 func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord, error) {
+	return response.GetRecordWithContext(context.Background(), client)
+}
+
+// GetRecordWithContext is GetRecord with a context that can cancel the receipt polling and the record query.
+func (response TransactionResponse) GetRecordWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		// Manually add the receipt, because an empty TransactionRecord will have an empty receipt and empty receipt has no status and no status defaults to 0, which means success
@@ -55,7 +67,7 @@ func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord
 	return NewTransactionRecordQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 }
 
 func (response TransactionResponse) GetReceiptQuery() *TransactionReceiptQuery {
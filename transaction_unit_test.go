@@ -24,6 +24,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -381,7 +382,7 @@ func TestUnitQueryRegression(t *testing.T) {
 		SetQueryPayment(HbarFromTinybar(25))
 
 	body := query._Build()
-	err = _QueryGeneratePayments(&query.Query, client, HbarFromTinybar(20))
+	err = _QueryGeneratePayments(context.Background(), &query.Query, client, HbarFromTinybar(20))
 	require.NoError(t, err)
 
 	var paymentTx services.TransactionBody